@@ -0,0 +1,69 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	"github.com/mitchellh/cli"
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tmain.go [flags] [<generated-file>]\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+
+	filename := `provider_catalog.json`
+	if args := flag.Args(); len(args) > 0 {
+		filename = args[0]
+	}
+
+	ui := &cli.BasicUi{
+		Reader:      os.Stdin,
+		Writer:      os.Stdout,
+		ErrorWriter: os.Stderr,
+	}
+
+	if err := writeCatalog(filename); err != nil {
+		ui.Error(fmt.Sprintf("error generating provider catalog: %s", err))
+		os.Exit(1)
+	}
+
+	ui.Info(fmt.Sprintf("Generated %s", filename))
+}
+
+func writeCatalog(filename string) error {
+	ctx := context.Background()
+	p, err := provider.New(ctx)
+
+	if err != nil {
+		return fmt.Errorf("creating provider: %w", err)
+	}
+
+	entries, err := provider.Catalog(ctx, p)
+
+	if err != nil {
+		return fmt.Errorf("building catalog: %w", err)
+	}
+
+	b, err := json.MarshalIndent(entries, "", "  ")
+
+	if err != nil {
+		return fmt.Errorf("marshaling catalog: %w", err)
+	}
+
+	if err := os.WriteFile(filename, append(b, '\n'), 0644); err != nil {
+		return fmt.Errorf("writing file (%s): %w", filename, err)
+	}
+
+	return nil
+}
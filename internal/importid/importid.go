@@ -0,0 +1,142 @@
+// Package importid describes, parses and validates the composite import IDs of resources.
+//
+// Every resource type whose importer accepts a composite import ID registers its
+// format from an init() function in its service package and parses the ID with it.
+// Resource types that are imported by a single name, ID or ARN do not register a format.
+package importid
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Part describes one component of a composite import ID.
+type Part struct {
+	// Name is the human-readable name of the part used in error messages,
+	// e.g. "EVENTBUSNAME" or "route-table-id".
+	Name string
+
+	// Optional parts may be omitted from the ID, in which case Default is used.
+	// Only leading parts may be optional.
+	Optional bool
+
+	// Default is the value of an Optional part when it is omitted.
+	Default string
+
+	// Pattern, if set, must match the value of the part.
+	Pattern *regexp.Regexp
+
+	// Greedy parts consume any surplus components of the ID, including
+	// the separators between them. At most one part may be greedy.
+	Greedy bool
+}
+
+// Format describes the format of a resource's import ID: an ordered list of
+// parts joined by a separator.
+type Format struct {
+	Parts     []Part
+	Separator string
+}
+
+// Create joins the specified part values into an ID.
+// Leading optional parts that are empty or set to their default are omitted.
+func (f Format) Create(values ...string) string {
+	i := 0
+	for i < f.optionalParts() && i < len(values) && (values[i] == "" || values[i] == f.Parts[i].Default) {
+		i++
+	}
+
+	return strings.Join(values[i:], f.Separator)
+}
+
+// Parse splits the specified ID into its part values, filling in the
+// defaults of omitted optional parts and validating each value.
+func (f Format) Parse(id string) ([]string, error) {
+	values := strings.Split(id, f.Separator)
+
+	if surplus := len(values) - len(f.Parts); surplus > 0 {
+		if i := f.greedyPart(); i >= 0 {
+			greedy := strings.Join(values[i:i+surplus+1], f.Separator)
+			values = append(append(values[:i:i], greedy), values[i+surplus+1:]...)
+		}
+	}
+
+	omitted := len(f.Parts) - len(values)
+
+	if omitted < 0 || omitted > f.optionalParts() {
+		return nil, f.unexpectedFormatError(id)
+	}
+
+	result := make([]string, len(f.Parts))
+
+	for i, part := range f.Parts {
+		if i < omitted {
+			result[i] = part.Default
+
+			continue
+		}
+
+		v := values[i-omitted]
+
+		if v == "" {
+			return nil, f.unexpectedFormatError(id)
+		}
+
+		if part.Pattern != nil && !part.Pattern.MatchString(v) {
+			return nil, fmt.Errorf("unexpected format for ID (%[1]s), %[2]s (%[3]s) must match %[4]s", id, part.Name, v, part.Pattern)
+		}
+
+		result[i] = v
+	}
+
+	return result, nil
+}
+
+// String returns a description of the format's accepted IDs,
+// e.g. "EVENTBUSNAME/STATEMENTID or STATEMENTID".
+func (f Format) String() string {
+	alternatives := make([]string, 0, f.optionalParts()+1)
+
+	for i := 0; i <= f.optionalParts(); i++ {
+		names := make([]string, 0, len(f.Parts)-i)
+
+		for _, part := range f.Parts[i:] {
+			names = append(names, part.Name)
+		}
+
+		alternatives = append(alternatives, strings.Join(names, f.Separator))
+	}
+
+	return strings.Join(alternatives, " or ")
+}
+
+// optionalParts returns the number of leading optional parts.
+func (f Format) optionalParts() int {
+	n := 0
+
+	for _, part := range f.Parts {
+		if !part.Optional {
+			break
+		}
+
+		n++
+	}
+
+	return n
+}
+
+// greedyPart returns the index of the greedy part, or -1 if there is none.
+func (f Format) greedyPart() int {
+	for i, part := range f.Parts {
+		if part.Greedy {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func (f Format) unexpectedFormatError(id string) error {
+	return fmt.Errorf("unexpected format for ID (%[1]s), expected %[2]s", id, f)
+}
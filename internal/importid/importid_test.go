@@ -0,0 +1,193 @@
+package importid
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFormatParse(t *testing.T) {
+	t.Parallel()
+
+	optionalFormat := Format{
+		Parts: []Part{
+			{Name: "EVENTBUSNAME", Optional: true, Default: "default"},
+			{Name: "STATEMENTID"},
+		},
+		Separator: "/",
+	}
+	greedyFormat := Format{
+		Parts: []Part{
+			{Name: "ID"},
+			{Name: "KEY", Greedy: true},
+		},
+		Separator: ",",
+	}
+	greedyMiddleFormat := Format{
+		Parts: []Part{
+			{Name: "service-namespace"},
+			{Name: "resource-id", Greedy: true},
+			{Name: "scalable-dimension"},
+		},
+		Separator: "/",
+	}
+	patternFormat := Format{
+		Parts: []Part{
+			{Name: "vpn-gateway-id", Pattern: regexp.MustCompile(`^vgw-[0-9a-f]+$`)},
+			{Name: "route-table-id", Pattern: regexp.MustCompile(`^rtb-[0-9a-f]+$`)},
+		},
+		Separator: "_",
+	}
+
+	testCases := []struct {
+		TestName      string
+		Format        Format
+		Input         string
+		Expected      []string
+		ExpectedError string
+	}{
+		{
+			TestName:      "empty",
+			Format:        optionalFormat,
+			Input:         "",
+			ExpectedError: "unexpected format for ID (), expected EVENTBUSNAME/STATEMENTID or STATEMENTID",
+		},
+		{
+			TestName: "optional part omitted",
+			Format:   optionalFormat,
+			Input:    "stmt",
+			Expected: []string{"default", "stmt"},
+		},
+		{
+			TestName: "optional part present",
+			Format:   optionalFormat,
+			Input:    "bus/stmt",
+			Expected: []string{"bus", "stmt"},
+		},
+		{
+			TestName:      "too many parts",
+			Format:        optionalFormat,
+			Input:         "bus/stmt/extra",
+			ExpectedError: "unexpected format for ID (bus/stmt/extra), expected EVENTBUSNAME/STATEMENTID or STATEMENTID",
+		},
+		{
+			TestName:      "empty part",
+			Format:        optionalFormat,
+			Input:         "/stmt",
+			ExpectedError: "unexpected format for ID (/stmt), expected EVENTBUSNAME/STATEMENTID or STATEMENTID",
+		},
+		{
+			TestName: "greedy",
+			Format:   greedyFormat,
+			Input:    "i-123,key,with,commas",
+			Expected: []string{"i-123", "key,with,commas"},
+		},
+		{
+			TestName:      "greedy too few parts",
+			Format:        greedyFormat,
+			Input:         "i-123",
+			ExpectedError: "unexpected format for ID (i-123), expected ID,KEY",
+		},
+		{
+			TestName: "greedy middle part",
+			Format:   greedyMiddleFormat,
+			Input:    "ecs/service/cluster/web/ecs:service:DesiredCount",
+			Expected: []string{"ecs", "service/cluster/web", "ecs:service:DesiredCount"},
+		},
+		{
+			TestName: "greedy middle part without surplus",
+			Format:   greedyMiddleFormat,
+			Input:    "dynamodb/table1/dynamodb:table:ReadCapacityUnits",
+			Expected: []string{"dynamodb", "table1", "dynamodb:table:ReadCapacityUnits"},
+		},
+		{
+			TestName: "pattern",
+			Format:   patternFormat,
+			Input:    "vgw-1234abcd_rtb-5678ef90",
+			Expected: []string{"vgw-1234abcd", "rtb-5678ef90"},
+		},
+		{
+			TestName:      "pattern mismatch",
+			Format:        patternFormat,
+			Input:         "rtb-5678ef90_vgw-1234abcd",
+			ExpectedError: "unexpected format for ID (rtb-5678ef90_vgw-1234abcd), vpn-gateway-id (rtb-5678ef90) must match ^vgw-[0-9a-f]+$",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.Format.Parse(testCase.Input)
+
+			if testCase.ExpectedError != "" {
+				if err == nil {
+					t.Fatalf("expected error, got %v", got)
+				}
+
+				if err.Error() != testCase.ExpectedError {
+					t.Errorf("got error %q, expected %q", err, testCase.ExpectedError)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.Expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestFormatCreate(t *testing.T) {
+	t.Parallel()
+
+	format := Format{
+		Parts: []Part{
+			{Name: "EVENTBUSNAME", Optional: true, Default: "default"},
+			{Name: "STATEMENTID"},
+		},
+		Separator: "/",
+	}
+
+	testCases := []struct {
+		TestName string
+		Input    []string
+		Expected string
+	}{
+		{
+			TestName: "all parts",
+			Input:    []string{"bus", "stmt"},
+			Expected: "bus/stmt",
+		},
+		{
+			TestName: "optional part default",
+			Input:    []string{"default", "stmt"},
+			Expected: "stmt",
+		},
+		{
+			TestName: "optional part empty",
+			Input:    []string{"", "stmt"},
+			Expected: "stmt",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			if got := format.Create(testCase.Input...); got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
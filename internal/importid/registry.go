@@ -0,0 +1,106 @@
+package importid
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+var registry = struct {
+	sync.RWMutex
+	formats map[string]Format
+}{
+	formats: make(map[string]Format),
+}
+
+// Register records the import ID format of the specified resource type.
+// It is intended to be called from init() functions and panics if the
+// resource type is already registered.
+// Only resource types that support import register a format; the IDs of
+// resources without an importer are not part of the provider's interface.
+func Register(resourceType string, format Format) {
+	registry.Lock()
+	defer registry.Unlock()
+
+	if _, ok := registry.formats[resourceType]; ok {
+		panic(fmt.Sprintf("import ID format for %s already registered", resourceType))
+	}
+
+	registry.formats[resourceType] = format
+}
+
+// Lookup returns the import ID format of the specified resource type.
+func Lookup(resourceType string) (Format, bool) {
+	registry.RLock()
+	defer registry.RUnlock()
+
+	format, ok := registry.formats[resourceType]
+
+	return format, ok
+}
+
+// ResourceTypes returns the sorted resource types with a registered import ID format.
+func ResourceTypes() []string {
+	registry.RLock()
+	defer registry.RUnlock()
+
+	resourceTypes := make([]string, 0, len(registry.formats))
+
+	for resourceType := range registry.formats {
+		resourceTypes = append(resourceTypes, resourceType)
+	}
+
+	sort.Strings(resourceTypes)
+
+	return resourceTypes
+}
+
+type partMetadata struct {
+	Name     string `json:"name"`
+	Optional bool   `json:"optional,omitempty"`
+	Default  string `json:"default,omitempty"`
+	Pattern  string `json:"pattern,omitempty"`
+	Greedy   bool   `json:"greedy,omitempty"`
+}
+
+type formatMetadata struct {
+	Description string         `json:"description"`
+	Parts       []partMetadata `json:"parts"`
+	Separator   string         `json:"separator"`
+}
+
+// MarshalJSON returns machine-readable metadata describing the format.
+func (f Format) MarshalJSON() ([]byte, error) {
+	m := formatMetadata{
+		Description: f.String(),
+		Parts:       make([]partMetadata, 0, len(f.Parts)),
+		Separator:   f.Separator,
+	}
+
+	for _, part := range f.Parts {
+		p := partMetadata{
+			Name:     part.Name,
+			Optional: part.Optional,
+			Default:  part.Default,
+			Greedy:   part.Greedy,
+		}
+
+		if part.Pattern != nil {
+			p.Pattern = part.Pattern.String()
+		}
+
+		m.Parts = append(m.Parts, p)
+	}
+
+	return json.Marshal(m)
+}
+
+// Metadata returns machine-readable metadata describing all registered
+// import ID formats, keyed by resource type.
+func Metadata() ([]byte, error) {
+	registry.RLock()
+	defer registry.RUnlock()
+
+	return json.MarshalIndent(registry.formats, "", "  ")
+}
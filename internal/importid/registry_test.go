@@ -0,0 +1,81 @@
+package importid
+
+import (
+	"encoding/json"
+	"regexp"
+	"testing"
+)
+
+func TestRegistry(t *testing.T) {
+	format := Format{
+		Parts: []Part{
+			{Name: "hosted-zone-id"},
+			{Name: "name", Pattern: regexp.MustCompile(`^[a-z]+$`)},
+		},
+		Separator: ",",
+	}
+
+	Register("aws_test_registry", format)
+
+	got, ok := Lookup("aws_test_registry")
+
+	if !ok {
+		t.Fatal("expected format to be registered")
+	}
+
+	if got.String() != "hosted-zone-id,name" {
+		t.Errorf("got %s, expected hosted-zone-id,name", got)
+	}
+
+	if _, ok := Lookup("aws_test_unregistered"); ok {
+		t.Error("expected format not to be registered")
+	}
+
+	found := false
+	for _, v := range ResourceTypes() {
+		if v == "aws_test_registry" {
+			found = true
+		}
+	}
+
+	if !found {
+		t.Error("expected resource type to be listed")
+	}
+
+	b, err := Metadata()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var metadata map[string]struct {
+		Description string `json:"description"`
+		Parts       []struct {
+			Name    string `json:"name"`
+			Pattern string `json:"pattern"`
+		} `json:"parts"`
+		Separator string `json:"separator"`
+	}
+
+	if err := json.Unmarshal(b, &metadata); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	m, ok := metadata["aws_test_registry"]
+
+	if !ok {
+		t.Fatal("expected metadata for resource type")
+	}
+
+	if m.Description != "hosted-zone-id,name" || m.Separator != "," || len(m.Parts) != 2 || m.Parts[1].Pattern != "^[a-z]+$" {
+		t.Errorf("unexpected metadata: %+v", m)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected duplicate registration to panic")
+		}
+	}()
+
+	Register("aws_test_registry", format)
+}
@@ -0,0 +1,186 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"reflect"
+	"runtime"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/importid"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/fwprovider"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	servicePackagePathPrefix = "github.com/hashicorp/terraform-provider-aws/internal/service/"
+)
+
+// CatalogEntry describes a resource type implemented by the provider.
+type CatalogEntry struct {
+	ARNPattern       string           `json:"arn_pattern,omitempty"`
+	AWSSDKVersions   []int            `json:"aws_sdk_versions,omitempty"`
+	Framework        bool             `json:"framework"`
+	ImportIDFormat   *importid.Format `json:"import_id_format,omitempty"` // Only set for the resource types listed in the importid package documentation.
+	Importable       bool             `json:"importable"`
+	Service          string           `json:"service"`
+	SupportsTimeouts bool             `json:"supports_timeouts"`
+	Taggable         bool             `json:"taggable"`
+	TypeName         string           `json:"type_name"`
+}
+
+// arnPatterns maps resource types to an IAM-style wildcard pattern matching their ARNs.
+// Resource types that aren't listed have no ARN pattern in the catalog.
+var arnPatterns = map[string]string{
+	"aws_alb":                     "arn:*:elasticloadbalancing:*:*:loadbalancer/*",
+	"aws_ami":                     "arn:*:ec2:*::image/*",
+	"aws_cloudfront_distribution": "arn:*:cloudfront::*:distribution/*",
+	"aws_cloudwatch_event_rule":   "arn:*:events:*:*:rule/*",
+	"aws_cloudwatch_log_group":    "arn:*:logs:*:*:log-group:*",
+	"aws_db_instance":             "arn:*:rds:*:*:db:*",
+	"aws_dynamodb_table":          "arn:*:dynamodb:*:*:table/*",
+	"aws_ebs_volume":              "arn:*:ec2:*:*:volume/*",
+	"aws_ecr_repository":          "arn:*:ecr:*:*:repository/*",
+	"aws_ecs_cluster":             "arn:*:ecs:*:*:cluster/*",
+	"aws_ecs_task_definition":     "arn:*:ecs:*:*:task-definition/*:*",
+	"aws_efs_file_system":         "arn:*:elasticfilesystem:*:*:file-system/*",
+	"aws_eks_cluster":             "arn:*:eks:*:*:cluster/*",
+	"aws_iam_policy":              "arn:*:iam::*:policy/*",
+	"aws_iam_role":                "arn:*:iam::*:role/*",
+	"aws_iam_user":                "arn:*:iam::*:user/*",
+	"aws_instance":                "arn:*:ec2:*:*:instance/*",
+	"aws_kinesis_stream":          "arn:*:kinesis:*:*:stream/*",
+	"aws_kms_key":                 "arn:*:kms:*:*:key/*",
+	"aws_lambda_function":         "arn:*:lambda:*:*:function:*",
+	"aws_lb":                      "arn:*:elasticloadbalancing:*:*:loadbalancer/*",
+	"aws_lb_target_group":         "arn:*:elasticloadbalancing:*:*:targetgroup/*/*",
+	"aws_route53_zone":            "arn:*:route53:::hostedzone/*",
+	"aws_s3_bucket":               "arn:*:s3:::*",
+	"aws_secretsmanager_secret":   "arn:*:secretsmanager:*:*:secret:*",
+	"aws_security_group":          "arn:*:ec2:*:*:security-group/*",
+	"aws_sfn_state_machine":       "arn:*:states:*:*:stateMachine:*",
+	"aws_sns_topic":               "arn:*:sns:*:*:*",
+	"aws_sqs_queue":               "arn:*:sqs:*:*:*",
+	"aws_ssm_parameter":           "arn:*:ssm:*:*:parameter/*",
+	"aws_subnet":                  "arn:*:ec2:*:*:subnet/*",
+	"aws_vpc":                     "arn:*:ec2:*:*:vpc/*",
+}
+
+// Catalog returns metadata describing each resource type implemented by the specified primary provider
+// and its Terraform Plugin Framework counterpart, sorted by resource type name.
+func Catalog(ctx context.Context, primary *schema.Provider) ([]CatalogEntry, error) {
+	var entries []CatalogEntry
+
+	for typeName, r := range primary.ResourcesMap {
+		entries = append(entries, sdkResourceCatalogEntry(typeName, r))
+	}
+
+	var resources []resource.Resource
+
+	for _, v := range fwprovider.New(primary).Resources(ctx) {
+		r := v()
+
+		// Describe the resource implementation, not the provider's wrapper.
+		if v, ok := r.(interface{ Unwrap() resource.Resource }); ok {
+			r = v.Unwrap()
+		}
+
+		resources = append(resources, r)
+	}
+
+	for _, r := range resources {
+		entry, err := frameworkResourceCatalogEntry(ctx, r)
+
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, entry)
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].TypeName < entries[j].TypeName
+	})
+
+	return entries, nil
+}
+
+func sdkResourceCatalogEntry(typeName string, r *schema.Resource) CatalogEntry {
+	var readFunc any
+
+	switch {
+	case r.ReadWithoutTimeout != nil:
+		readFunc = r.ReadWithoutTimeout
+	case r.ReadContext != nil:
+		readFunc = r.ReadContext
+	default:
+		readFunc = r.Read //nolint:staticcheck // Still used by some resources.
+	}
+
+	// e.g. "github.com/hashicorp/terraform-provider-aws/internal/service/ec2.resourceVPCRead".
+	funcName := runtime.FuncForPC(reflect.ValueOf(readFunc).Pointer()).Name()
+	service, _, _ := strings.Cut(strings.TrimPrefix(funcName, servicePackagePathPrefix), ".")
+
+	entry := CatalogEntry{
+		Framework:        false,
+		Importable:       r.Importer != nil,
+		Service:          service,
+		SupportsTimeouts: r.Timeouts != nil,
+		Taggable:         r.Schema["tags"] != nil,
+		TypeName:         typeName,
+	}
+
+	if v, ok := r.Schema["arn"]; ok && v.Computed {
+		entry.ARNPattern = arnPatterns[typeName]
+	}
+
+	return completeCatalogEntry(entry)
+}
+
+func frameworkResourceCatalogEntry(ctx context.Context, r resource.Resource) (CatalogEntry, error) {
+	var metadata resource.MetadataResponse
+	r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "aws"}, &metadata)
+
+	schema, diags := r.GetSchema(ctx)
+
+	if diags.HasError() {
+		return CatalogEntry{}, fmt.Errorf("getting %s schema", metadata.TypeName)
+	}
+
+	_, importable := r.(resource.ResourceWithImportState)
+	_, timeoutsAttribute := schema.Attributes["timeouts"]
+	_, timeoutsBlock := schema.Blocks["timeouts"]
+
+	entry := CatalogEntry{
+		Framework:        true,
+		Importable:       importable,
+		Service:          path.Base(reflect.TypeOf(r).Elem().PkgPath()),
+		SupportsTimeouts: timeoutsAttribute || timeoutsBlock,
+		TypeName:         metadata.TypeName,
+	}
+
+	_, entry.Taggable = schema.Attributes["tags"]
+
+	if v, ok := schema.Attributes["arn"]; ok && v.Computed {
+		entry.ARNPattern = arnPatterns[entry.TypeName]
+	}
+
+	return completeCatalogEntry(entry), nil
+}
+
+func completeCatalogEntry(entry CatalogEntry) CatalogEntry {
+	// Service packages that aren't AWS services (e.g. meta) have no clients.
+	if v, err := names.AWSGoSDKVersions(entry.Service); err == nil {
+		entry.AWSSDKVersions = v
+	}
+
+	if v, ok := importid.Lookup(entry.TypeName); ok {
+		entry.ImportIDFormat = &v
+	}
+
+	return entry
+}
@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// dataSourceCatalog returns the aws_provider_catalog data source, which describes the specified provider.
+// It is intended for internal tooling (policy checks, module template generation) and is not documented.
+func dataSourceCatalog(provider *schema.Provider) *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return dataSourceCatalogRead(ctx, d, meta, provider)
+		},
+
+		Description: "Machine-readable metadata describing the provider's resource types. For internal use.",
+
+		Schema: map[string]*schema.Schema{
+			"json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"service": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func dataSourceCatalogRead(ctx context.Context, d *schema.ResourceData, meta interface{}, provider *schema.Provider) diag.Diagnostics {
+	entries, err := Catalog(ctx, provider)
+
+	if err != nil {
+		return diag.Errorf("reading provider catalog: %s", err)
+	}
+
+	service := d.Get("service").(string)
+
+	if service != "" {
+		var filtered []CatalogEntry
+
+		for _, v := range entries {
+			if v.Service == service {
+				filtered = append(filtered, v)
+			}
+		}
+
+		entries = filtered
+	}
+
+	b, err := json.Marshal(entries)
+
+	if err != nil {
+		return diag.Errorf("reading provider catalog: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Partition)
+	d.Set("json", string(b))
+
+	return nil
+}
@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/importid"
+)
+
+func TestCatalog(t *testing.T) {
+	ctx := context.Background()
+	p, err := New(ctx)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	entries, err := Catalog(ctx, p)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	catalog := make(map[string]CatalogEntry)
+
+	for _, v := range entries {
+		if _, ok := catalog[v.TypeName]; ok {
+			t.Errorf("duplicate catalog entry for %s", v.TypeName)
+		}
+
+		if v.Service == "" {
+			t.Errorf("no service for %s", v.TypeName)
+		}
+
+		catalog[v.TypeName] = v
+	}
+
+	testCases := []struct {
+		TypeName         string
+		Service          string
+		Framework        bool
+		Taggable         bool
+		SupportsTimeouts bool
+		ARNPattern       string
+		ImportIDFormat   string
+	}{
+		{
+			TypeName:         "aws_instance",
+			Service:          "ec2",
+			Taggable:         true,
+			SupportsTimeouts: true,
+			ARNPattern:       "arn:*:ec2:*:*:instance/*",
+		},
+		{
+			TypeName:         "aws_security_group_rule",
+			Service:          "ec2",
+			SupportsTimeouts: true,
+			ImportIDFormat:   "SECURITYGROUPID_TYPE_PROTOCOL_FROMPORT_TOPORT_SOURCE",
+		},
+		{
+			TypeName:         "aws_vpn_gateway_route_propagation",
+			Service:          "ec2",
+			SupportsTimeouts: true,
+		},
+		{
+			TypeName:         "aws_lb",
+			Service:          "elbv2",
+			Taggable:         true,
+			SupportsTimeouts: true,
+			ARNPattern:       "arn:*:elasticloadbalancing:*:*:loadbalancer/*",
+		},
+		{
+			TypeName:         "aws_vpc_endpoint_service",
+			Service:          "ec2",
+			Taggable:         true,
+			SupportsTimeouts: true,
+		},
+		{
+			TypeName:  "aws_medialive_multiplex_program",
+			Service:   "medialive",
+			Framework: true,
+		},
+		{
+			TypeName:  "aws_simpledb_domain",
+			Service:   "simpledb",
+			Framework: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		got, ok := catalog[testCase.TypeName]
+
+		if !ok {
+			t.Errorf("no catalog entry for %s", testCase.TypeName)
+
+			continue
+		}
+
+		if got.Service != testCase.Service {
+			t.Errorf("%s: got service %s, expected %s", testCase.TypeName, got.Service, testCase.Service)
+		}
+
+		if got.Framework != testCase.Framework {
+			t.Errorf("%s: got framework %t, expected %t", testCase.TypeName, got.Framework, testCase.Framework)
+		}
+
+		if got.Taggable != testCase.Taggable {
+			t.Errorf("%s: got taggable %t, expected %t", testCase.TypeName, got.Taggable, testCase.Taggable)
+		}
+
+		if got.SupportsTimeouts != testCase.SupportsTimeouts {
+			t.Errorf("%s: got supports_timeouts %t, expected %t", testCase.TypeName, got.SupportsTimeouts, testCase.SupportsTimeouts)
+		}
+
+		if got.ARNPattern != testCase.ARNPattern {
+			t.Errorf("%s: got arn_pattern %s, expected %s", testCase.TypeName, got.ARNPattern, testCase.ARNPattern)
+		}
+
+		var importIDFormat string
+		if got.ImportIDFormat != nil {
+			importIDFormat = got.ImportIDFormat.String()
+		}
+
+		if importIDFormat != testCase.ImportIDFormat {
+			t.Errorf("%s: got import_id_format %s, expected %s", testCase.TypeName, importIDFormat, testCase.ImportIDFormat)
+		}
+	}
+}
+
+// TestImportIDFormatResourceTypes verifies that import ID formats are only registered for importable resources.
+func TestImportIDFormatResourceTypes(t *testing.T) {
+	p, err := New(context.Background())
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for _, resourceType := range importid.ResourceTypes() {
+		r, ok := p.ResourcesMap[resourceType]
+
+		if !ok {
+			t.Errorf("import ID format registered for unknown resource type %s", resourceType)
+
+			continue
+		}
+
+		if r.Importer == nil {
+			t.Errorf("import ID format registered for %s, which does not support import", resourceType)
+		}
+	}
+}
+
+// TestARNPatternResourceTypes verifies that ARN patterns are only listed for resources that export an ARN.
+func TestARNPatternResourceTypes(t *testing.T) {
+	p, err := New(context.Background())
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for resourceType := range arnPatterns {
+		r, ok := p.ResourcesMap[resourceType]
+
+		if !ok {
+			t.Errorf("ARN pattern listed for unknown resource type %s", resourceType)
+
+			continue
+		}
+
+		if v, ok := r.Schema["arn"]; !ok || !v.Computed {
+			t.Errorf("ARN pattern listed for %s, which does not export an ARN", resourceType)
+		}
+	}
+}
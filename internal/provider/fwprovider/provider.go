@@ -481,3 +481,8 @@ func (w *wrappedResource) Configure(ctx context.Context, request resource.Config
 func (w *wrappedResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
 	w.inner.ImportState(ctx, request, response)
 }
+
+// Unwrap returns the wrapped resource.
+func (w *wrappedResource) Unwrap() resource.Resource {
+	return w.inner
+}
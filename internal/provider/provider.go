@@ -544,6 +544,7 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_ebs_volume":                                 ec2.DataSourceEBSVolume(),
 			"aws_ebs_volumes":                                ec2.DataSourceEBSVolumes(),
 			"aws_ec2_client_vpn_endpoint":                    ec2.DataSourceClientVPNEndpoint(),
+			"aws_ec2_cloudinit_config":                       ec2.DataSourceCloudInitConfig(),
 			"aws_ec2_coip_pool":                              ec2.DataSourceCoIPPool(),
 			"aws_ec2_coip_pools":                             ec2.DataSourceCoIPPools(),
 			"aws_ec2_host":                                   ec2.DataSourceHost(),
@@ -606,7 +607,9 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_vpc_ipam_preview_next_cidr":                 ec2.DataSourceIPAMPreviewNextCIDR(),
 			"aws_vpc_peering_connection":                     ec2.DataSourceVPCPeeringConnection(),
 			"aws_vpc_peering_connections":                    ec2.DataSourceVPCPeeringConnections(),
+			"aws_vpc_security_group_rules":                   ec2.DataSourceSecurityGroupRules(),
 			"aws_vpc":                                        ec2.DataSourceVPC(),
+			"aws_vpc_subnet_plan":                            ec2.DataSourceSubnetPlan(),
 			"aws_vpcs":                                       ec2.DataSourceVPCs(),
 			"aws_vpn_gateway":                                ec2.DataSourceVPNGateway(),
 
@@ -626,13 +629,15 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_efs_file_system":   efs.DataSourceFileSystem(),
 			"aws_efs_mount_target":  efs.DataSourceMountTarget(),
 
-			"aws_eks_addon":         eks.DataSourceAddon(),
-			"aws_eks_addon_version": eks.DataSourceAddonVersion(),
-			"aws_eks_cluster":       eks.DataSourceCluster(),
-			"aws_eks_clusters":      eks.DataSourceClusters(),
-			"aws_eks_cluster_auth":  eks.DataSourceClusterAuth(),
-			"aws_eks_node_group":    eks.DataSourceNodeGroup(),
-			"aws_eks_node_groups":   eks.DataSourceNodeGroups(),
+			"aws_eks_access_entries": eks.DataSourceAccessEntries(),
+			"aws_eks_access_entry":   eks.DataSourceAccessEntry(),
+			"aws_eks_addon":          eks.DataSourceAddon(),
+			"aws_eks_addon_version":  eks.DataSourceAddonVersion(),
+			"aws_eks_cluster":        eks.DataSourceCluster(),
+			"aws_eks_clusters":       eks.DataSourceClusters(),
+			"aws_eks_cluster_auth":   eks.DataSourceClusterAuth(),
+			"aws_eks_node_group":     eks.DataSourceNodeGroup(),
+			"aws_eks_node_groups":    eks.DataSourceNodeGroups(),
 
 			"aws_elasticache_cluster":           elasticache.DataSourceCluster(),
 			"aws_elasticache_replication_group": elasticache.DataSourceReplicationGroup(),
@@ -747,6 +752,7 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_lambda_function":            lambda.DataSourceFunction(),
 			"aws_lambda_invocation":          lambda.DataSourceInvocation(),
 			"aws_lambda_layer_version":       lambda.DataSourceLayerVersion(),
+			"aws_lambda_package":             lambda.DataSourcePackage(),
 
 			"aws_lex_bot":       lexmodels.DataSourceBot(),
 			"aws_lex_bot_alias": lexmodels.DataSourceBotAlias(),
@@ -839,6 +845,7 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_redshift_subnet_group":        redshift.DataSourceSubnetGroup(),
 
 			"aws_resourcegroupstaggingapi_resources": resourcegroupstaggingapi.DataSourceResources(),
+			"aws_unmanaged_resources":                resourcegroupstaggingapi.DataSourceUnmanagedResources(),
 
 			"aws_route53_delegation_set":          route53.DataSourceDelegationSet(),
 			"aws_route53_traffic_policy_document": route53.DataSourceTrafficPolicyDocument(),
@@ -848,13 +855,14 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_route53_resolver_rule":     route53resolver.DataSourceRule(),
 			"aws_route53_resolver_rules":    route53resolver.DataSourceRules(),
 
-			"aws_canonical_user_id": s3.DataSourceCanonicalUserID(),
-			"aws_s3_bucket":         s3.DataSourceBucket(),
-			"aws_s3_object":         s3.DataSourceObject(),
-			"aws_s3_objects":        s3.DataSourceObjects(),
-			"aws_s3_bucket_object":  s3.DataSourceBucketObject(),  // DEPRECATED: use aws_s3_object instead
-			"aws_s3_bucket_objects": s3.DataSourceBucketObjects(), // DEPRECATED: use aws_s3_objects instead
-			"aws_s3_bucket_policy":  s3.DataSourceBucketPolicy(),
+			"aws_canonical_user_id":       s3.DataSourceCanonicalUserID(),
+			"aws_s3_bucket":               s3.DataSourceBucket(),
+			"aws_s3_object":               s3.DataSourceObject(),
+			"aws_s3_objects":              s3.DataSourceObjects(),
+			"aws_s3_bucket_object":        s3.DataSourceBucketObject(),  // DEPRECATED: use aws_s3_object instead
+			"aws_s3_bucket_objects":       s3.DataSourceBucketObjects(), // DEPRECATED: use aws_s3_objects instead
+			"aws_s3_bucket_policy":        s3.DataSourceBucketPolicy(),
+			"aws_s3_bucket_configuration": s3.DataSourceBucketConfiguration(),
 
 			"aws_s3_account_public_access_block": s3control.DataSourceAccountPublicAccessBlock(),
 
@@ -1100,19 +1108,23 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_cloudformation_stack_set_instance": cloudformation.ResourceStackSetInstance(),
 			"aws_cloudformation_type":               cloudformation.ResourceType(),
 
+			"aws_cloudfront_cache_behavior":                 cloudfront.ResourceCacheBehavior(),
 			"aws_cloudfront_cache_policy":                   cloudfront.ResourceCachePolicy(),
+			"aws_cloudfront_continuous_deployment_policy":   cloudfront.ResourceContinuousDeploymentPolicy(),
 			"aws_cloudfront_distribution":                   cloudfront.ResourceDistribution(),
 			"aws_cloudfront_field_level_encryption_config":  cloudfront.ResourceFieldLevelEncryptionConfig(),
 			"aws_cloudfront_field_level_encryption_profile": cloudfront.ResourceFieldLevelEncryptionProfile(),
 			"aws_cloudfront_function":                       cloudfront.ResourceFunction(),
 			"aws_cloudfront_key_group":                      cloudfront.ResourceKeyGroup(),
 			"aws_cloudfront_monitoring_subscription":        cloudfront.ResourceMonitoringSubscription(),
+			"aws_cloudfront_origin":                         cloudfront.ResourceOrigin(),
 			"aws_cloudfront_origin_access_control":          cloudfront.ResourceOriginAccessControl(),
 			"aws_cloudfront_origin_access_identity":         cloudfront.ResourceOriginAccessIdentity(),
 			"aws_cloudfront_origin_request_policy":          cloudfront.ResourceOriginRequestPolicy(),
 			"aws_cloudfront_public_key":                     cloudfront.ResourcePublicKey(),
 			"aws_cloudfront_realtime_log_config":            cloudfront.ResourceRealtimeLogConfig(),
 			"aws_cloudfront_response_headers_policy":        cloudfront.ResourceResponseHeadersPolicy(),
+			"aws_cloudfront_staging_distribution_promotion": cloudfront.ResourceStagingDistributionPromotion(),
 
 			"aws_cloudhsm_v2_cluster": cloudhsmv2.ResourceCluster(),
 			"aws_cloudhsm_v2_hsm":     cloudhsmv2.ResourceHSM(),
@@ -1306,7 +1318,9 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_dynamodb_global_table":                  dynamodb.ResourceGlobalTable(),
 			"aws_dynamodb_kinesis_streaming_destination": dynamodb.ResourceKinesisStreamingDestination(),
 			"aws_dynamodb_table":                         dynamodb.ResourceTable(),
+			"aws_dynamodb_table_export":                  dynamodb.ResourceTableExport(),
 			"aws_dynamodb_table_item":                    dynamodb.ResourceTableItem(),
+			"aws_dynamodb_table_items":                   dynamodb.ResourceTableItems(),
 			"aws_dynamodb_table_replica":                 dynamodb.ResourceTableReplica(),
 			"aws_dynamodb_tag":                           dynamodb.ResourceTag(),
 
@@ -1420,6 +1434,8 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_vpc_peering_connection":                           ec2.ResourceVPCPeeringConnection(),
 			"aws_vpc_peering_connection_accepter":                  ec2.ResourceVPCPeeringConnectionAccepter(),
 			"aws_vpc_peering_connection_options":                   ec2.ResourceVPCPeeringConnectionOptions(),
+			"aws_vpc_security_group_egress_rule":                   ec2.ResourceSecurityGroupEgressRule(),
+			"aws_vpc_security_group_ingress_rule":                  ec2.ResourceSecurityGroupIngressRule(),
 			"aws_vpn_connection":                                   ec2.ResourceVPNConnection(),
 			"aws_vpn_connection_route":                             ec2.ResourceVPNConnectionRoute(),
 			"aws_vpn_gateway":                                      ec2.ResourceVPNGateway(),
@@ -1453,11 +1469,13 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_efs_mount_target":              efs.ResourceMountTarget(),
 			"aws_efs_replication_configuration": efs.ResourceReplicationConfiguration(),
 
-			"aws_eks_addon":                    eks.ResourceAddon(),
-			"aws_eks_cluster":                  eks.ResourceCluster(),
-			"aws_eks_fargate_profile":          eks.ResourceFargateProfile(),
-			"aws_eks_identity_provider_config": eks.ResourceIdentityProviderConfig(),
-			"aws_eks_node_group":               eks.ResourceNodeGroup(),
+			"aws_eks_access_entry":              eks.ResourceAccessEntry(),
+			"aws_eks_access_policy_association": eks.ResourceAccessPolicyAssociation(),
+			"aws_eks_addon":                     eks.ResourceAddon(),
+			"aws_eks_cluster":                   eks.ResourceCluster(),
+			"aws_eks_fargate_profile":           eks.ResourceFargateProfile(),
+			"aws_eks_identity_provider_config":  eks.ResourceIdentityProviderConfig(),
+			"aws_eks_node_group":                eks.ResourceNodeGroup(),
 
 			"aws_elasticache_cluster":                  elasticache.ResourceCluster(),
 			"aws_elasticache_global_replication_group": elasticache.ResourceGlobalReplicationGroup(),
@@ -1702,6 +1720,7 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_lambda_invocation":                     lambda.ResourceInvocation(),
 			"aws_lambda_layer_version":                  lambda.ResourceLayerVersion(),
 			"aws_lambda_layer_version_permission":       lambda.ResourceLayerVersionPermission(),
+			"aws_lambda_package":                        lambda.ResourcePackage(),
 			"aws_lambda_permission":                     lambda.ResourcePermission(),
 			"aws_lambda_provisioned_concurrency_config": lambda.ResourceProvisionedConcurrencyConfig(),
 
@@ -1966,6 +1985,7 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_s3_bucket_server_side_encryption_configuration": s3.ResourceBucketServerSideEncryptionConfiguration(),
 			"aws_s3_bucket_versioning":                           s3.ResourceBucketVersioning(),
 			"aws_s3_bucket_website_configuration":                s3.ResourceBucketWebsiteConfiguration(),
+			"aws_s3_directory_sync":                              s3.ResourceDirectorySync(),
 			"aws_s3_object":                                      s3.ResourceObject(),
 			"aws_s3_object_copy":                                 s3.ResourceObjectCopy(),
 			"aws_s3_bucket_object":                               s3.ResourceBucketObject(), // DEPRECATED: use aws_s3_object instead
@@ -2193,6 +2213,9 @@ func New(_ context.Context) (*schema.Provider, error) {
 		},
 	}
 
+	// The catalog describes the provider's own resources, so it's registered once the provider exists.
+	provider.DataSourcesMap["aws_provider_catalog"] = dataSourceCatalog(provider)
+
 	provider.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 		return configure(ctx, provider, d)
 	}
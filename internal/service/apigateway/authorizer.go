@@ -29,9 +29,9 @@ func ResourceAuthorizer() *schema.Resource {
 
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-				idParts := strings.Split(d.Id(), "/")
-				if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-					return nil, fmt.Errorf("Unexpected format of ID (%q), expected REST-API-ID/AUTHORIZER-ID", d.Id())
+				idParts, err := authorizerImportIDFormat.Parse(d.Id())
+				if err != nil {
+					return nil, err
 				}
 				restAPIId := idParts[0]
 				authorizerId := idParts[1]
@@ -3,7 +3,6 @@ package apigateway
 import (
 	"fmt"
 	"log"
-	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/apigateway"
@@ -20,9 +19,9 @@ func ResourceGatewayResponse() *schema.Resource {
 		Delete: resourceGatewayResponseDelete,
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-				idParts := strings.Split(d.Id(), "/")
-				if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-					return nil, fmt.Errorf("Unexpected format of ID (%q), expected REST-API-ID/RESPONSE-TYPE", d.Id())
+				idParts, err := gatewayResponseImportIDFormat.Parse(d.Id())
+				if err != nil {
+					return nil, err
 				}
 				restApiID := idParts[0]
 				responseType := idParts[1]
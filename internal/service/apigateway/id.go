@@ -0,0 +1,124 @@
+package apigateway
+
+import (
+	"github.com/hashicorp/terraform-provider-aws/internal/importid"
+)
+
+var authorizerImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "REST-API-ID"},
+		{Name: "AUTHORIZER-ID"},
+	},
+	Separator: "/",
+}
+
+var modelImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "REST-API-ID"},
+		{Name: "NAME"},
+	},
+	Separator: "/",
+}
+
+var usagePlanKeyImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "USAGE-PLAN-ID"},
+		{Name: "USAGE-PLAN-KEY-ID"},
+	},
+	Separator: "/",
+}
+
+var integrationResponseImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "REST-API-ID"},
+		{Name: "RESOURCE-ID"},
+		{Name: "HTTP-METHOD"},
+		{Name: "STATUS-CODE"},
+	},
+	Separator: "/",
+}
+
+var methodResponseImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "REST-API-ID"},
+		{Name: "RESOURCE-ID"},
+		{Name: "HTTP-METHOD"},
+		{Name: "STATUS-CODE"},
+	},
+	Separator: "/",
+}
+
+// Method paths contain the separator.
+var methodSettingsImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "REST-API-ID"},
+		{Name: "STAGE-NAME"},
+		{Name: "METHOD-PATH", Greedy: true},
+	},
+	Separator: "/",
+}
+
+var integrationImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "REST-API-ID"},
+		{Name: "RESOURCE-ID"},
+		{Name: "HTTP-METHOD"},
+	},
+	Separator: "/",
+}
+
+var requestValidatorImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "REST-API-ID"},
+		{Name: "REQUEST-VALIDATOR-ID"},
+	},
+	Separator: "/",
+}
+
+var methodImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "REST-API-ID"},
+		{Name: "RESOURCE-ID"},
+		{Name: "HTTP-METHOD"},
+	},
+	Separator: "/",
+}
+
+var resourceImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "REST-API-ID"},
+		{Name: "RESOURCE-ID"},
+	},
+	Separator: "/",
+}
+
+var stageImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "REST-API-ID"},
+		{Name: "STAGE-NAME"},
+	},
+	Separator: "/",
+}
+
+var gatewayResponseImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "REST-API-ID"},
+		{Name: "RESPONSE-TYPE"},
+	},
+	Separator: "/",
+}
+
+func init() {
+	importid.Register("aws_api_gateway_authorizer", authorizerImportIDFormat)
+	importid.Register("aws_api_gateway_model", modelImportIDFormat)
+	importid.Register("aws_api_gateway_usage_plan_key", usagePlanKeyImportIDFormat)
+	importid.Register("aws_api_gateway_integration_response", integrationResponseImportIDFormat)
+	importid.Register("aws_api_gateway_method_response", methodResponseImportIDFormat)
+	importid.Register("aws_api_gateway_method_settings", methodSettingsImportIDFormat)
+	importid.Register("aws_api_gateway_integration", integrationImportIDFormat)
+	importid.Register("aws_api_gateway_request_validator", requestValidatorImportIDFormat)
+	importid.Register("aws_api_gateway_method", methodImportIDFormat)
+	importid.Register("aws_api_gateway_resource", resourceImportIDFormat)
+	importid.Register("aws_api_gateway_stage", stageImportIDFormat)
+	importid.Register("aws_api_gateway_gateway_response", gatewayResponseImportIDFormat)
+}
@@ -23,9 +23,9 @@ func ResourceIntegration() *schema.Resource {
 		Delete: resourceIntegrationDelete,
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-				idParts := strings.Split(d.Id(), "/")
-				if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
-					return nil, fmt.Errorf("Unexpected format of ID (%q), expected REST-API-ID/RESOURCE-ID/HTTP-METHOD", d.Id())
+				idParts, err := integrationImportIDFormat.Parse(d.Id())
+				if err != nil {
+					return nil, err
 				}
 				restApiID := idParts[0]
 				resourceID := idParts[1]
@@ -3,7 +3,6 @@ package apigateway
 import (
 	"fmt"
 	"log"
-	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/apigateway"
@@ -20,9 +19,9 @@ func ResourceIntegrationResponse() *schema.Resource {
 		Delete: resourceIntegrationResponseDelete,
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-				idParts := strings.Split(d.Id(), "/")
-				if len(idParts) != 4 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" || idParts[3] == "" {
-					return nil, fmt.Errorf("Unexpected format of ID (%q), expected REST-API-ID/RESOURCE-ID/HTTP-METHOD/STATUS-CODE", d.Id())
+				idParts, err := integrationResponseImportIDFormat.Parse(d.Id())
+				if err != nil {
+					return nil, err
 				}
 				restApiID := idParts[0]
 				resourceID := idParts[1]
@@ -3,7 +3,6 @@ package apigateway
 import (
 	"fmt"
 	"log"
-	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/apigateway"
@@ -296,9 +295,9 @@ func resourceMethodSettingsDelete(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceMethodSettingsImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	idParts := strings.SplitN(d.Id(), "/", 3)
-	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
-		return nil, fmt.Errorf("Unexpected format of ID (%q), expected REST-API-ID/STAGE-NAME/METHOD-PATH", d.Id())
+	idParts, err := methodSettingsImportIDFormat.Parse(d.Id())
+	if err != nil {
+		return nil, err
 	}
 	restApiID := idParts[0]
 	stageName := idParts[1]
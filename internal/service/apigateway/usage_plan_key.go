@@ -3,7 +3,6 @@ package apigateway
 import (
 	"fmt"
 	"log"
-	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/apigateway"
@@ -19,9 +18,9 @@ func ResourceUsagePlanKey() *schema.Resource {
 		Delete: resourceUsagePlanKeyDelete,
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-				idParts := strings.Split(d.Id(), "/")
-				if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-					return nil, fmt.Errorf("Unexpected format of ID (%q), expected USAGE-PLAN-ID/USAGE-PLAN-KEY-ID", d.Id())
+				idParts, err := usagePlanKeyImportIDFormat.Parse(d.Id())
+				if err != nil {
+					return nil, err
 				}
 				usagePlanId := idParts[0]
 				usagePlanKeyId := idParts[1]
@@ -3,7 +3,6 @@ package apigatewayv2
 import (
 	"fmt"
 	"log"
-	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/apigatewayv2"
@@ -134,9 +133,9 @@ func resourceAPIMappingDelete(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAPIMappingImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	parts := strings.Split(d.Id(), "/")
-	if len(parts) != 2 {
-		return []*schema.ResourceData{}, fmt.Errorf("wrong format of import ID (%s), use: 'api-mapping-id/domain-name'", d.Id())
+	parts, err := apiMappingImportIDFormat.Parse(d.Id())
+	if err != nil {
+		return []*schema.ResourceData{}, err
 	}
 
 	d.SetId(parts[0])
@@ -3,7 +3,6 @@ package apigatewayv2
 import (
 	"fmt"
 	"log"
-	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/apigatewayv2"
@@ -244,9 +243,9 @@ func resourceAuthorizerDelete(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAuthorizerImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	parts := strings.Split(d.Id(), "/")
-	if len(parts) != 2 {
-		return []*schema.ResourceData{}, fmt.Errorf("wrong format of import ID (%s), use: 'api-id/authorizer-id'", d.Id())
+	parts, err := authorizerImportIDFormat.Parse(d.Id())
+	if err != nil {
+		return []*schema.ResourceData{}, err
 	}
 
 	d.SetId(parts[1])
@@ -3,7 +3,6 @@ package apigatewayv2
 import (
 	"fmt"
 	"log"
-	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/apigatewayv2"
@@ -136,9 +135,9 @@ func resourceDeploymentDelete(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceDeploymentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	parts := strings.Split(d.Id(), "/")
-	if len(parts) != 2 {
-		return []*schema.ResourceData{}, fmt.Errorf("wrong format of import ID (%s), use: 'api-id/deployment-id'", d.Id())
+	parts, err := deploymentImportIDFormat.Parse(d.Id())
+	if err != nil {
+		return []*schema.ResourceData{}, err
 	}
 
 	d.SetId(parts[1])
@@ -0,0 +1,91 @@
+package apigatewayv2
+
+import (
+	"github.com/hashicorp/terraform-provider-aws/internal/importid"
+)
+
+var modelImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "api-id"},
+		{Name: "model-id"},
+	},
+	Separator: "/",
+}
+
+var apiMappingImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "api-mapping-id"},
+		{Name: "domain-name"},
+	},
+	Separator: "/",
+}
+
+var routeResponseImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "api-id"},
+		{Name: "route-id"},
+		{Name: "route-response-id"},
+	},
+	Separator: "/",
+}
+
+var routeImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "api-id"},
+		{Name: "route-id"},
+	},
+	Separator: "/",
+}
+
+var integrationResponseImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "api-id"},
+		{Name: "integration-id"},
+		{Name: "integration-response-id"},
+	},
+	Separator: "/",
+}
+
+var authorizerImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "api-id"},
+		{Name: "authorizer-id"},
+	},
+	Separator: "/",
+}
+
+var stageImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "api-id"},
+		{Name: "stage-name"},
+	},
+	Separator: "/",
+}
+
+var deploymentImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "api-id"},
+		{Name: "deployment-id"},
+	},
+	Separator: "/",
+}
+
+var integrationImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "api-id"},
+		{Name: "integration-id"},
+	},
+	Separator: "/",
+}
+
+func init() {
+	importid.Register("aws_apigatewayv2_model", modelImportIDFormat)
+	importid.Register("aws_apigatewayv2_api_mapping", apiMappingImportIDFormat)
+	importid.Register("aws_apigatewayv2_route_response", routeResponseImportIDFormat)
+	importid.Register("aws_apigatewayv2_route", routeImportIDFormat)
+	importid.Register("aws_apigatewayv2_integration_response", integrationResponseImportIDFormat)
+	importid.Register("aws_apigatewayv2_authorizer", authorizerImportIDFormat)
+	importid.Register("aws_apigatewayv2_stage", stageImportIDFormat)
+	importid.Register("aws_apigatewayv2_deployment", deploymentImportIDFormat)
+	importid.Register("aws_apigatewayv2_integration", integrationImportIDFormat)
+}
@@ -3,7 +3,6 @@ package apigatewayv2
 import (
 	"fmt"
 	"log"
-	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/apigatewayv2"
@@ -410,9 +409,9 @@ func resourceIntegrationDelete(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceIntegrationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	parts := strings.Split(d.Id(), "/")
-	if len(parts) != 2 {
-		return []*schema.ResourceData{}, fmt.Errorf("wrong format of import ID (%s), use: 'api-id/integration-id'", d.Id())
+	parts, err := integrationImportIDFormat.Parse(d.Id())
+	if err != nil {
+		return []*schema.ResourceData{}, err
 	}
 
 	apiId := parts[0]
@@ -3,7 +3,6 @@ package apigatewayv2
 import (
 	"fmt"
 	"log"
-	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/apigatewayv2"
@@ -167,9 +166,9 @@ func resourceIntegrationResponseDelete(d *schema.ResourceData, meta interface{})
 }
 
 func resourceIntegrationResponseImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	parts := strings.Split(d.Id(), "/")
-	if len(parts) != 3 {
-		return []*schema.ResourceData{}, fmt.Errorf("wrong format of import ID (%s), use: 'api-id/integration-id/integration-response-id'", d.Id())
+	parts, err := integrationResponseImportIDFormat.Parse(d.Id())
+	if err != nil {
+		return []*schema.ResourceData{}, err
 	}
 
 	d.SetId(parts[2])
@@ -0,0 +1,19 @@
+package appautoscaling
+
+import (
+	"github.com/hashicorp/terraform-provider-aws/internal/importid"
+)
+
+// Resource IDs contain the separator.
+var targetImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "service-namespace"},
+		{Name: "resource-id", Greedy: true},
+		{Name: "scalable-dimension"},
+	},
+	Separator: "/",
+}
+
+func init() {
+	importid.Register("aws_appautoscaling_target", targetImportIDFormat)
+}
@@ -3,7 +3,6 @@ package appautoscaling
 import (
 	"fmt"
 	"log"
-	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -212,19 +211,15 @@ func FindTargetByThreePartKey(conn *applicationautoscaling.ApplicationAutoScalin
 }
 
 func resourceTargetImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	idParts := strings.Split(d.Id(), "/")
+	idParts, err := targetImportIDFormat.Parse(d.Id())
 
-	if len(idParts) < 3 {
-		return nil, fmt.Errorf("unexpected format (%q), expected <service-namespace>/<resource-id>/<scalable-dimension>", d.Id())
+	if err != nil {
+		return nil, err
 	}
 
 	serviceNamespace := idParts[0]
-	resourceId := strings.Join(idParts[1:len(idParts)-1], "/")
-	scalableDimension := idParts[len(idParts)-1]
-
-	if serviceNamespace == "" || resourceId == "" || scalableDimension == "" {
-		return nil, fmt.Errorf("unexpected format (%q), expected <service-namespace>/<resource-id>/<scalable-dimension>", d.Id())
-	}
+	resourceId := idParts[1]
+	scalableDimension := idParts[2]
 
 	d.Set("service_namespace", serviceNamespace)
 	d.Set("resource_id", resourceId)
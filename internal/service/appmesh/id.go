@@ -0,0 +1,64 @@
+package appmesh
+
+import (
+	"github.com/hashicorp/terraform-provider-aws/internal/importid"
+)
+
+var routeImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "mesh-name"},
+		{Name: "virtual-router-name"},
+		{Name: "route-name"},
+	},
+	Separator: "/",
+}
+
+var virtualNodeImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "mesh-name"},
+		{Name: "virtual-node-name"},
+	},
+	Separator: "/",
+}
+
+var virtualRouterImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "mesh-name"},
+		{Name: "virtual-router-name"},
+	},
+	Separator: "/",
+}
+
+var virtualGatewayImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "mesh-name"},
+		{Name: "virtual-gateway-name"},
+	},
+	Separator: "/",
+}
+
+var virtualServiceImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "mesh-name"},
+		{Name: "virtual-service-name"},
+	},
+	Separator: "/",
+}
+
+var gatewayRouteImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "mesh-name"},
+		{Name: "virtual-gateway-name"},
+		{Name: "gateway-route-name"},
+	},
+	Separator: "/",
+}
+
+func init() {
+	importid.Register("aws_appmesh_route", routeImportIDFormat)
+	importid.Register("aws_appmesh_virtual_node", virtualNodeImportIDFormat)
+	importid.Register("aws_appmesh_virtual_router", virtualRouterImportIDFormat)
+	importid.Register("aws_appmesh_virtual_gateway", virtualGatewayImportIDFormat)
+	importid.Register("aws_appmesh_virtual_service", virtualServiceImportIDFormat)
+	importid.Register("aws_appmesh_gateway_route", gatewayRouteImportIDFormat)
+}
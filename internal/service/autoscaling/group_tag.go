@@ -6,10 +6,15 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/importid"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+func init() {
+	importid.Register("aws_autoscaling_group_tag", tftags.ResourceIDFormat)
+}
+
 func ResourceGroupTag() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceGroupTagCreate,
@@ -0,0 +1,35 @@
+package autoscaling
+
+import (
+	"github.com/hashicorp/terraform-provider-aws/internal/importid"
+)
+
+var scheduleImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "asg-name"},
+		{Name: "action-name"},
+	},
+	Separator: "/",
+}
+
+var lifecycleHookImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "asg-name"},
+		{Name: "lifecycle-hook-name", Greedy: true},
+	},
+	Separator: "/",
+}
+
+var policyImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "asg-name"},
+		{Name: "policy-name", Greedy: true},
+	},
+	Separator: "/",
+}
+
+func init() {
+	importid.Register("aws_autoscaling_schedule", scheduleImportIDFormat)
+	importid.Register("aws_autoscaling_lifecycle_hook", lifecycleHookImportIDFormat)
+	importid.Register("aws_autoscaling_policy", policyImportIDFormat)
+}
@@ -3,7 +3,6 @@ package autoscaling
 import (
 	"fmt"
 	"log"
-	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -199,9 +198,9 @@ func FindLifecycleHook(conn *autoscaling.AutoScaling, asgName, hookName string)
 }
 
 func resourceLifecycleHookImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	idParts := strings.SplitN(d.Id(), "/", 2)
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		return nil, fmt.Errorf("unexpected format (%q), expected <asg-name>/<lifecycle-hook-name>", d.Id())
+	idParts, err := lifecycleHookImportIDFormat.Parse(d.Id())
+	if err != nil {
+		return nil, err
 	}
 
 	asgName := idParts[0]
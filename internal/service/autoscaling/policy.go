@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"log"
 	"strconv"
-	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
@@ -516,9 +515,9 @@ func resourcePolicyDelete(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourcePolicyImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	idParts := strings.SplitN(d.Id(), "/", 2)
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		return nil, fmt.Errorf("unexpected format (%q), expected <asg-name>/<policy-name>", d.Id())
+	idParts, err := policyImportIDFormat.Parse(d.Id())
+	if err != nil {
+		return nil, err
 	}
 
 	asgName := idParts[0]
@@ -3,7 +3,6 @@ package autoscaling
 import (
 	"fmt"
 	"log"
-	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -211,15 +210,15 @@ func resourceScheduleDelete(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceScheduleImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	splitId := strings.Split(d.Id(), "/")
-	if len(splitId) != 2 {
-		return []*schema.ResourceData{}, fmt.Errorf("wrong format of import ID (%s), use: 'asg-name/action-name'", d.Id())
+	splitId, err := scheduleImportIDFormat.Parse(d.Id())
+	if err != nil {
+		return []*schema.ResourceData{}, err
 	}
 
 	asgName := splitId[0]
 	actionName := splitId[1]
 
-	err := d.Set("autoscaling_group_name", asgName)
+	err = d.Set("autoscaling_group_name", asgName)
 	if err != nil {
 		return []*schema.ResourceData{}, fmt.Errorf("failed to set autoscaling_group_name value")
 	}
@@ -0,0 +1,17 @@
+package backup
+
+import (
+	"github.com/hashicorp/terraform-provider-aws/internal/importid"
+)
+
+var selectionImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "plan-id"},
+		{Name: "selection-id"},
+	},
+	Separator: "|",
+}
+
+func init() {
+	importid.Register("aws_backup_selection", selectionImportIDFormat)
+}
@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"log"
 	"regexp"
-	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/backup"
@@ -351,9 +350,9 @@ func resourceSelectionDelete(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceSelectionImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	idParts := strings.Split(d.Id(), "|")
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		return nil, fmt.Errorf("unexpected format of ID (%q), expected <plan-id>|<selection-id>", d.Id())
+	idParts, err := selectionImportIDFormat.Parse(d.Id())
+	if err != nil {
+		return nil, err
 	}
 
 	planID := idParts[0]
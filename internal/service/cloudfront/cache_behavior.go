@@ -0,0 +1,306 @@
+package cloudfront
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// ResourceCacheBehavior manages a single ordered cache behavior of a distribution that is otherwise managed by
+// aws_cloudfront_distribution. The cache behavior is placed at its precedence in the distribution's list of cache behaviors.
+func ResourceCacheBehavior() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCacheBehaviorCreate,
+		ReadWithoutTimeout:   resourceCacheBehaviorRead,
+		UpdateWithoutTimeout: resourceCacheBehaviorUpdate,
+		DeleteWithoutTimeout: resourceCacheBehaviorDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: cacheBehaviorSchema(),
+	}
+}
+
+const (
+	ResNameCacheBehavior = "Cache Behavior"
+)
+
+// cacheBehaviorSchema returns the schema of an ordered cache behavior of aws_cloudfront_distribution, with the
+// arguments that identify the cache behavior's distribution and its position in the distribution's cache behaviors.
+func cacheBehaviorSchema() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{}
+
+	for k, v := range distributionCacheBehaviorSchema() {
+		s[k] = v
+	}
+
+	pathPattern := *s["path_pattern"]
+	pathPattern.ForceNew = true
+	s["path_pattern"] = &pathPattern
+
+	s["distribution_id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	}
+	s["precedence"] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Required:     true,
+		ValidateFunc: validation.IntAtLeast(0),
+	}
+	s["wait_for_deployment"] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  true,
+	}
+
+	return s
+}
+
+// distributionCacheBehaviorSchema returns the schema of an ordered cache behavior of aws_cloudfront_distribution.
+func distributionCacheBehaviorSchema() map[string]*schema.Schema {
+	return ResourceDistribution().Schema["ordered_cache_behavior"].Elem.(*schema.Resource).Schema
+}
+
+func resourceCacheBehaviorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CloudFrontConn
+
+	distributionID := d.Get("distribution_id").(string)
+	cacheBehavior := expandCacheBehaviorResource(d)
+	precedence := d.Get("precedence").(int)
+	id := CacheBehaviorCreateResourceID(distributionID, aws.StringValue(cacheBehavior.PathPattern))
+
+	err := updateDistributionConfig(ctx, conn, distributionID, func(config *cloudfront.DistributionConfig) (bool, error) {
+		if findCacheBehavior(config.CacheBehaviors, aws.StringValue(cacheBehavior.PathPattern)) >= 0 {
+			return false, fmt.Errorf("cache behavior (%s) already exists", aws.StringValue(cacheBehavior.PathPattern))
+		}
+
+		config.CacheBehaviors = insertCacheBehavior(config.CacheBehaviors, cacheBehavior, precedence)
+
+		return true, nil
+	})
+
+	if err != nil {
+		return create.DiagError(names.CloudFront, create.ErrActionCreating, ResNameCacheBehavior, id, err)
+	}
+
+	d.SetId(id)
+
+	if d.Get("wait_for_deployment").(bool) {
+		log.Printf("[DEBUG] Waiting until CloudFront Distribution (%s) is deployed", distributionID)
+		if err := DistributionWaitUntilDeployed(ctx, distributionID, meta); err != nil {
+			return create.DiagError(names.CloudFront, create.ErrActionWaitingForCreation, ResNameCacheBehavior, d.Id(), err)
+		}
+	}
+
+	if diags := resourceCacheBehaviorRead(ctx, d, meta); diags.HasError() {
+		return diags
+	}
+
+	// Cache behaviors created concurrently may have been inserted before this one since it was written.
+	d.Set("precedence", precedence)
+
+	return nil
+}
+
+func resourceCacheBehaviorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CloudFrontConn
+
+	distributionID, pathPattern, err := CacheBehaviorParseResourceID(d.Id())
+
+	if err != nil {
+		return create.DiagError(names.CloudFront, create.ErrActionReading, ResNameCacheBehavior, d.Id(), err)
+	}
+
+	output, err := findDistributionConfigByID(ctx, conn, distributionID)
+
+	i := -1
+
+	if err == nil {
+		i = findCacheBehavior(output.DistributionConfig.CacheBehaviors, pathPattern)
+
+		if i < 0 {
+			err = tfresource.NewEmptyResultError(pathPattern)
+		}
+	}
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		create.LogNotFoundRemoveState(names.CloudFront, create.ErrActionReading, ResNameCacheBehavior, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.CloudFront, create.ErrActionReading, ResNameCacheBehavior, d.Id(), err)
+	}
+
+	d.Set("distribution_id", distributionID)
+
+	// A cache behavior whose precedence is past the end of the list is the last cache behavior.
+	if n := len(output.DistributionConfig.CacheBehaviors.Items); i != n-1 || d.Get("precedence").(int) < i {
+		d.Set("precedence", i)
+	}
+
+	tfMap := flattenCacheBehavior(output.DistributionConfig.CacheBehaviors.Items[i])
+
+	for k := range distributionCacheBehaviorSchema() {
+		if err := d.Set(k, tfMap[k]); err != nil {
+			return create.DiagSettingError(names.CloudFront, ResNameCacheBehavior, d.Id(), k, err)
+		}
+	}
+
+	return nil
+}
+
+func resourceCacheBehaviorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CloudFrontConn
+
+	precedence := d.Get("precedence").(int)
+
+	if d.HasChangeExcept("wait_for_deployment") {
+		distributionID := d.Get("distribution_id").(string)
+		cacheBehavior := expandCacheBehaviorResource(d)
+
+		err := updateDistributionConfig(ctx, conn, distributionID, func(config *cloudfront.DistributionConfig) (bool, error) {
+			i := findCacheBehavior(config.CacheBehaviors, aws.StringValue(cacheBehavior.PathPattern))
+
+			if i < 0 {
+				return false, fmt.Errorf("cache behavior (%s) not found", aws.StringValue(cacheBehavior.PathPattern))
+			}
+
+			config.CacheBehaviors = removeCacheBehavior(config.CacheBehaviors, i)
+			config.CacheBehaviors = insertCacheBehavior(config.CacheBehaviors, cacheBehavior, precedence)
+
+			return true, nil
+		})
+
+		if err != nil {
+			return create.DiagError(names.CloudFront, create.ErrActionUpdating, ResNameCacheBehavior, d.Id(), err)
+		}
+
+		if d.Get("wait_for_deployment").(bool) {
+			log.Printf("[DEBUG] Waiting until CloudFront Distribution (%s) is deployed", distributionID)
+			if err := DistributionWaitUntilDeployed(ctx, distributionID, meta); err != nil {
+				return create.DiagError(names.CloudFront, create.ErrActionWaitingForUpdate, ResNameCacheBehavior, d.Id(), err)
+			}
+		}
+	}
+
+	if diags := resourceCacheBehaviorRead(ctx, d, meta); diags.HasError() {
+		return diags
+	}
+
+	// Cache behaviors created concurrently may have been inserted before this one since it was written.
+	d.Set("precedence", precedence)
+
+	return nil
+}
+
+func resourceCacheBehaviorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CloudFrontConn
+
+	distributionID := d.Get("distribution_id").(string)
+	pathPattern := d.Get("path_pattern").(string)
+
+	log.Printf("[INFO] Deleting CloudFront Cache Behavior %s", d.Id())
+	err := updateDistributionConfig(ctx, conn, distributionID, func(config *cloudfront.DistributionConfig) (bool, error) {
+		i := findCacheBehavior(config.CacheBehaviors, pathPattern)
+
+		if i < 0 {
+			return false, nil
+		}
+
+		config.CacheBehaviors = removeCacheBehavior(config.CacheBehaviors, i)
+
+		return true, nil
+	})
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.CloudFront, create.ErrActionDeleting, ResNameCacheBehavior, d.Id(), err)
+	}
+
+	if d.Get("wait_for_deployment").(bool) {
+		log.Printf("[DEBUG] Waiting until CloudFront Distribution (%s) is deployed", distributionID)
+		if err := DistributionWaitUntilDeployed(ctx, distributionID, meta); err != nil {
+			return create.DiagError(names.CloudFront, create.ErrActionWaitingForDeletion, ResNameCacheBehavior, d.Id(), err)
+		}
+	}
+
+	return nil
+}
+
+// findCacheBehavior returns the index of the cache behavior with the specified path pattern, or -1.
+func findCacheBehavior(cacheBehaviors *cloudfront.CacheBehaviors, pathPattern string) int {
+	if cacheBehaviors == nil {
+		return -1
+	}
+
+	for i, v := range cacheBehaviors.Items {
+		if aws.StringValue(v.PathPattern) == pathPattern {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// insertCacheBehavior inserts a cache behavior at the specified precedence, or at the end of the
+// cache behaviors if there are fewer cache behaviors than the precedence.
+func insertCacheBehavior(cacheBehaviors *cloudfront.CacheBehaviors, cacheBehavior *cloudfront.CacheBehavior, precedence int) *cloudfront.CacheBehaviors {
+	var items []*cloudfront.CacheBehavior
+
+	if cacheBehaviors != nil {
+		items = cacheBehaviors.Items
+	}
+
+	if precedence > len(items) {
+		precedence = len(items)
+	}
+
+	items = append(items[:precedence:precedence], append([]*cloudfront.CacheBehavior{cacheBehavior}, items[precedence:]...)...)
+
+	return &cloudfront.CacheBehaviors{
+		Items:    items,
+		Quantity: aws.Int64(int64(len(items))),
+	}
+}
+
+// removeCacheBehavior removes the cache behavior at the specified index.
+func removeCacheBehavior(cacheBehaviors *cloudfront.CacheBehaviors, i int) *cloudfront.CacheBehaviors {
+	items := append(cacheBehaviors.Items[:i:i], cacheBehaviors.Items[i+1:]...)
+
+	if len(items) == 0 {
+		items = nil
+	}
+
+	return &cloudfront.CacheBehaviors{
+		Items:    items,
+		Quantity: aws.Int64(int64(len(items))),
+	}
+}
+
+func expandCacheBehaviorResource(d *schema.ResourceData) *cloudfront.CacheBehavior {
+	tfMap := map[string]interface{}{}
+
+	for k := range distributionCacheBehaviorSchema() {
+		tfMap[k] = d.Get(k)
+	}
+
+	return expandCacheBehavior(tfMap)
+}
@@ -0,0 +1,226 @@
+package cloudfront_test
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfcloudfront "github.com/hashicorp/terraform-provider-aws/internal/service/cloudfront"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCloudFrontCacheBehavior_basic(t *testing.T) {
+	resourceName := "aws_cloudfront_cache_behavior.test"
+	distributionResourceName := "aws_cloudfront_distribution.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(cloudfront.EndpointsID, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, cloudfront.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDistributionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCacheBehaviorConfig_basic(0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCacheBehaviorExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "distribution_id", distributionResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "path_pattern", "/images/*"),
+					resource.TestCheckResourceAttr(resourceName, "precedence", "0"),
+					resource.TestCheckResourceAttr(resourceName, "target_origin_id", "test"),
+					resource.TestCheckResourceAttr(resourceName, "viewer_protocol_policy", "redirect-to-https"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait_for_deployment"},
+			},
+			{
+				// A precedence past the end of the list of cache behaviors places the cache behavior last.
+				Config: testAccCacheBehaviorConfig_basic(10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCacheBehaviorExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "precedence", "10"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudFrontCacheBehavior_precedence(t *testing.T) {
+	resourceName1 := "aws_cloudfront_cache_behavior.test1"
+	resourceName2 := "aws_cloudfront_cache_behavior.test2"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(cloudfront.EndpointsID, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, cloudfront.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDistributionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCacheBehaviorConfig_precedence(0, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCacheBehaviorExists(resourceName1),
+					testAccCheckCacheBehaviorExists(resourceName2),
+					resource.TestCheckResourceAttr(resourceName1, "precedence", "0"),
+					resource.TestCheckResourceAttr(resourceName2, "precedence", "1"),
+				),
+			},
+			{
+				Config: testAccCacheBehaviorConfig_precedence(1, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCacheBehaviorExists(resourceName1),
+					testAccCheckCacheBehaviorExists(resourceName2),
+					resource.TestCheckResourceAttr(resourceName1, "precedence", "1"),
+					resource.TestCheckResourceAttr(resourceName2, "precedence", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudFrontCacheBehavior_disappears(t *testing.T) {
+	resourceName := "aws_cloudfront_cache_behavior.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(cloudfront.EndpointsID, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, cloudfront.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDistributionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCacheBehaviorConfig_basic(0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCacheBehaviorExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfcloudfront.ResourceCacheBehavior(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+// testAccCheckCacheBehaviorExists checks that the cache behavior exists at its precedence,
+// or last if its precedence is past the end of the distribution's cache behaviors.
+func testAccCheckCacheBehaviorExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.CloudFront, create.ErrActionCheckingExistence, tfcloudfront.ResNameCacheBehavior, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.CloudFront, create.ErrActionCheckingExistence, tfcloudfront.ResNameCacheBehavior, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFrontConn
+
+		output, err := tfcloudfront.FindDistributionByID(conn, rs.Primary.Attributes["distribution_id"])
+
+		if err != nil {
+			return create.Error(names.CloudFront, create.ErrActionCheckingExistence, tfcloudfront.ResNameCacheBehavior, rs.Primary.ID, err)
+		}
+
+		precedence, err := strconv.Atoi(rs.Primary.Attributes["precedence"])
+
+		if err != nil {
+			return err
+		}
+
+		items := output.Distribution.DistributionConfig.CacheBehaviors.Items
+
+		for i, v := range items {
+			if aws.StringValue(v.PathPattern) != rs.Primary.Attributes["path_pattern"] {
+				continue
+			}
+
+			if i != precedence && !(i == len(items)-1 && precedence > i) {
+				return create.Error(names.CloudFront, create.ErrActionCheckingExistence, tfcloudfront.ResNameCacheBehavior, rs.Primary.ID, fmt.Errorf("found at precedence %d, expected %d", i, precedence))
+			}
+
+			return nil
+		}
+
+		return create.Error(names.CloudFront, create.ErrActionCheckingExistence, tfcloudfront.ResNameCacheBehavior, rs.Primary.ID, errors.New("not found in distribution"))
+	}
+}
+
+func testAccCacheBehaviorConfig_basic(precedence int) string {
+	return acctest.ConfigCompose(testAccDistributionPartConfig_base(), fmt.Sprintf(`
+resource "aws_cloudfront_cache_behavior" "test" {
+  distribution_id        = aws_cloudfront_distribution.test.id
+  path_pattern           = "/images/*"
+  precedence             = %[1]d
+  allowed_methods        = ["GET", "HEAD"]
+  cached_methods         = ["GET", "HEAD"]
+  target_origin_id       = "test"
+  viewer_protocol_policy = "redirect-to-https"
+
+  forwarded_values {
+    query_string = false
+
+    cookies {
+      forward = "none"
+    }
+  }
+}
+`, precedence))
+}
+
+func testAccCacheBehaviorConfig_precedence(precedence1, precedence2 int) string {
+	return acctest.ConfigCompose(testAccDistributionPartConfig_base(), fmt.Sprintf(`
+resource "aws_cloudfront_cache_behavior" "test1" {
+  distribution_id        = aws_cloudfront_distribution.test.id
+  path_pattern           = "/images/*"
+  precedence             = %[1]d
+  allowed_methods        = ["GET", "HEAD"]
+  cached_methods         = ["GET", "HEAD"]
+  target_origin_id       = "test"
+  viewer_protocol_policy = "allow-all"
+
+  forwarded_values {
+    query_string = false
+
+    cookies {
+      forward = "none"
+    }
+  }
+}
+
+resource "aws_cloudfront_cache_behavior" "test2" {
+  distribution_id        = aws_cloudfront_distribution.test.id
+  path_pattern           = "/images/*.jpg"
+  precedence             = %[2]d
+  allowed_methods        = ["GET", "HEAD"]
+  cached_methods         = ["GET", "HEAD"]
+  target_origin_id       = "test"
+  viewer_protocol_policy = "allow-all"
+
+  forwarded_values {
+    query_string = false
+
+    cookies {
+      forward = "none"
+    }
+  }
+}
+`, precedence1, precedence2))
+}
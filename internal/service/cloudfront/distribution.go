@@ -1,6 +1,7 @@
 package cloudfront
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -9,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/service/cloudfront"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -23,10 +25,10 @@ import (
 func ResourceDistribution() *schema.Resource {
 	//lintignore:R011
 	return &schema.Resource{
-		Create: resourceDistributionCreate,
-		Read:   resourceDistributionRead,
-		Update: resourceDistributionUpdate,
-		Delete: resourceDistributionDelete,
+		CreateWithoutTimeout: resourceDistributionCreate,
+		ReadWithoutTimeout:   resourceDistributionRead,
+		UpdateWithoutTimeout: resourceDistributionUpdate,
+		DeleteWithoutTimeout: resourceDistributionDelete,
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				// Set non API attributes to their Default settings in the schema
@@ -816,6 +818,16 @@ func ResourceDistribution() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"continuous_deployment_policy_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"staging": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
 
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
@@ -825,7 +837,7 @@ func ResourceDistribution() *schema.Resource {
 	}
 }
 
-func resourceDistributionCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceDistributionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).CloudFrontConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
@@ -836,12 +848,13 @@ func resourceDistributionCreate(d *schema.ResourceData, meta interface{}) error
 			Tags:               &cloudfront.Tags{Items: Tags(tags.IgnoreAWS())},
 		},
 	}
+	params.DistributionConfigWithTags.DistributionConfig.Staging = aws.Bool(d.Get("staging").(bool))
 
 	var resp *cloudfront.CreateDistributionWithTagsOutput
 	// Handle eventual consistency issues
-	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 1*time.Minute, func() *resource.RetryError {
 		var err error
-		resp, err = conn.CreateDistributionWithTags(params)
+		resp, err = conn.CreateDistributionWithTagsWithContext(ctx, params)
 
 		// ACM and IAM certificate eventual consistency
 		// InvalidViewerCertificate: The specified SSL certificate doesn't exist, isn't in us-east-1 region, isn't valid, or doesn't include a valid certificate chain.
@@ -858,26 +871,40 @@ func resourceDistributionCreate(d *schema.ResourceData, meta interface{}) error
 
 	// Propagate AWS Go SDK retried error, if any
 	if tfresource.TimedOut(err) {
-		resp, err = conn.CreateDistributionWithTags(params)
+		resp, err = conn.CreateDistributionWithTagsWithContext(ctx, params)
 	}
 
 	if err != nil {
-		return fmt.Errorf("error creating CloudFront Distribution: %s", err)
+		return diag.Errorf("error creating CloudFront Distribution: %s", err)
 	}
 
 	d.SetId(aws.StringValue(resp.Distribution.Id))
 
+	// A continuous deployment policy can only be attached to an existing distribution.
+	if v, ok := d.GetOk("continuous_deployment_policy_id"); ok {
+		input := &cloudfront.UpdateDistributionInput{
+			DistributionConfig: resp.Distribution.DistributionConfig,
+			Id:                 resp.Distribution.Id,
+			IfMatch:            resp.ETag,
+		}
+		input.DistributionConfig.ContinuousDeploymentPolicyId = aws.String(v.(string))
+
+		if _, err := conn.UpdateDistributionWithContext(ctx, input); err != nil {
+			return diag.Errorf("error attaching continuous deployment policy (%s) to CloudFront Distribution (%s): %s", v.(string), d.Id(), err)
+		}
+	}
+
 	if d.Get("wait_for_deployment").(bool) {
 		log.Printf("[DEBUG] Waiting until CloudFront Distribution (%s) is deployed", d.Id())
-		if err := DistributionWaitUntilDeployed(d.Id(), meta); err != nil {
-			return fmt.Errorf("error waiting until CloudFront Distribution (%s) is deployed: %s", d.Id(), err)
+		if err := DistributionWaitUntilDeployed(ctx, d.Id(), meta); err != nil {
+			return diag.Errorf("error waiting until CloudFront Distribution (%s) is deployed: %s", d.Id(), err)
 		}
 	}
 
-	return resourceDistributionRead(d, meta)
+	return resourceDistributionRead(ctx, d, meta)
 }
 
-func resourceDistributionRead(d *schema.ResourceData, meta interface{}) error {
+func resourceDistributionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).CloudFrontConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
@@ -886,7 +913,7 @@ func resourceDistributionRead(d *schema.ResourceData, meta interface{}) error {
 		Id: aws.String(d.Id()),
 	}
 
-	resp, err := conn.GetDistribution(params)
+	resp, err := conn.GetDistributionWithContext(ctx, params)
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, cloudfront.ErrCodeNoSuchDistribution) {
 		create.LogNotFoundRemoveState(names.CloudFront, create.ErrActionReading, ResNameDistribution, d.Id())
 		d.SetId("")
@@ -894,21 +921,21 @@ func resourceDistributionRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if err != nil {
-		return create.Error(names.CloudFront, create.ErrActionReading, ResNameDistribution, d.Id(), err)
+		return create.DiagError(names.CloudFront, create.ErrActionReading, ResNameDistribution, d.Id(), err)
 	}
 
 	// Update attributes from DistributionConfig
 	err = flattenDistributionConfig(d, resp.Distribution.DistributionConfig)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Update other attributes outside of DistributionConfig
 	if err := d.Set("trusted_key_groups", flattenActiveTrustedKeyGroups(resp.Distribution.ActiveTrustedKeyGroups)); err != nil {
-		return fmt.Errorf("error setting trusted_key_groups: %w", err)
+		return diag.Errorf("error setting trusted_key_groups: %s", err)
 	}
 	if err := d.Set("trusted_signers", flattenActiveTrustedSigners(resp.Distribution.ActiveTrustedSigners)); err != nil {
-		return fmt.Errorf("error setting trusted_signers: %w", err)
+		return diag.Errorf("error setting trusted_signers: %s", err)
 	}
 	d.Set("status", resp.Distribution.Status)
 	d.Set("domain_name", resp.Distribution.DomainName)
@@ -916,6 +943,8 @@ func resourceDistributionRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("in_progress_validation_batches", resp.Distribution.InProgressInvalidationBatches)
 	d.Set("etag", resp.ETag)
 	d.Set("arn", resp.Distribution.ARN)
+	d.Set("continuous_deployment_policy_id", resp.Distribution.DistributionConfig.ContinuousDeploymentPolicyId)
+	d.Set("staging", resp.Distribution.DistributionConfig.Staging)
 
 	// override hosted_zone_id from flattenDistributionConfig
 	region := meta.(*conns.AWSClient).Region
@@ -925,35 +954,35 @@ func resourceDistributionRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("hosted_zone_id", route53ZoneID)
 	}
 
-	tags, err := ListTags(conn, d.Get("arn").(string))
+	tags, err := ListTagsWithContext(ctx, conn, d.Get("arn").(string))
 	if err != nil {
-		return fmt.Errorf("error listing tags for CloudFront Distribution (%s): %s", d.Id(), err)
+		return diag.Errorf("error listing tags for CloudFront Distribution (%s): %s", d.Id(), err)
 	}
 	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
 	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
-		return fmt.Errorf("error setting tags: %w", err)
+		return diag.Errorf("error setting tags: %s", err)
 	}
 
 	if err := d.Set("tags_all", tags.Map()); err != nil {
-		return fmt.Errorf("error setting tags_all: %w", err)
+		return diag.Errorf("error setting tags_all: %s", err)
 	}
 
 	return nil
 }
 
-func resourceDistributionUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceDistributionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).CloudFrontConn
 	params := &cloudfront.UpdateDistributionInput{
 		Id:                 aws.String(d.Id()),
-		DistributionConfig: expandDistributionConfig(d),
+		DistributionConfig: expandDistributionUpdateConfig(d),
 		IfMatch:            aws.String(d.Get("etag").(string)),
 	}
 
 	// Handle eventual consistency issues
-	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
-		_, err := conn.UpdateDistribution(params)
+	err := resource.RetryContext(ctx, 1*time.Minute, func() *resource.RetryError {
+		_, err := conn.UpdateDistributionWithContext(ctx, params)
 
 		// ACM and IAM certificate eventual consistency
 		// InvalidViewerCertificate: The specified SSL certificate doesn't exist, isn't in us-east-1 region, isn't valid, or doesn't include a valid certificate chain.
@@ -968,56 +997,67 @@ func resourceDistributionUpdate(d *schema.ResourceData, meta interface{}) error
 		return nil
 	})
 
-	// Refresh our ETag if it is out of date and attempt update again
+	// The distribution changed since it was read, e.g. an aws_cloudfront_origin or aws_cloudfront_cache_behavior
+	// resource updated it. Apply the configuration to the current one instead, keeping its origins and
+	// ordered cache behaviors unless they are changed by this resource, so that those changes are not lost.
 	if tfawserr.ErrCodeEquals(err, cloudfront.ErrCodePreconditionFailed) {
-		getDistributionInput := &cloudfront.GetDistributionInput{
-			Id: aws.String(d.Id()),
-		}
-		var getDistributionOutput *cloudfront.GetDistributionOutput
+		log.Printf("[DEBUG] CloudFront Distribution (%s) changed since it was read, merging configuration", d.Id())
+		err = updateDistributionConfig(ctx, conn, d.Id(), func(config *cloudfront.DistributionConfig) (bool, error) {
+			origins, cacheBehaviors := config.Origins, config.CacheBehaviors
 
-		log.Printf("[DEBUG] Refreshing CloudFront Distribution (%s) ETag", d.Id())
-		getDistributionOutput, err = conn.GetDistribution(getDistributionInput)
-
-		if err != nil {
-			return fmt.Errorf("error refreshing CloudFront Distribution (%s) ETag: %s", d.Id(), err)
-		}
+			*config = *expandDistributionUpdateConfig(d)
 
-		if getDistributionOutput == nil {
-			return fmt.Errorf("error refreshing CloudFront Distribution (%s) ETag: empty response", d.Id())
-		}
+			if !d.HasChange("origin") {
+				config.Origins = origins
+			}
 
-		params.IfMatch = getDistributionOutput.ETag
+			if !d.HasChange("ordered_cache_behavior") {
+				config.CacheBehaviors = cacheBehaviors
+			}
 
-		_, err = conn.UpdateDistribution(params)
+			return true, nil
+		})
 	}
 
 	// Propagate AWS Go SDK retried error, if any
 	if tfresource.TimedOut(err) {
-		_, err = conn.UpdateDistribution(params)
+		_, err = conn.UpdateDistributionWithContext(ctx, params)
 	}
 
 	if err != nil {
-		return fmt.Errorf("error updating CloudFront Distribution (%s): %s", d.Id(), err)
+		return diag.Errorf("error updating CloudFront Distribution (%s): %s", d.Id(), err)
 	}
 
 	if d.Get("wait_for_deployment").(bool) {
 		log.Printf("[DEBUG] Waiting until CloudFront Distribution (%s) is deployed", d.Id())
-		if err := DistributionWaitUntilDeployed(d.Id(), meta); err != nil {
-			return fmt.Errorf("error waiting until CloudFront Distribution (%s) is deployed: %s", d.Id(), err)
+		if err := DistributionWaitUntilDeployed(ctx, d.Id(), meta); err != nil {
+			return diag.Errorf("error waiting until CloudFront Distribution (%s) is deployed: %s", d.Id(), err)
 		}
 	}
 
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
-		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
-			return fmt.Errorf("error updating tags for CloudFront Distribution (%s): %s", d.Id(), err)
+		if err := UpdateTagsWithContext(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating tags for CloudFront Distribution (%s): %s", d.Id(), err)
 		}
 	}
 
-	return resourceDistributionRead(d, meta)
+	return resourceDistributionRead(ctx, d, meta)
 }
 
-func resourceDistributionDelete(d *schema.ResourceData, meta interface{}) error {
+// expandDistributionUpdateConfig returns the distribution configuration to write on update.
+func expandDistributionUpdateConfig(d *schema.ResourceData) *cloudfront.DistributionConfig {
+	config := expandDistributionConfig(d)
+	config.Staging = aws.Bool(d.Get("staging").(bool))
+
+	if v, ok := d.GetOk("continuous_deployment_policy_id"); ok {
+		config.ContinuousDeploymentPolicyId = aws.String(v.(string))
+	}
+
+	return config
+}
+
+func resourceDistributionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).CloudFrontConn
 
 	if d.Get("retain_on_delete").(bool) {
@@ -1027,14 +1067,14 @@ func resourceDistributionDelete(d *schema.ResourceData, meta interface{}) error
 		}
 
 		log.Printf("[DEBUG] Refreshing CloudFront Distribution (%s) to check if disable is necessary", d.Id())
-		getDistributionOutput, err := conn.GetDistribution(getDistributionInput)
+		getDistributionOutput, err := conn.GetDistributionWithContext(ctx, getDistributionInput)
 
 		if err != nil {
-			return fmt.Errorf("error refreshing CloudFront Distribution (%s) to check if disable is necessary: %s", d.Id(), err)
+			return diag.Errorf("error refreshing CloudFront Distribution (%s) to check if disable is necessary: %s", d.Id(), err)
 		}
 
 		if getDistributionOutput == nil || getDistributionOutput.Distribution == nil || getDistributionOutput.Distribution.DistributionConfig == nil {
-			return fmt.Errorf("error refreshing CloudFront Distribution (%s) to check if disable is necessary: empty response", d.Id())
+			return diag.Errorf("error refreshing CloudFront Distribution (%s) to check if disable is necessary: empty response", d.Id())
 		}
 
 		if !aws.BoolValue(getDistributionOutput.Distribution.DistributionConfig.Enabled) {
@@ -1048,12 +1088,14 @@ func resourceDistributionDelete(d *schema.ResourceData, meta interface{}) error
 			IfMatch:            getDistributionOutput.ETag,
 		}
 		updateDistributionInput.DistributionConfig.Enabled = aws.Bool(false)
+		// Disabling the distribution also removes any continuous deployment policy, so that the policy can be deleted.
+		updateDistributionInput.DistributionConfig.ContinuousDeploymentPolicyId = nil
 
 		log.Printf("[DEBUG] Disabling CloudFront Distribution: %s", d.Id())
-		_, err = conn.UpdateDistribution(updateDistributionInput)
+		_, err = conn.UpdateDistributionWithContext(ctx, updateDistributionInput)
 
 		if err != nil {
-			return fmt.Errorf("error disabling CloudFront Distribution (%s): %s", d.Id(), err)
+			return diag.Errorf("error disabling CloudFront Distribution (%s): %s", d.Id(), err)
 		}
 
 		log.Printf("[WARN] Removing CloudFront Distribution ID %q with `retain_on_delete` set. Please delete this distribution manually.", d.Id())
@@ -1066,7 +1108,7 @@ func resourceDistributionDelete(d *schema.ResourceData, meta interface{}) error
 	}
 
 	log.Printf("[DEBUG] Deleting CloudFront Distribution: %s", d.Id())
-	_, err := conn.DeleteDistribution(deleteDistributionInput)
+	_, err := conn.DeleteDistributionWithContext(ctx, deleteDistributionInput)
 
 	if err == nil || tfawserr.ErrCodeEquals(err, cloudfront.ErrCodeNoSuchDistribution) {
 		return nil
@@ -1080,19 +1122,19 @@ func resourceDistributionDelete(d *schema.ResourceData, meta interface{}) error
 		var getDistributionOutput *cloudfront.GetDistributionOutput
 
 		log.Printf("[DEBUG] Refreshing CloudFront Distribution (%s) ETag", d.Id())
-		getDistributionOutput, err = conn.GetDistribution(getDistributionInput)
+		getDistributionOutput, err = conn.GetDistributionWithContext(ctx, getDistributionInput)
 
 		if err != nil {
-			return fmt.Errorf("error refreshing CloudFront Distribution (%s) ETag: %s", d.Id(), err)
+			return diag.Errorf("error refreshing CloudFront Distribution (%s) ETag: %s", d.Id(), err)
 		}
 
 		if getDistributionOutput == nil {
-			return fmt.Errorf("error refreshing CloudFront Distribution (%s) ETag: empty response", d.Id())
+			return diag.Errorf("error refreshing CloudFront Distribution (%s) ETag: empty response", d.Id())
 		}
 
 		deleteDistributionInput.IfMatch = getDistributionOutput.ETag
 
-		_, err = conn.DeleteDistribution(deleteDistributionInput)
+		_, err = conn.DeleteDistributionWithContext(ctx, deleteDistributionInput)
 	}
 
 	// Disable distribution if it is not yet disabled and attempt deletion again.
@@ -1105,14 +1147,14 @@ func resourceDistributionDelete(d *schema.ResourceData, meta interface{}) error
 		var getDistributionOutput *cloudfront.GetDistributionOutput
 
 		log.Printf("[DEBUG] Refreshing CloudFront Distribution (%s) to disable", d.Id())
-		getDistributionOutput, err = conn.GetDistribution(getDistributionInput)
+		getDistributionOutput, err = conn.GetDistributionWithContext(ctx, getDistributionInput)
 
 		if err != nil {
-			return fmt.Errorf("error refreshing CloudFront Distribution (%s) to disable: %s", d.Id(), err)
+			return diag.Errorf("error refreshing CloudFront Distribution (%s) to disable: %s", d.Id(), err)
 		}
 
 		if getDistributionOutput == nil || getDistributionOutput.Distribution == nil {
-			return fmt.Errorf("error refreshing CloudFront Distribution (%s) to disable: empty response", d.Id())
+			return diag.Errorf("error refreshing CloudFront Distribution (%s) to disable: empty response", d.Id())
 		}
 
 		updateDistributionInput := &cloudfront.UpdateDistributionInput{
@@ -1121,30 +1163,32 @@ func resourceDistributionDelete(d *schema.ResourceData, meta interface{}) error
 			IfMatch:            getDistributionOutput.ETag,
 		}
 		updateDistributionInput.DistributionConfig.Enabled = aws.Bool(false)
+		// Disabling the distribution also removes any continuous deployment policy, so that the policy can be deleted.
+		updateDistributionInput.DistributionConfig.ContinuousDeploymentPolicyId = nil
 		var updateDistributionOutput *cloudfront.UpdateDistributionOutput
 
 		log.Printf("[DEBUG] Disabling CloudFront Distribution: %s", d.Id())
-		updateDistributionOutput, err = conn.UpdateDistribution(updateDistributionInput)
+		updateDistributionOutput, err = conn.UpdateDistributionWithContext(ctx, updateDistributionInput)
 
 		if err != nil {
-			return fmt.Errorf("error disabling CloudFront Distribution (%s): %s", d.Id(), err)
+			return diag.Errorf("error disabling CloudFront Distribution (%s): %s", d.Id(), err)
 		}
 
 		log.Printf("[DEBUG] Waiting until CloudFront Distribution (%s) is deployed", d.Id())
-		if err := DistributionWaitUntilDeployed(d.Id(), meta); err != nil {
-			return fmt.Errorf("error waiting until CloudFront Distribution (%s) is deployed: %s", d.Id(), err)
+		if err := DistributionWaitUntilDeployed(ctx, d.Id(), meta); err != nil {
+			return diag.Errorf("error waiting until CloudFront Distribution (%s) is deployed: %s", d.Id(), err)
 		}
 
 		deleteDistributionInput.IfMatch = updateDistributionOutput.ETag
 
-		_, err = conn.DeleteDistribution(deleteDistributionInput)
+		_, err = conn.DeleteDistributionWithContext(ctx, deleteDistributionInput)
 
 		// CloudFront has eventual consistency issues even for "deployed" state.
 		// Occasionally the DeleteDistribution call will return this error as well, in which retries will succeed:
 		//   * PreconditionFailed: The request failed because it didn't meet the preconditions in one or more request-header fields
 		if tfawserr.ErrCodeEquals(err, cloudfront.ErrCodeDistributionNotDisabled) || tfawserr.ErrCodeEquals(err, cloudfront.ErrCodePreconditionFailed) {
-			err = resource.Retry(2*time.Minute, func() *resource.RetryError {
-				_, err := conn.DeleteDistribution(deleteDistributionInput)
+			err = resource.RetryContext(ctx, 2*time.Minute, func() *resource.RetryError {
+				_, err := conn.DeleteDistributionWithContext(ctx, deleteDistributionInput)
 
 				if tfawserr.ErrCodeEquals(err, cloudfront.ErrCodeDistributionNotDisabled) {
 					return resource.RetryableError(err)
@@ -1167,7 +1211,7 @@ func resourceDistributionDelete(d *schema.ResourceData, meta interface{}) error
 
 			// Propagate AWS Go SDK retried error, if any
 			if tfresource.TimedOut(err) {
-				_, err = conn.DeleteDistribution(deleteDistributionInput)
+				_, err = conn.DeleteDistributionWithContext(ctx, deleteDistributionInput)
 			}
 		}
 	}
@@ -1177,7 +1221,7 @@ func resourceDistributionDelete(d *schema.ResourceData, meta interface{}) error
 	}
 
 	if err != nil {
-		return fmt.Errorf("CloudFront Distribution %s cannot be deleted: %s", d.Id(), err)
+		return diag.Errorf("CloudFront Distribution %s cannot be deleted: %s", d.Id(), err)
 	}
 
 	return nil
@@ -1186,29 +1230,28 @@ func resourceDistributionDelete(d *schema.ResourceData, meta interface{}) error
 // resourceAwsCloudFrontWebDistributionWaitUntilDeployed blocks until the
 // distribution is deployed. It currently takes exactly 15 minutes to deploy
 // but that might change in the future.
-func DistributionWaitUntilDeployed(id string, meta interface{}) error {
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"InProgress"},
-		Target:     []string{"Deployed"},
-		Refresh:    resourceWebDistributionStateRefreshFunc(id, meta),
-		Timeout:    90 * time.Minute,
-		MinTimeout: 15 * time.Second,
-		Delay:      1 * time.Minute,
-	}
+func DistributionWaitUntilDeployed(ctx context.Context, id string, meta interface{}) error {
+	_, err := tfresource.Wait(ctx, resourceWebDistributionStateRefreshFunc(id, meta), tfresource.WaiterOpts{
+		Description:     fmt.Sprintf("CloudFront Distribution (%s) deployment", id),
+		Pending:         []string{"InProgress"},
+		Target:          []string{"Deployed"},
+		Timeout:         90 * time.Minute,
+		MinPollInterval: 15 * time.Second,
+		Delay:           1 * time.Minute,
+	})
 
-	_, err := stateConf.WaitForState()
 	return err
 }
 
-// The refresh function for resourceAwsCloudFrontWebDistributionWaitUntilDeployed.
-func resourceWebDistributionStateRefreshFunc(id string, meta interface{}) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+// The refresh function for DistributionWaitUntilDeployed.
+func resourceWebDistributionStateRefreshFunc(id string, meta interface{}) func(context.Context) (*cloudfront.Distribution, string, error) {
+	return func(ctx context.Context) (*cloudfront.Distribution, string, error) {
 		conn := meta.(*conns.AWSClient).CloudFrontConn
 		params := &cloudfront.GetDistributionInput{
 			Id: aws.String(id),
 		}
 
-		resp, err := conn.GetDistribution(params)
+		resp, err := conn.GetDistributionWithContext(ctx, params)
 		if err != nil {
 			log.Printf("[WARN] Error retrieving CloudFront Distribution %q details: %s", id, err)
 			return nil, "", err
@@ -0,0 +1,74 @@
+package cloudfront
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	distributionConfigUpdateTimeout = 5 * time.Minute
+)
+
+// findDistributionConfigByID returns the configuration of a distribution and its ETag.
+func findDistributionConfigByID(ctx context.Context, conn *cloudfront.CloudFront, id string) (*cloudfront.GetDistributionConfigOutput, error) {
+	input := &cloudfront.GetDistributionConfigInput{
+		Id: aws.String(id),
+	}
+
+	output, err := conn.GetDistributionConfigWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, cloudfront.ErrCodeNoSuchDistribution) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.DistributionConfig == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+// updateDistributionConfig reads the configuration of a distribution, applies update to it and writes it back.
+// The write is conditional on the ETag that was read, so that concurrent changes to the distribution by other
+// resources are not lost: if the distribution changed in the meantime, the read, update and write are retried.
+// update returns false if the configuration does not need to be written.
+func updateDistributionConfig(ctx context.Context, conn *cloudfront.CloudFront, id string, update func(*cloudfront.DistributionConfig) (bool, error)) error {
+	_, err := tfresource.RetryWhenAWSErrCodeEqualsContext(ctx, distributionConfigUpdateTimeout, func() (interface{}, error) {
+		output, err := findDistributionConfigByID(ctx, conn, id)
+
+		if err != nil {
+			return nil, err
+		}
+
+		changed, err := update(output.DistributionConfig)
+
+		if err != nil || !changed {
+			return nil, err
+		}
+
+		input := &cloudfront.UpdateDistributionInput{
+			DistributionConfig: output.DistributionConfig,
+			Id:                 aws.String(id),
+			IfMatch:            output.ETag,
+		}
+
+		log.Printf("[DEBUG] Updating CloudFront Distribution (%s) configuration", id)
+		return conn.UpdateDistributionWithContext(ctx, input)
+	}, cloudfront.ErrCodePreconditionFailed, cloudfront.ErrCodeInvalidIfMatchVersion)
+
+	return err
+}
@@ -0,0 +1,180 @@
+package cloudfront
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+)
+
+// testCloudFrontConn returns a CloudFront client whose requests are served by handler.
+func testCloudFrontConn(t *testing.T, handler http.HandlerFunc) *cloudfront.CloudFront {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("AKID", "SECRET", ""),
+		Endpoint:    aws.String(server.URL),
+		Region:      aws.String("us-east-1"),
+		MaxRetries:  aws.Int(0),
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	return cloudfront.New(sess)
+}
+
+func TestUpdateDistributionConfig(t *testing.T) {
+	var etags, ifMatches []string
+	var body string
+
+	conn := testCloudFrontConn(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			etag := "E1"
+			if len(etags) > 0 {
+				etag = "E2"
+			}
+			etags = append(etags, etag)
+
+			w.Header().Set("ETag", etag)
+			io.WriteString(w, `<?xml version="1.0"?>
+<DistributionConfig xmlns="http://cloudfront.amazonaws.com/doc/2020-05-31/">
+  <CallerReference>test</CallerReference>
+  <Comment></Comment>
+  <Enabled>true</Enabled>
+  <CacheBehaviors><Quantity>1</Quantity><Items><CacheBehavior><PathPattern>/a/*</PathPattern><TargetOriginId>origin</TargetOriginId><ViewerProtocolPolicy>allow-all</ViewerProtocolPolicy></CacheBehavior></Items></CacheBehaviors>
+  <DefaultCacheBehavior><TargetOriginId>origin</TargetOriginId><ViewerProtocolPolicy>allow-all</ViewerProtocolPolicy></DefaultCacheBehavior>
+  <Origins><Quantity>1</Quantity><Items><Origin><Id>origin</Id><DomainName>example.com</DomainName></Origin></Items></Origins>
+  <ContinuousDeploymentPolicyId>CDP1</ContinuousDeploymentPolicyId>
+  <Staging>false</Staging>
+</DistributionConfig>`)
+			return
+		}
+
+		b, _ := io.ReadAll(r.Body)
+		body = string(b)
+		ifMatches = append(ifMatches, r.Header.Get("If-Match"))
+
+		// The first write loses the race with a concurrent change to the distribution.
+		if len(ifMatches) == 1 {
+			w.WriteHeader(http.StatusPreconditionFailed)
+			io.WriteString(w, `<ErrorResponse><Error><Type>Sender</Type><Code>PreconditionFailed</Code><Message>changed</Message></Error><RequestId>1</RequestId></ErrorResponse>`)
+			return
+		}
+
+		w.Header().Set("ETag", "E3")
+		io.WriteString(w, `<?xml version="1.0"?><Distribution xmlns="http://cloudfront.amazonaws.com/doc/2020-05-31/"><Id>D1</Id></Distribution>`)
+	})
+
+	cacheBehavior := &cloudfront.CacheBehavior{
+		PathPattern:          aws.String("/b/*"),
+		TargetOriginId:       aws.String("origin"),
+		ViewerProtocolPolicy: aws.String(cloudfront.ViewerProtocolPolicyAllowAll),
+	}
+
+	err := updateDistributionConfig(context.Background(), conn, "D1", func(config *cloudfront.DistributionConfig) (bool, error) {
+		config.CacheBehaviors = insertCacheBehavior(config.CacheBehaviors, cacheBehavior, 0)
+
+		return true, nil
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := strings.Join(ifMatches, " "), "E1 E2"; got != expected {
+		t.Errorf("got If-Match %s, expected %s", got, expected)
+	}
+
+	for _, expected := range []string{
+		`<Quantity>2</Quantity>`,
+		`<ContinuousDeploymentPolicyId>CDP1</ContinuousDeploymentPolicyId>`,
+		`<Staging>false</Staging>`,
+	} {
+		if got := strings.Count(body, expected); got != 1 {
+			t.Errorf("request body %s contains %s %d times, expected once", body, expected, got)
+		}
+	}
+
+	if i, j := strings.Index(body, "/b/*"), strings.Index(body, "/a/*"); i < 0 || j < 0 || i > j {
+		t.Errorf("request body %s does not contain /b/* before /a/*", body)
+	}
+
+	ifMatches = nil
+
+	err = updateDistributionConfig(context.Background(), conn, "D1", func(config *cloudfront.DistributionConfig) (bool, error) {
+		return false, nil
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(ifMatches) != 0 {
+		t.Errorf("got %d updates, expected none", len(ifMatches))
+	}
+}
+
+func TestInsertRemoveCacheBehavior(t *testing.T) {
+	pathPatterns := func(cacheBehaviors *cloudfront.CacheBehaviors) string {
+		var s []string
+
+		for _, v := range cacheBehaviors.Items {
+			s = append(s, aws.StringValue(v.PathPattern))
+		}
+
+		if got, expected := aws.Int64Value(cacheBehaviors.Quantity), int64(len(cacheBehaviors.Items)); got != expected {
+			t.Errorf("got quantity %d, expected %d", got, expected)
+		}
+
+		return strings.Join(s, " ")
+	}
+	cacheBehavior := func(pathPattern string) *cloudfront.CacheBehavior {
+		return &cloudfront.CacheBehavior{PathPattern: aws.String(pathPattern)}
+	}
+
+	cacheBehaviors := insertCacheBehavior(nil, cacheBehavior("a"), 5)
+	cacheBehaviors = insertCacheBehavior(cacheBehaviors, cacheBehavior("c"), 1)
+	original := cacheBehaviors
+	cacheBehaviors = insertCacheBehavior(cacheBehaviors, cacheBehavior("b"), 1)
+	cacheBehaviors = insertCacheBehavior(cacheBehaviors, cacheBehavior("z"), 0)
+
+	if got, expected := pathPatterns(cacheBehaviors), "z a b c"; got != expected {
+		t.Errorf("got %s, expected %s", got, expected)
+	}
+
+	if got, expected := pathPatterns(original), "a c"; got != expected {
+		t.Errorf("got %s, expected %s after later inserts", got, expected)
+	}
+
+	if got, expected := findCacheBehavior(cacheBehaviors, "b"), 2; got != expected {
+		t.Errorf("got index %d, expected %d", got, expected)
+	}
+
+	if got, expected := findCacheBehavior(cacheBehaviors, "y"), -1; got != expected {
+		t.Errorf("got index %d, expected %d", got, expected)
+	}
+
+	cacheBehaviors = removeCacheBehavior(cacheBehaviors, 2)
+
+	if got, expected := pathPatterns(cacheBehaviors), "z a c"; got != expected {
+		t.Errorf("got %s, expected %s", got, expected)
+	}
+
+	for range []int{0, 1, 2} {
+		cacheBehaviors = removeCacheBehavior(cacheBehaviors, 0)
+	}
+
+	if cacheBehaviors.Items != nil {
+		t.Errorf("got %v, expected no cache behaviors", cacheBehaviors.Items)
+	}
+}
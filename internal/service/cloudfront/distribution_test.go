@@ -1,6 +1,7 @@
 package cloudfront_test
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"regexp"
@@ -1563,7 +1564,7 @@ func testAccCheckDistributionDisappears(distribution *cloudfront.Distribution) r
 
 func testAccCheckDistributionWaitForDeployment(distribution *cloudfront.Distribution) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		return tfcloudfront.DistributionWaitUntilDeployed(aws.StringValue(distribution.Id), acctest.Provider.Meta())
+		return tfcloudfront.DistributionWaitUntilDeployed(context.Background(), aws.StringValue(distribution.Id), acctest.Provider.Meta())
 	}
 }
 
@@ -0,0 +1,59 @@
+package cloudfront
+
+import (
+	"github.com/hashicorp/terraform-provider-aws/internal/importid"
+)
+
+// distributionPartIDSeparator separates the distribution ID from the key of an origin or cache behavior
+// in the ID of the resources that manage part of a distribution's configuration.
+const distributionPartIDSeparator = ","
+
+// Origin IDs and cache behavior path patterns may contain the separator, so the key consumes the remainder of the ID.
+var originResourceIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "distribution-id"},
+		{Name: "origin-id", Greedy: true},
+	},
+	Separator: distributionPartIDSeparator,
+}
+
+var cacheBehaviorResourceIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "distribution-id"},
+		{Name: "path-pattern", Greedy: true},
+	},
+	Separator: distributionPartIDSeparator,
+}
+
+func init() {
+	importid.Register("aws_cloudfront_origin", originResourceIDFormat)
+	importid.Register("aws_cloudfront_cache_behavior", cacheBehaviorResourceIDFormat)
+}
+
+func OriginCreateResourceID(distributionID, originID string) string {
+	return originResourceIDFormat.Create(distributionID, originID)
+}
+
+func OriginParseResourceID(id string) (string, string, error) {
+	parts, err := originResourceIDFormat.Parse(id)
+
+	if err != nil {
+		return "", "", err
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func CacheBehaviorCreateResourceID(distributionID, pathPattern string) string {
+	return cacheBehaviorResourceIDFormat.Create(distributionID, pathPattern)
+}
+
+func CacheBehaviorParseResourceID(id string) (string, string, error) {
+	parts, err := cacheBehaviorResourceIDFormat.Parse(id)
+
+	if err != nil {
+		return "", "", err
+	}
+
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,81 @@
+package cloudfront
+
+import (
+	"testing"
+)
+
+func TestOriginParseResourceID(t *testing.T) {
+	cases := []struct {
+		ID       string
+		OriginID string
+		ErrCount int
+	}{
+		{ID: "D1,origin", OriginID: "origin"},
+		{ID: "D1,origin,with,commas", OriginID: "origin,with,commas"},
+		{ID: "D1", ErrCount: 1},
+		{ID: "D1,", ErrCount: 1},
+		{ID: ",origin", ErrCount: 1},
+	}
+
+	for _, tc := range cases {
+		distributionID, originID, err := OriginParseResourceID(tc.ID)
+
+		if tc.ErrCount > 0 {
+			if err == nil {
+				t.Errorf("expected error for %q", tc.ID)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("unexpected error for %q: %s", tc.ID, err)
+			continue
+		}
+
+		if distributionID != "D1" || originID != tc.OriginID {
+			t.Errorf("got %q %q for %q, expected %q %q", distributionID, originID, tc.ID, "D1", tc.OriginID)
+		}
+
+		if got := OriginCreateResourceID(distributionID, originID); got != tc.ID {
+			t.Errorf("got %q, expected %q", got, tc.ID)
+		}
+	}
+}
+
+func TestCacheBehaviorParseResourceID(t *testing.T) {
+	cases := []struct {
+		ID          string
+		PathPattern string
+		ErrCount    int
+	}{
+		{ID: "D1,/images/*", PathPattern: "/images/*"},
+		{ID: "D1,/images/*,jpg", PathPattern: "/images/*,jpg"},
+		{ID: "D1", ErrCount: 1},
+		{ID: "D1,", ErrCount: 1},
+		{ID: ",/images/*", ErrCount: 1},
+	}
+
+	for _, tc := range cases {
+		distributionID, pathPattern, err := CacheBehaviorParseResourceID(tc.ID)
+
+		if tc.ErrCount > 0 {
+			if err == nil {
+				t.Errorf("expected error for %q", tc.ID)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("unexpected error for %q: %s", tc.ID, err)
+			continue
+		}
+
+		if distributionID != "D1" || pathPattern != tc.PathPattern {
+			t.Errorf("got %q %q for %q, expected %q %q", distributionID, pathPattern, tc.ID, "D1", tc.PathPattern)
+		}
+
+		if got := CacheBehaviorCreateResourceID(distributionID, pathPattern); got != tc.ID {
+			t.Errorf("got %q, expected %q", got, tc.ID)
+		}
+	}
+}
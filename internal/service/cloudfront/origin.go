@@ -0,0 +1,247 @@
+package cloudfront
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// ResourceOrigin manages a single origin of a distribution that is otherwise managed by aws_cloudfront_distribution.
+func ResourceOrigin() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceOriginCreate,
+		ReadWithoutTimeout:   resourceOriginRead,
+		UpdateWithoutTimeout: resourceOriginUpdate,
+		DeleteWithoutTimeout: resourceOriginDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: originSchema(),
+	}
+}
+
+const (
+	ResNameOrigin = "Origin"
+)
+
+// originSchema returns the schema of an origin of aws_cloudfront_distribution, with the arguments
+// that identify the origin's distribution.
+func originSchema() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{}
+
+	for k, v := range distributionOriginSchema() {
+		s[k] = v
+	}
+
+	originID := *s["origin_id"]
+	originID.ForceNew = true
+	s["origin_id"] = &originID
+
+	s["distribution_id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	}
+	s["wait_for_deployment"] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  true,
+	}
+
+	return s
+}
+
+// distributionOriginSchema returns the schema of an origin of aws_cloudfront_distribution.
+func distributionOriginSchema() map[string]*schema.Schema {
+	return ResourceDistribution().Schema["origin"].Elem.(*schema.Resource).Schema
+}
+
+func resourceOriginCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CloudFrontConn
+
+	distributionID := d.Get("distribution_id").(string)
+	origin := expandOriginResource(d)
+	id := OriginCreateResourceID(distributionID, aws.StringValue(origin.Id))
+
+	err := updateDistributionConfig(ctx, conn, distributionID, func(config *cloudfront.DistributionConfig) (bool, error) {
+		if config.Origins == nil {
+			config.Origins = &cloudfront.Origins{}
+		}
+
+		if findOrigin(config.Origins, aws.StringValue(origin.Id)) >= 0 {
+			return false, fmt.Errorf("origin (%s) already exists", aws.StringValue(origin.Id))
+		}
+
+		config.Origins.Items = append(config.Origins.Items, origin)
+		config.Origins.Quantity = aws.Int64(int64(len(config.Origins.Items)))
+
+		return true, nil
+	})
+
+	if err != nil {
+		return create.DiagError(names.CloudFront, create.ErrActionCreating, ResNameOrigin, id, err)
+	}
+
+	d.SetId(id)
+
+	if d.Get("wait_for_deployment").(bool) {
+		log.Printf("[DEBUG] Waiting until CloudFront Distribution (%s) is deployed", distributionID)
+		if err := DistributionWaitUntilDeployed(ctx, distributionID, meta); err != nil {
+			return create.DiagError(names.CloudFront, create.ErrActionWaitingForCreation, ResNameOrigin, d.Id(), err)
+		}
+	}
+
+	return resourceOriginRead(ctx, d, meta)
+}
+
+func resourceOriginRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CloudFrontConn
+
+	distributionID, originID, err := OriginParseResourceID(d.Id())
+
+	if err != nil {
+		return create.DiagError(names.CloudFront, create.ErrActionReading, ResNameOrigin, d.Id(), err)
+	}
+
+	output, err := findDistributionConfigByID(ctx, conn, distributionID)
+
+	i := -1
+
+	if err == nil {
+		i = findOrigin(output.DistributionConfig.Origins, originID)
+
+		if i < 0 {
+			err = tfresource.NewEmptyResultError(originID)
+		}
+	}
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		create.LogNotFoundRemoveState(names.CloudFront, create.ErrActionReading, ResNameOrigin, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.CloudFront, create.ErrActionReading, ResNameOrigin, d.Id(), err)
+	}
+
+	d.Set("distribution_id", distributionID)
+
+	tfMap := FlattenOrigin(output.DistributionConfig.Origins.Items[i])
+
+	for k := range distributionOriginSchema() {
+		if err := d.Set(k, tfMap[k]); err != nil {
+			return create.DiagSettingError(names.CloudFront, ResNameOrigin, d.Id(), k, err)
+		}
+	}
+
+	return nil
+}
+
+func resourceOriginUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CloudFrontConn
+
+	if d.HasChangeExcept("wait_for_deployment") {
+		distributionID := d.Get("distribution_id").(string)
+		origin := expandOriginResource(d)
+
+		err := updateDistributionConfig(ctx, conn, distributionID, func(config *cloudfront.DistributionConfig) (bool, error) {
+			i := findOrigin(config.Origins, aws.StringValue(origin.Id))
+
+			if i < 0 {
+				return false, fmt.Errorf("origin (%s) not found", aws.StringValue(origin.Id))
+			}
+
+			config.Origins.Items[i] = origin
+
+			return true, nil
+		})
+
+		if err != nil {
+			return create.DiagError(names.CloudFront, create.ErrActionUpdating, ResNameOrigin, d.Id(), err)
+		}
+
+		if d.Get("wait_for_deployment").(bool) {
+			log.Printf("[DEBUG] Waiting until CloudFront Distribution (%s) is deployed", distributionID)
+			if err := DistributionWaitUntilDeployed(ctx, distributionID, meta); err != nil {
+				return create.DiagError(names.CloudFront, create.ErrActionWaitingForUpdate, ResNameOrigin, d.Id(), err)
+			}
+		}
+	}
+
+	return resourceOriginRead(ctx, d, meta)
+}
+
+func resourceOriginDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CloudFrontConn
+
+	distributionID := d.Get("distribution_id").(string)
+	originID := d.Get("origin_id").(string)
+
+	log.Printf("[INFO] Deleting CloudFront Origin %s", d.Id())
+	err := updateDistributionConfig(ctx, conn, distributionID, func(config *cloudfront.DistributionConfig) (bool, error) {
+		i := findOrigin(config.Origins, originID)
+
+		if i < 0 {
+			return false, nil
+		}
+
+		config.Origins.Items = append(config.Origins.Items[:i], config.Origins.Items[i+1:]...)
+		config.Origins.Quantity = aws.Int64(int64(len(config.Origins.Items)))
+
+		return true, nil
+	})
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.CloudFront, create.ErrActionDeleting, ResNameOrigin, d.Id(), err)
+	}
+
+	if d.Get("wait_for_deployment").(bool) {
+		log.Printf("[DEBUG] Waiting until CloudFront Distribution (%s) is deployed", distributionID)
+		if err := DistributionWaitUntilDeployed(ctx, distributionID, meta); err != nil {
+			return create.DiagError(names.CloudFront, create.ErrActionWaitingForDeletion, ResNameOrigin, d.Id(), err)
+		}
+	}
+
+	return nil
+}
+
+// findOrigin returns the index of the origin with the specified ID, or -1.
+func findOrigin(origins *cloudfront.Origins, id string) int {
+	if origins == nil {
+		return -1
+	}
+
+	for i, v := range origins.Items {
+		if aws.StringValue(v.Id) == id {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func expandOriginResource(d *schema.ResourceData) *cloudfront.Origin {
+	tfMap := map[string]interface{}{}
+
+	for k := range distributionOriginSchema() {
+		tfMap[k] = d.Get(k)
+	}
+
+	return ExpandOrigin(tfMap)
+}
@@ -0,0 +1,182 @@
+package cloudfront_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfcloudfront "github.com/hashicorp/terraform-provider-aws/internal/service/cloudfront"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCloudFrontOrigin_basic(t *testing.T) {
+	resourceName := "aws_cloudfront_origin.test"
+	distributionResourceName := "aws_cloudfront_distribution.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(cloudfront.EndpointsID, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, cloudfront.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDistributionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOriginConfig_basic("/v1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOriginExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "distribution_id", distributionResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "domain_name", "www.example.org"),
+					resource.TestCheckResourceAttr(resourceName, "origin_id", "additional"),
+					resource.TestCheckResourceAttr(resourceName, "origin_path", "/v1"),
+					resource.TestCheckResourceAttr(resourceName, "custom_origin_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "custom_origin_config.0.origin_protocol_policy", "https-only"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait_for_deployment"},
+			},
+			{
+				Config: testAccOriginConfig_basic("/v2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOriginExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "origin_path", "/v2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudFrontOrigin_disappears(t *testing.T) {
+	resourceName := "aws_cloudfront_origin.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(cloudfront.EndpointsID, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, cloudfront.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDistributionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOriginConfig_basic("/v1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOriginExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfcloudfront.ResourceOrigin(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckOriginExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.CloudFront, create.ErrActionCheckingExistence, tfcloudfront.ResNameOrigin, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.CloudFront, create.ErrActionCheckingExistence, tfcloudfront.ResNameOrigin, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFrontConn
+
+		output, err := tfcloudfront.FindDistributionByID(conn, rs.Primary.Attributes["distribution_id"])
+
+		if err != nil {
+			return create.Error(names.CloudFront, create.ErrActionCheckingExistence, tfcloudfront.ResNameOrigin, rs.Primary.ID, err)
+		}
+
+		for _, v := range output.Distribution.DistributionConfig.Origins.Items {
+			if aws.StringValue(v.Id) == rs.Primary.Attributes["origin_id"] {
+				return nil
+			}
+		}
+
+		return create.Error(names.CloudFront, create.ErrActionCheckingExistence, tfcloudfront.ResNameOrigin, rs.Primary.ID, errors.New("not found in distribution"))
+	}
+}
+
+// testAccDistributionPartConfig_base returns a distribution whose origins and ordered cache behaviors
+// are managed by aws_cloudfront_origin and aws_cloudfront_cache_behavior resources.
+func testAccDistributionPartConfig_base() string {
+	return `
+resource "aws_cloudfront_distribution" "test" {
+  enabled          = true
+  retain_on_delete = false
+
+  default_cache_behavior {
+    allowed_methods        = ["GET", "HEAD"]
+    cached_methods         = ["GET", "HEAD"]
+    target_origin_id       = "test"
+    viewer_protocol_policy = "allow-all"
+
+    forwarded_values {
+      query_string = false
+
+      cookies {
+        forward = "all"
+      }
+    }
+  }
+
+  origin {
+    domain_name = "www.example.com"
+    origin_id   = "test"
+
+    custom_origin_config {
+      http_port              = 80
+      https_port             = 443
+      origin_protocol_policy = "https-only"
+      origin_ssl_protocols   = ["TLSv1.2"]
+    }
+  }
+
+  restrictions {
+    geo_restriction {
+      restriction_type = "none"
+    }
+  }
+
+  viewer_certificate {
+    cloudfront_default_certificate = true
+  }
+
+  lifecycle {
+    ignore_changes = [origin, ordered_cache_behavior]
+  }
+}
+`
+}
+
+func testAccOriginConfig_basic(originPath string) string {
+	return acctest.ConfigCompose(testAccDistributionPartConfig_base(), fmt.Sprintf(`
+resource "aws_cloudfront_origin" "test" {
+  distribution_id = aws_cloudfront_distribution.test.id
+  domain_name     = "www.example.org"
+  origin_id       = "additional"
+  origin_path     = %[1]q
+
+  custom_origin_config {
+    http_port              = 80
+    https_port             = 443
+    origin_protocol_policy = "https-only"
+    origin_ssl_protocols   = ["TLSv1.2"]
+  }
+}
+`, originPath))
+}
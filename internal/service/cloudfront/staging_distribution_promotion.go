@@ -0,0 +1,124 @@
+package cloudfront
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// ResourceStagingDistributionPromotion copies the configuration of a staging distribution to its
+// primary distribution when it is created. Changing any argument promotes the staging distribution again.
+func ResourceStagingDistributionPromotion() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceStagingDistributionPromotionCreate,
+		ReadWithoutTimeout:   resourceStagingDistributionPromotionRead,
+		DeleteWithoutTimeout: schema.NoopContext,
+
+		Schema: map[string]*schema.Schema{
+			"distribution_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"staging_distribution_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"wait_for_deployment": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+const (
+	ResNameStagingDistributionPromotion = "Staging Distribution Promotion"
+
+	stagingDistributionPromotionIDSeparator = ","
+)
+
+func resourceStagingDistributionPromotionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CloudFrontConn
+
+	distributionID := d.Get("distribution_id").(string)
+	stagingDistributionID := d.Get("staging_distribution_id").(string)
+	id := strings.Join([]string{distributionID, stagingDistributionID}, stagingDistributionPromotionIDSeparator)
+
+	primary, err := FindDistributionByID(conn, distributionID)
+
+	if err != nil {
+		return create.DiagError(names.CloudFront, create.ErrActionCreating, ResNameStagingDistributionPromotion, id, fmt.Errorf("reading primary distribution: %w", err))
+	}
+
+	staging, err := FindDistributionByID(conn, stagingDistributionID)
+
+	if err != nil {
+		return create.DiagError(names.CloudFront, create.ErrActionCreating, ResNameStagingDistributionPromotion, id, fmt.Errorf("reading staging distribution: %w", err))
+	}
+
+	in := &cloudfront.UpdateDistributionWithStagingConfigInput{
+		Id:                    aws.String(distributionID),
+		IfMatch:               aws.String(fmt.Sprintf("%s, %s", aws.StringValue(primary.ETag), aws.StringValue(staging.ETag))),
+		StagingDistributionId: aws.String(stagingDistributionID),
+	}
+
+	log.Printf("[DEBUG] Promoting CloudFront staging Distribution (%s) to Distribution (%s)", stagingDistributionID, distributionID)
+	if _, err := conn.UpdateDistributionWithStagingConfigWithContext(ctx, in); err != nil {
+		return create.DiagError(names.CloudFront, create.ErrActionCreating, ResNameStagingDistributionPromotion, id, err)
+	}
+
+	d.SetId(id)
+
+	if d.Get("wait_for_deployment").(bool) {
+		log.Printf("[DEBUG] Waiting until CloudFront Distribution (%s) is deployed", distributionID)
+		if err := DistributionWaitUntilDeployed(ctx, distributionID, meta); err != nil {
+			return create.DiagError(names.CloudFront, create.ErrActionWaitingForCreation, ResNameStagingDistributionPromotion, id, err)
+		}
+	}
+
+	return resourceStagingDistributionPromotionRead(ctx, d, meta)
+}
+
+func resourceStagingDistributionPromotionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CloudFrontConn
+
+	out, err := FindDistributionByID(conn, d.Get("distribution_id").(string))
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		create.LogNotFoundRemoveState(names.CloudFront, create.ErrActionReading, ResNameStagingDistributionPromotion, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.CloudFront, create.ErrActionReading, ResNameStagingDistributionPromotion, d.Id(), err)
+	}
+
+	d.Set("etag", out.ETag)
+
+	return nil
+}
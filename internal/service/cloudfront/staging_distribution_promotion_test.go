@@ -0,0 +1,46 @@
+package cloudfront_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccCloudFrontStagingDistributionPromotion_basic(t *testing.T) {
+	resourceName := "aws_cloudfront_staging_distribution_promotion.test"
+	productionDistributionResourceName := "aws_cloudfront_distribution.test"
+	stagingDistributionResourceName := "aws_cloudfront_distribution.staging"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(cloudfront.EndpointsID, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, cloudfront.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             resource.ComposeTestCheckFunc(testAccCheckContinuousDeploymentPolicyDestroy, testAccCheckDistributionDestroy),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStagingDistributionPromotionConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "distribution_id", productionDistributionResourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "etag"),
+					resource.TestCheckResourceAttrPair(resourceName, "staging_distribution_id", stagingDistributionResourceName, "id"),
+				),
+				// The production distribution now has the staging distribution's comment.
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccStagingDistributionPromotionConfig_basic() string {
+	return acctest.ConfigCompose(testAccContinuousDeploymentPolicyConfig_singleWeight(0.15), `
+resource "aws_cloudfront_staging_distribution_promotion" "test" {
+  distribution_id         = aws_cloudfront_distribution.test.id
+  staging_distribution_id = aws_cloudfront_distribution.staging.id
+}
+`)
+}
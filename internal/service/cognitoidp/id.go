@@ -0,0 +1,35 @@
+package cognitoidp
+
+import (
+	"github.com/hashicorp/terraform-provider-aws/internal/importid"
+)
+
+var userImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "user-pool-id"},
+		{Name: "username"},
+	},
+	Separator: "/",
+}
+
+var userPoolClientImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "user-pool-id"},
+		{Name: "client-id"},
+	},
+	Separator: "/",
+}
+
+var userGroupImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "user-pool-id"},
+		{Name: "group-name"},
+	},
+	Separator: "/",
+}
+
+func init() {
+	importid.Register("aws_cognito_user", userImportIDFormat)
+	importid.Register("aws_cognito_user_pool_client", userPoolClientImportIDFormat)
+	importid.Register("aws_cognito_user_group", userGroupImportIDFormat)
+}
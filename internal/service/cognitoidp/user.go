@@ -1,7 +1,6 @@
 package cognitoidp
 
 import (
-	"errors"
 	"fmt"
 	"log"
 	"strings"
@@ -382,9 +381,9 @@ func resourceUserDelete(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceUserImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	idSplit := strings.Split(d.Id(), "/")
-	if len(idSplit) != 2 {
-		return nil, errors.New("error importing Cognito User. Must specify user_pool_id/username")
+	idSplit, err := userImportIDFormat.Parse(d.Id())
+	if err != nil {
+		return nil, err
 	}
 	userPoolId := idSplit[0]
 	name := idSplit[1]
@@ -1,10 +1,8 @@
 package cognitoidp
 
 import (
-	"errors"
 	"fmt"
 	"log"
-	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
@@ -169,9 +167,9 @@ func resourceUserGroupDelete(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceUserGroupImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	idSplit := strings.Split(d.Id(), "/")
-	if len(idSplit) != 2 {
-		return nil, errors.New("Error importing Cognito User Group. Must specify user_pool_id/group_name")
+	idSplit, err := userGroupImportIDFormat.Parse(d.Id())
+	if err != nil {
+		return nil, err
 	}
 	userPoolId := idSplit[0]
 	name := idSplit[1]
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"log"
 	"regexp"
-	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -528,11 +527,12 @@ func resourceUserPoolClientDelete(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceUserPoolClientImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	if len(strings.Split(d.Id(), "/")) != 2 || len(d.Id()) < 3 {
-		return []*schema.ResourceData{}, fmt.Errorf("wrong format of import ID (%s), use: 'user-pool-id/client-id'", d.Id())
+	parts, err := userPoolClientImportIDFormat.Parse(d.Id())
+	if err != nil {
+		return []*schema.ResourceData{}, err
 	}
-	userPoolId := strings.Split(d.Id(), "/")[0]
-	clientId := strings.Split(d.Id(), "/")[1]
+	userPoolId := parts[0]
+	clientId := parts[1]
 	d.SetId(clientId)
 	d.Set("user_pool_id", userPoolId)
 	log.Printf("[DEBUG] Importing client %s for user pool %s", clientId, userPoolId)
@@ -0,0 +1,35 @@
+package datasync
+
+import (
+	"github.com/hashicorp/terraform-provider-aws/internal/importid"
+)
+
+var locationFSxLustreFileSystemImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "DataSyncLocationArn"},
+		{Name: "FsxArn"},
+	},
+	Separator: "#",
+}
+
+var locationFSxWindowsFileSystemImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "DataSyncLocationArn"},
+		{Name: "FsxArn"},
+	},
+	Separator: "#",
+}
+
+var locationFSxOpenZFSFileSystemImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "DataSyncLocationArn"},
+		{Name: "FsxArn"},
+	},
+	Separator: "#",
+}
+
+func init() {
+	importid.Register("aws_datasync_location_fsx_lustre_file_system", locationFSxLustreFileSystemImportIDFormat)
+	importid.Register("aws_datasync_location_fsx_windows_file_system", locationFSxWindowsFileSystemImportIDFormat)
+	importid.Register("aws_datasync_location_fsx_openzfs_file_system", locationFSxOpenZFSFileSystemImportIDFormat)
+}
@@ -3,7 +3,6 @@ package datasync
 import (
 	"fmt"
 	"log"
-	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -26,9 +25,9 @@ func ResourceLocationFSxOpenZFSFileSystem() *schema.Resource {
 		Delete: resourceLocationFSxOpenZFSFileSystemDelete,
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-				idParts := strings.Split(d.Id(), "#")
-				if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-					return nil, fmt.Errorf("Unexpected format of ID (%q), expected DataSyncLocationArn#FsxArn", d.Id())
+				idParts, err := locationFSxOpenZFSFileSystemImportIDFormat.Parse(d.Id())
+				if err != nil {
+					return nil, err
 				}
 
 				DSArn := idParts[0]
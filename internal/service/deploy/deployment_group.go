@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"log"
 	"sort"
-	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -31,10 +30,10 @@ func ResourceDeploymentGroup() *schema.Resource {
 		Delete: resourceDeploymentGroupDelete,
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-				idParts := strings.Split(d.Id(), ":")
+				idParts, err := deploymentGroupImportIDFormat.Parse(d.Id())
 
-				if len(idParts) != 2 {
-					return []*schema.ResourceData{}, fmt.Errorf("expected ID in format ApplicationName:DeploymentGroupName, received: %s", d.Id())
+				if err != nil {
+					return []*schema.ResourceData{}, err
 				}
 
 				applicationName := idParts[0]
@@ -0,0 +1,17 @@
+package deploy
+
+import (
+	"github.com/hashicorp/terraform-provider-aws/internal/importid"
+)
+
+var deploymentGroupImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "ApplicationName"},
+		{Name: "DeploymentGroupName"},
+	},
+	Separator: ":",
+}
+
+func init() {
+	importid.Register("aws_codedeploy_deployment_group", deploymentGroupImportIDFormat)
+}
@@ -3,7 +3,6 @@ package directconnect
 import (
 	"fmt"
 	"log"
-	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -260,10 +259,10 @@ func resourceGatewayAssociationDelete(d *schema.ResourceData, meta interface{})
 func resourceGatewayAssociationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	conn := meta.(*conns.AWSClient).DirectConnectConn
 
-	parts := strings.Split(d.Id(), "/")
+	parts, err := gatewayAssociationImportIDFormat.Parse(d.Id())
 
-	if len(parts) != 2 {
-		return nil, fmt.Errorf("Incorrect resource ID format: %q. Expected DXGATEWAYID/ASSOCIATEDGATEWAYID", d.Id())
+	if err != nil {
+		return nil, err
 	}
 
 	directConnectGatewayID := parts[0]
@@ -233,27 +233,25 @@ func flattenRouteFilterPrefixes(apiObjects []*directconnect.RouteFilterPrefix) [
 }
 
 func resourceGatewayAssociationProposalImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	switch parts := strings.Split(strings.ToLower(d.Id()), "/"); len(parts) {
-	case 1:
-		break
+	// A bare PROPOSALID is imported as is.
+	if !strings.Contains(d.Id(), gatewayAssociationProposalImportIDFormat.Separator) {
+		return []*schema.ResourceData{d}, nil
+	}
 
-	case 3:
-		proposalID := parts[0]
-		directConnectGatewayID := parts[1]
-		associatedGatewayID := parts[2]
+	parts, err := gatewayAssociationProposalImportIDFormat.Parse(strings.ToLower(d.Id()))
 
-		if proposalID == "" || directConnectGatewayID == "" || associatedGatewayID == "" {
-			return nil, fmt.Errorf("Incorrect resource ID format: %q. PROPOSALID, DXGATEWAYID and ASSOCIATEDGATEWAYID must not be empty strings", d.Id())
-		}
+	if err != nil {
+		return nil, err
+	}
 
-		// Use pseudo-proposal ID and actual DirectConnectGatewayId and AssociatedGatewayId.
-		d.SetId(proposalID)
-		d.Set("associated_gateway_id", associatedGatewayID)
-		d.Set("dx_gateway_id", directConnectGatewayID)
+	proposalID := parts[0]
+	directConnectGatewayID := parts[1]
+	associatedGatewayID := parts[2]
 
-	default:
-		return nil, fmt.Errorf("Incorrect resource ID format: %q. Expected PROPOSALID or PROPOSALID/DXGATEWAYID/ASSOCIATEDGATEWAYID", d.Id())
-	}
+	// Use pseudo-proposal ID and actual DirectConnectGatewayId and AssociatedGatewayId.
+	d.SetId(proposalID)
+	d.Set("associated_gateway_id", associatedGatewayID)
+	d.Set("dx_gateway_id", directConnectGatewayID)
 
 	return []*schema.ResourceData{d}, nil
 }
@@ -2,8 +2,32 @@ package directconnect
 
 import (
 	"fmt"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/importid"
 )
 
 func GatewayAssociationCreateResourceID(directConnectGatewayID, associatedGatewayID string) string {
 	return fmt.Sprintf("ga-%s%s", directConnectGatewayID, associatedGatewayID)
 }
+
+var gatewayAssociationProposalImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "PROPOSALID"},
+		{Name: "DXGATEWAYID"},
+		{Name: "ASSOCIATEDGATEWAYID"},
+	},
+	Separator: "/",
+}
+
+var gatewayAssociationImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "DXGATEWAYID"},
+		{Name: "ASSOCIATEDGATEWAYID"},
+	},
+	Separator: "/",
+}
+
+func init() {
+	importid.Register("aws_dx_gateway_association_proposal", gatewayAssociationProposalImportIDFormat)
+	importid.Register("aws_dx_gateway_association", gatewayAssociationImportIDFormat)
+}
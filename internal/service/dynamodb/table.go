@@ -138,6 +138,10 @@ func ResourceTable() *schema.Resource {
 				Default:      dynamodb.BillingModeProvisioned,
 				ValidateFunc: validation.StringInSlice(dynamodb.BillingMode_Values(), false),
 			},
+			"deletion_protection_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			"global_secondary_index": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -182,6 +186,88 @@ func ResourceTable() *schema.Resource {
 				Computed: true,
 				ForceNew: true,
 			},
+			"import_table": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"local_secondary_index", "restore_source_name"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"input_compression_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(dynamodb.InputCompressionType_Values(), false),
+						},
+						"input_format": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(dynamodb.InputFormat_Values(), false),
+						},
+						"input_format_options": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"csv": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"delimiter": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.StringLenBetween(1, 1),
+												},
+												"header_list": {
+													Type:     schema.TypeList,
+													Optional: true,
+													ForceNew: true,
+													MinItems: 1,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"s3_bucket_source": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bucket": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"bucket_owner": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidAccountID,
+									},
+									"key_prefix": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"local_secondary_index": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -477,6 +563,62 @@ func resourceTableCreate(d *schema.ResourceData, meta interface{}) error {
 		if output == nil || output.TableDescription == nil {
 			return errors.New("error creating DynamoDB Table: empty response")
 		}
+	} else if v, ok := d.GetOk("import_table"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input := expandImportTable(v.([]interface{})[0].(map[string]interface{}))
+
+		billingMode := d.Get("billing_mode").(string)
+
+		capacityMap := map[string]interface{}{
+			"write_capacity": d.Get("write_capacity"),
+			"read_capacity":  d.Get("read_capacity"),
+		}
+
+		input.TableCreationParameters = &dynamodb.TableCreationParameters{
+			AttributeDefinitions:  expandAttributes(d.Get("attribute").(*schema.Set).List()),
+			BillingMode:           aws.String(billingMode),
+			KeySchema:             expandKeySchema(keySchemaMap),
+			ProvisionedThroughput: expandProvisionedThroughput(capacityMap, billingMode),
+			TableName:             aws.String(d.Get("name").(string)),
+		}
+
+		if v, ok := d.GetOk("global_secondary_index"); ok {
+			globalSecondaryIndexes := []*dynamodb.GlobalSecondaryIndex{}
+			gsiSet := v.(*schema.Set)
+
+			for _, gsiObject := range gsiSet.List() {
+				gsi := gsiObject.(map[string]interface{})
+				if err := validateGSIProvisionedThroughput(gsi, billingMode); err != nil {
+					return create.Error(names.DynamoDB, create.ErrActionCreating, ResNameTable, d.Get("name").(string), err)
+				}
+
+				gsiObject := expandGlobalSecondaryIndex(gsi, billingMode)
+				globalSecondaryIndexes = append(globalSecondaryIndexes, gsiObject)
+			}
+			input.TableCreationParameters.GlobalSecondaryIndexes = globalSecondaryIndexes
+		}
+
+		if v, ok := d.GetOk("server_side_encryption"); ok {
+			input.TableCreationParameters.SSESpecification = expandEncryptAtRestOptions(v.([]interface{}))
+		}
+
+		output, err := conn.ImportTable(input)
+
+		if err != nil {
+			return create.Error(names.DynamoDB, create.ErrActionCreating, ResNameTable, d.Get("name").(string), err)
+		}
+
+		if output == nil || output.ImportTableDescription == nil {
+			return errors.New("error creating DynamoDB Table: empty response")
+		}
+
+		importARN := aws.StringValue(output.ImportTableDescription.ImportArn)
+
+		// Set the ID before waiting so that the table is tracked, and can be destroyed, if the import fails or times out.
+		d.SetId(d.Get("name").(string))
+
+		if _, err := waitImportTableCompleted(conn, importARN, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return create.Error(names.DynamoDB, create.ErrActionWaitingForCreation, ResNameTable, d.Get("name").(string), fmt.Errorf("import (%s): %w", importARN, err))
+		}
 	} else {
 		input := &dynamodb.CreateTableInput{
 			TableName:   aws.String(d.Get("name").(string)),
@@ -538,6 +680,10 @@ func resourceTableCreate(d *schema.ResourceData, meta interface{}) error {
 			input.TableClass = aws.String(v.(string))
 		}
 
+		if v, ok := d.GetOk("deletion_protection_enabled"); ok {
+			input.DeletionProtectionEnabled = aws.Bool(v.(bool))
+		}
+
 		var output *dynamodb.CreateTableOutput
 		err := resource.Retry(createTableTimeout, func() *resource.RetryError {
 			var err error
@@ -591,6 +737,49 @@ func resourceTableCreate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	// Tags, streams and the table class cannot be specified when importing a table.
+	if v, ok := d.GetOk("import_table"); ok && len(v.([]interface{})) > 0 {
+		if len(tags) > 0 {
+			if err := UpdateTags(conn, aws.StringValue(output.TableArn), nil, tags); err != nil {
+				return create.Error(names.DynamoDB, create.ErrActionCreating, ResNameTable, d.Id(), fmt.Errorf("tags: %w", err))
+			}
+		}
+
+		input := &dynamodb.UpdateTableInput{
+			TableName: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("stream_enabled"); ok {
+			input.StreamSpecification = &dynamodb.StreamSpecification{
+				StreamEnabled:  aws.Bool(v.(bool)),
+				StreamViewType: aws.String(d.Get("stream_view_type").(string)),
+			}
+		}
+
+		if v, ok := d.GetOk("table_class"); ok {
+			input.TableClass = aws.String(v.(string))
+		}
+
+		if input.StreamSpecification != nil || input.TableClass != nil {
+			if _, err := conn.UpdateTable(input); err != nil {
+				return create.Error(names.DynamoDB, create.ErrActionCreating, ResNameTable, d.Id(), err)
+			}
+
+			if _, err := waitTableActive(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+				return create.Error(names.DynamoDB, create.ErrActionWaitingForCreation, ResNameTable, d.Id(), err)
+			}
+		}
+	}
+
+	// Deletion protection cannot be specified when restoring or importing a table.
+	if _, ok := d.GetOk("restore_source_name"); ok || len(d.Get("import_table").([]interface{})) > 0 {
+		if d.Get("deletion_protection_enabled").(bool) {
+			if err := updateDeletionProtection(conn, d.Id(), true, d.Timeout(schema.TimeoutCreate)); err != nil {
+				return create.Error(names.DynamoDB, create.ErrActionCreating, ResNameTable, d.Id(), err)
+			}
+		}
+	}
+
 	if d.Get("ttl.0.enabled").(bool) {
 		if err := updateTimeToLive(conn, d.Id(), d.Get("ttl").([]interface{}), d.Timeout(schema.TimeoutCreate)); err != nil {
 			return create.Error(names.DynamoDB, create.ErrActionCreating, ResNameTable, d.Id(), fmt.Errorf("enabling TTL: %w", err))
@@ -645,6 +834,7 @@ func resourceTableRead(d *schema.ResourceData, meta interface{}) error {
 	table := result.Table
 
 	d.Set("arn", table.TableArn)
+	d.Set("deletion_protection_enabled", table.DeletionProtectionEnabled)
 	d.Set("name", table.TableName)
 
 	if table.BillingModeSummary != nil {
@@ -766,6 +956,12 @@ func resourceTableUpdate(d *schema.ResourceData, meta interface{}) error {
 	billingMode := n.(string)
 	oldBillingMode := o.(string)
 
+	if d.HasChange("deletion_protection_enabled") {
+		if err := updateDeletionProtection(conn, d.Id(), d.Get("deletion_protection_enabled").(bool), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return create.Error(names.DynamoDB, create.ErrActionUpdating, ResNameTable, d.Id(), err)
+		}
+	}
+
 	// Global Secondary Index operations must occur in multiple phases
 	// to prevent various error scenarios. If there are no detected required
 	// updates in the Terraform configuration, later validation or API errors
@@ -1186,6 +1382,24 @@ func updateReplicaTags(conn *dynamodb.DynamoDB, rn string, replicas []interface{
 	return nil
 }
 
+func updateDeletionProtection(conn *dynamodb.DynamoDB, tableName string, enabled bool, timeout time.Duration) error {
+	input := &dynamodb.UpdateTableInput{
+		DeletionProtectionEnabled: aws.Bool(enabled),
+		TableName:                 aws.String(tableName),
+	}
+
+	log.Printf("[DEBUG] Updating DynamoDB Table (%s) deletion protection: %t", tableName, enabled)
+	if _, err := conn.UpdateTable(input); err != nil {
+		return fmt.Errorf("updating deletion protection: %w", err)
+	}
+
+	if _, err := waitTableActive(conn, tableName, timeout); err != nil {
+		return fmt.Errorf("waiting for deletion protection update: %w", err)
+	}
+
+	return nil
+}
+
 func updateTimeToLive(conn *dynamodb.DynamoDB, tableName string, ttlList []interface{}, timeout time.Duration) error {
 	ttlMap := ttlList[0].(map[string]interface{})
 
@@ -1710,6 +1924,51 @@ func flattenTableServerSideEncryption(description *dynamodb.SSEDescription) []in
 	return []interface{}{m}
 }
 
+func expandImportTable(tfMap map[string]interface{}) *dynamodb.ImportTableInput {
+	apiObject := &dynamodb.ImportTableInput{
+		InputFormat: aws.String(tfMap["input_format"].(string)),
+	}
+
+	if v, ok := tfMap["input_compression_type"].(string); ok && v != "" {
+		apiObject.InputCompressionType = aws.String(v)
+	}
+
+	if v, ok := tfMap["input_format_options"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.InputFormatOptions = &dynamodb.InputFormatOptions{}
+
+		if v, ok := v[0].(map[string]interface{})["csv"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.InputFormatOptions.Csv = &dynamodb.CsvOptions{}
+
+			if v, ok := tfMap["delimiter"].(string); ok && v != "" {
+				apiObject.InputFormatOptions.Csv.Delimiter = aws.String(v)
+			}
+
+			if v, ok := tfMap["header_list"].([]interface{}); ok && len(v) > 0 {
+				apiObject.InputFormatOptions.Csv.HeaderList = flex.ExpandStringList(v)
+			}
+		}
+	}
+
+	if v, ok := tfMap["s3_bucket_source"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.S3BucketSource = &dynamodb.S3BucketSource{
+			S3Bucket: aws.String(tfMap["bucket"].(string)),
+		}
+
+		if v, ok := tfMap["bucket_owner"].(string); ok && v != "" {
+			apiObject.S3BucketSource.S3BucketOwner = aws.String(v)
+		}
+
+		if v, ok := tfMap["key_prefix"].(string); ok && v != "" {
+			apiObject.S3BucketSource.S3KeyPrefix = aws.String(v)
+		}
+	}
+
+	return apiObject
+}
+
 func expandAttributes(cfg []interface{}) []*dynamodb.AttributeDefinition {
 	attributes := make([]*dynamodb.AttributeDefinition, len(cfg))
 	for i, attribute := range cfg {
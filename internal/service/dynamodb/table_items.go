@@ -0,0 +1,589 @@
+package dynamodb
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	// BatchWriteItem accepts at most 25 requests and BatchGetItem at most 100 keys.
+	tableItemsBatchWriteSize = 25
+	tableItemsBatchGetSize   = 100
+
+	tableItemsSourceFormatCSV  = "CSV"
+	tableItemsSourceFormatJSON = "JSON"
+)
+
+func tableItemsSourceFormat_Values() []string {
+	return []string{
+		tableItemsSourceFormatCSV,
+		tableItemsSourceFormatJSON,
+	}
+}
+
+func ResourceTableItems() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTableItemsCreate,
+		ReadContext:   resourceTableItemsRead,
+		UpdateContext: resourceTableItemsUpdate,
+		DeleteContext: resourceTableItemsDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: resourceTableItemsCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"hash_key": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"item": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"items": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ExactlyOneOf: []string{"items", "source"},
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateTableItem,
+				},
+			},
+			"range_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"source": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"items", "source"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"csv_attribute_types": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{dynamodb.ScalarAttributeTypeN, dynamodb.ScalarAttributeTypeS, "BOOL"}, false),
+							},
+						},
+						"format": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(tableItemsSourceFormat_Values(), false),
+						},
+						"path": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"table_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+// resourceTableItemsCustomizeDiff reads the configured items during planning and keys them by
+// their primary key, so that each added, changed or removed item is shown in the plan.
+func resourceTableItemsCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("items") || !d.NewValueKnown("source") || !d.NewValueKnown("hash_key") || !d.NewValueKnown("range_key") {
+		return d.SetNewComputed("item")
+	}
+
+	var items []map[string]*dynamodb.AttributeValue
+	var err error
+
+	if v, ok := d.GetOk("source"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		items, err = readTableItemsSource(v.([]interface{})[0].(map[string]interface{}))
+	} else {
+		items, err = expandTableItems(d.Get("items").([]interface{}))
+	}
+
+	if err != nil {
+		return err
+	}
+
+	item, err := flattenTableItemsByKey(items, d.Get("hash_key").(string), d.Get("range_key").(string))
+
+	if err != nil {
+		return err
+	}
+
+	return d.SetNew("item", item)
+}
+
+func resourceTableItemsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DynamoDBConn
+
+	tableName := d.Get("table_name").(string)
+	hashKey := d.Get("hash_key").(string)
+	rangeKey := d.Get("range_key").(string)
+
+	requests, err := tableItemsWriteRequests(nil, d.Get("item").(map[string]interface{}), hashKey, rangeKey)
+
+	if err != nil {
+		return diag.Errorf("creating DynamoDB Table (%s) items: %s", tableName, err)
+	}
+
+	if err := batchWriteTableItems(ctx, conn, tableName, requests); err != nil {
+		return diag.Errorf("creating DynamoDB Table (%s) items: %s", tableName, err)
+	}
+
+	d.SetId(strings.Join([]string{tableName, hashKey, rangeKey}, "|"))
+
+	return resourceTableItemsRead(ctx, d, meta)
+}
+
+func resourceTableItemsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DynamoDBConn
+
+	tableName := d.Get("table_name").(string)
+	hashKey := d.Get("hash_key").(string)
+	rangeKey := d.Get("range_key").(string)
+
+	var keys []map[string]*dynamodb.AttributeValue
+
+	for _, v := range d.Get("item").(map[string]interface{}) {
+		attributes, err := ExpandTableItemAttributes(v.(string))
+
+		if err != nil {
+			return diag.Errorf("reading DynamoDB Table (%s) items: %s", tableName, err)
+		}
+
+		keys = append(keys, BuildTableItemqueryKey(attributes, hashKey, rangeKey))
+	}
+
+	items, err := batchGetTableItems(ctx, conn, tableName, keys)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] DynamoDB Table (%s) not found, removing items from state", tableName)
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading DynamoDB Table (%s) items: %s", tableName, err)
+	}
+
+	// Items that no longer exist are removed, so that they are planned to be written again.
+	item, err := flattenTableItemsByKey(items, hashKey, rangeKey)
+
+	if err != nil {
+		return diag.Errorf("reading DynamoDB Table (%s) items: %s", tableName, err)
+	}
+
+	d.Set("item", item)
+
+	return nil
+}
+
+func resourceTableItemsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DynamoDBConn
+
+	if d.HasChange("item") {
+		tableName := d.Get("table_name").(string)
+		o, n := d.GetChange("item")
+
+		requests, err := tableItemsWriteRequests(o.(map[string]interface{}), n.(map[string]interface{}), d.Get("hash_key").(string), d.Get("range_key").(string))
+
+		if err != nil {
+			return diag.Errorf("updating DynamoDB Table (%s) items: %s", tableName, err)
+		}
+
+		if err := batchWriteTableItems(ctx, conn, tableName, requests); err != nil {
+			return diag.Errorf("updating DynamoDB Table (%s) items: %s", tableName, err)
+		}
+	}
+
+	return resourceTableItemsRead(ctx, d, meta)
+}
+
+func resourceTableItemsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DynamoDBConn
+
+	tableName := d.Get("table_name").(string)
+
+	requests, err := tableItemsWriteRequests(d.Get("item").(map[string]interface{}), nil, d.Get("hash_key").(string), d.Get("range_key").(string))
+
+	if err != nil {
+		return diag.Errorf("deleting DynamoDB Table (%s) items: %s", tableName, err)
+	}
+
+	log.Printf("[DEBUG] Deleting %d DynamoDB Table (%s) items", len(requests), tableName)
+	err = batchWriteTableItems(ctx, conn, tableName, requests)
+
+	if tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting DynamoDB Table (%s) items: %s", tableName, err)
+	}
+
+	return nil
+}
+
+// tableItemsWriteRequests returns the requests that write the new items that are added or changed
+// and delete the old items that are removed. Items are keyed by tableItemKey.
+func tableItemsWriteRequests(o, n map[string]interface{}, hashKey, rangeKey string) ([]*dynamodb.WriteRequest, error) {
+	var requests []*dynamodb.WriteRequest
+
+	for _, k := range sortedTableItemKeys(o) {
+		if _, ok := n[k]; ok {
+			continue
+		}
+
+		attributes, err := ExpandTableItemAttributes(o[k].(string))
+
+		if err != nil {
+			return nil, err
+		}
+
+		requests = append(requests, &dynamodb.WriteRequest{
+			DeleteRequest: &dynamodb.DeleteRequest{
+				Key: BuildTableItemqueryKey(attributes, hashKey, rangeKey),
+			},
+		})
+	}
+
+	for _, k := range sortedTableItemKeys(n) {
+		if v, ok := o[k]; ok && v == n[k] {
+			continue
+		}
+
+		attributes, err := ExpandTableItemAttributes(n[k].(string))
+
+		if err != nil {
+			return nil, err
+		}
+
+		requests = append(requests, &dynamodb.WriteRequest{
+			PutRequest: &dynamodb.PutRequest{
+				Item: attributes,
+			},
+		})
+	}
+
+	return requests, nil
+}
+
+func sortedTableItemKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+// batchWriteTableItems sends the requests in batches, retrying any unprocessed requests with backoff
+// until they are processed or the context is done.
+func batchWriteTableItems(ctx context.Context, conn *dynamodb.DynamoDB, tableName string, requests []*dynamodb.WriteRequest) error {
+	for len(requests) > 0 {
+		n := len(requests)
+		if n > tableItemsBatchWriteSize {
+			n = tableItemsBatchWriteSize
+		}
+
+		batch := requests[:n]
+		requests = requests[n:]
+
+		for delay := 100 * time.Millisecond; len(batch) > 0; delay *= 2 {
+			output, err := conn.BatchWriteItemWithContext(ctx, &dynamodb.BatchWriteItemInput{
+				RequestItems: map[string][]*dynamodb.WriteRequest{
+					tableName: batch,
+				},
+			})
+
+			if err != nil {
+				return err
+			}
+
+			batch = output.UnprocessedItems[tableName]
+
+			if len(batch) == 0 {
+				break
+			}
+
+			if delay > 20*time.Second {
+				delay = 20 * time.Second
+			}
+
+			log.Printf("[DEBUG] Retrying %d unprocessed DynamoDB Table (%s) item writes in %s", len(batch), tableName, delay)
+
+			select {
+			case <-ctx.Done():
+				return fmt.Errorf("%d item writes unprocessed: %w", len(batch)+len(requests), ctx.Err())
+			case <-time.After(delay):
+			}
+		}
+	}
+
+	return nil
+}
+
+// batchGetTableItems returns the items with the specified keys that exist, retrying any unprocessed keys
+// with backoff until they are processed or the context is done.
+func batchGetTableItems(ctx context.Context, conn *dynamodb.DynamoDB, tableName string, keys []map[string]*dynamodb.AttributeValue) ([]map[string]*dynamodb.AttributeValue, error) {
+	var items []map[string]*dynamodb.AttributeValue
+
+	for len(keys) > 0 {
+		n := len(keys)
+		if n > tableItemsBatchGetSize {
+			n = tableItemsBatchGetSize
+		}
+
+		batch := keys[:n]
+		keys = keys[n:]
+
+		for delay := 100 * time.Millisecond; len(batch) > 0; delay *= 2 {
+			output, err := conn.BatchGetItemWithContext(ctx, &dynamodb.BatchGetItemInput{
+				RequestItems: map[string]*dynamodb.KeysAndAttributes{
+					tableName: {
+						ConsistentRead: aws.Bool(true),
+						Keys:           batch,
+					},
+				},
+			})
+
+			if err != nil {
+				return nil, err
+			}
+
+			items = append(items, output.Responses[tableName]...)
+			batch = nil
+
+			if v, ok := output.UnprocessedKeys[tableName]; ok && v != nil {
+				batch = v.Keys
+			}
+
+			if len(batch) == 0 {
+				break
+			}
+
+			if delay > 20*time.Second {
+				delay = 20 * time.Second
+			}
+
+			select {
+			case <-ctx.Done():
+				return nil, fmt.Errorf("%d item reads unprocessed: %w", len(batch)+len(keys), ctx.Err())
+			case <-time.After(delay):
+			}
+		}
+	}
+
+	return items, nil
+}
+
+func expandTableItems(tfList []interface{}) ([]map[string]*dynamodb.AttributeValue, error) {
+	var items []map[string]*dynamodb.AttributeValue
+
+	for i, v := range tfList {
+		v, ok := v.(string)
+
+		if !ok {
+			continue
+		}
+
+		attributes, err := ExpandTableItemAttributes(v)
+
+		if err != nil {
+			return nil, fmt.Errorf("items[%d]: %w", i, err)
+		}
+
+		items = append(items, attributes)
+	}
+
+	return items, nil
+}
+
+// flattenTableItemsByKey returns the items' attributes JSON keyed by tableItemKey.
+func flattenTableItemsByKey(items []map[string]*dynamodb.AttributeValue, hashKey, rangeKey string) (map[string]interface{}, error) {
+	m := map[string]interface{}{}
+
+	for _, attributes := range items {
+		key, err := tableItemKey(attributes, hashKey, rangeKey)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := m[key]; ok {
+			return nil, fmt.Errorf("duplicate item with key %q", key)
+		}
+
+		v, err := flattenTableItemAttributes(attributes)
+
+		if err != nil {
+			return nil, err
+		}
+
+		m[key] = v
+	}
+
+	return m, nil
+}
+
+// tableItemKey returns a string form of an item's primary key: the hash key value or, if the table has a range key,
+// the JSON array of the hash and range key values, so that distinct keys never have the same string form.
+func tableItemKey(attributes map[string]*dynamodb.AttributeValue, hashKey, rangeKey string) (string, error) {
+	names := []string{hashKey}
+	if rangeKey != "" {
+		names = append(names, rangeKey)
+	}
+
+	var values []string
+
+	for _, name := range names {
+		v, ok := attributes[name]
+
+		if !ok || v == nil {
+			return "", fmt.Errorf("item is missing key attribute %q", name)
+		}
+
+		switch {
+		case v.S != nil:
+			values = append(values, aws.StringValue(v.S))
+		case v.N != nil:
+			values = append(values, aws.StringValue(v.N))
+		case v.B != nil:
+			values = append(values, verify.Base64Encode(v.B))
+		default:
+			return "", fmt.Errorf("key attribute %q must be of type S, N or B", name)
+		}
+	}
+
+	if len(values) == 1 {
+		return values[0], nil
+	}
+
+	b, err := json.Marshal(values)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+func readTableItemsSource(tfMap map[string]interface{}) ([]map[string]*dynamodb.AttributeValue, error) {
+	path := tfMap["path"].(string)
+	f, err := os.Open(path)
+
+	if err != nil {
+		return nil, fmt.Errorf("reading items source: %w", err)
+	}
+
+	defer f.Close()
+
+	var items []map[string]*dynamodb.AttributeValue
+
+	switch tfMap["format"].(string) {
+	case tableItemsSourceFormatCSV:
+		items, err = decodeTableItemsCSV(f, flex.ExpandStringValueMap(tfMap["csv_attribute_types"].(map[string]interface{})))
+	case tableItemsSourceFormatJSON:
+		err = json.NewDecoder(f).Decode(&items)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("decoding items source (%s): %w", path, err)
+	}
+
+	return items, nil
+}
+
+// decodeTableItemsCSV decodes CSV with a header row of attribute names into items.
+// Values are strings unless their attribute is typed as N or BOOL. Empty values are omitted.
+func decodeTableItemsCSV(r io.Reader, attributeTypes map[string]string) ([]map[string]*dynamodb.AttributeValue, error) {
+	reader := csv.NewReader(r)
+	header, err := reader.Read()
+
+	if err == io.EOF {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	var items []map[string]*dynamodb.AttributeValue
+
+	for {
+		record, err := reader.Read()
+
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		item := map[string]*dynamodb.AttributeValue{}
+
+		for i, v := range record {
+			if v == "" {
+				continue
+			}
+
+			name := header[i]
+
+			switch attributeTypes[name] {
+			case dynamodb.ScalarAttributeTypeN:
+				item[name] = &dynamodb.AttributeValue{N: aws.String(v)}
+			case "BOOL":
+				switch strings.ToLower(v) {
+				case "true":
+					item[name] = &dynamodb.AttributeValue{BOOL: aws.Bool(true)}
+				case "false":
+					item[name] = &dynamodb.AttributeValue{BOOL: aws.Bool(false)}
+				default:
+					line, _ := reader.FieldPos(i)
+					return nil, fmt.Errorf("line %d: invalid BOOL value %q for attribute %q", line, v, name)
+				}
+			default:
+				item[name] = &dynamodb.AttributeValue{S: aws.String(v)}
+			}
+		}
+
+		items = append(items, item)
+	}
+
+	return items, nil
+}
@@ -0,0 +1,179 @@
+package dynamodb
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+)
+
+// testDynamoDBConn returns a DynamoDB client whose requests are served by handler.
+func testDynamoDBConn(t *testing.T, handler http.HandlerFunc) *dynamodb.DynamoDB {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("AKID", "SECRET", ""),
+		Endpoint:    aws.String(server.URL),
+		Region:      aws.String("us-west-2"),
+		MaxRetries:  aws.Int(0),
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	return dynamodb.New(sess)
+}
+
+func TestBatchWriteTableItems(t *testing.T) {
+	var requests []int
+
+	conn := testDynamoDBConn(t, func(w http.ResponseWriter, r *http.Request) {
+		var input dynamodb.BatchWriteItemInput
+		body, _ := io.ReadAll(r.Body)
+		json.Unmarshal(body, &input)
+
+		batch := input.RequestItems["test"]
+		requests = append(requests, len(batch))
+
+		output := dynamodb.BatchWriteItemOutput{}
+
+		// The first attempt of each batch leaves its last write unprocessed.
+		if len(requests)%2 == 1 && len(batch) > 1 {
+			output.UnprocessedItems = map[string][]*dynamodb.WriteRequest{"test": batch[len(batch)-1:]}
+		}
+
+		w.Header().Set("Content-Type", "application/x-amz-json-1.0")
+		json.NewEncoder(w).Encode(output)
+	})
+
+	var writes []*dynamodb.WriteRequest
+
+	for i := 0; i < 30; i++ {
+		writes = append(writes, &dynamodb.WriteRequest{
+			PutRequest: &dynamodb.PutRequest{
+				Item: map[string]*dynamodb.AttributeValue{"id": {N: aws.String(strings.Repeat("1", i+1))}},
+			},
+		})
+	}
+
+	if err := batchWriteTableItems(context.Background(), conn, "test", writes); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if expected := []int{25, 1, 5, 1}; !reflect.DeepEqual(requests, expected) {
+		t.Errorf("got batch sizes %v, expected %v", requests, expected)
+	}
+}
+
+func TestTableItemsWriteRequests(t *testing.T) {
+	o := map[string]interface{}{
+		"1": `{"id":{"S":"1"},"value":{"S":"one"}}`,
+		"2": `{"id":{"S":"2"},"value":{"S":"two"}}`,
+		"3": `{"id":{"S":"3"},"value":{"S":"three"}}`,
+	}
+	n := map[string]interface{}{
+		"1": `{"id":{"S":"1"},"value":{"S":"one"}}`,
+		"2": `{"id":{"S":"2"},"value":{"S":"TWO"}}`,
+		"4": `{"id":{"S":"4"},"value":{"S":"four"}}`,
+	}
+
+	requests, err := tableItemsWriteRequests(o, n, "id", "")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var got []string
+
+	for _, v := range requests {
+		if v.DeleteRequest != nil {
+			got = append(got, "delete "+aws.StringValue(v.DeleteRequest.Key["id"].S))
+		}
+
+		if v.PutRequest != nil {
+			got = append(got, "put "+aws.StringValue(v.PutRequest.Item["id"].S)+"="+aws.StringValue(v.PutRequest.Item["value"].S))
+		}
+	}
+
+	if expected := []string{"delete 3", "put 2=TWO", "put 4=four"}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("got requests %v, expected %v", got, expected)
+	}
+}
+
+func TestFlattenTableItemsByKey(t *testing.T) {
+	items := []map[string]*dynamodb.AttributeValue{
+		{"pk": {S: aws.String("a")}, "sk": {N: aws.String("1")}},
+		{"pk": {S: aws.String("a")}, "sk": {N: aws.String("2")}},
+	}
+
+	got, err := flattenTableItemsByKey(items, "pk", "sk")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, ok := got[`["a","2"]`]; !ok || len(got) != 2 {
+		t.Errorf(`got keys %v, expected ["a","1"] and ["a","2"]`, got)
+	}
+
+	// Key values containing separators must not collide.
+	items = []map[string]*dynamodb.AttributeValue{
+		{"pk": {S: aws.String("a|b")}, "sk": {S: aws.String("c")}},
+		{"pk": {S: aws.String("a")}, "sk": {S: aws.String("b|c")}},
+		{"pk": {S: aws.String(`a","b`)}, "sk": {S: aws.String("c")}},
+		{"pk": {S: aws.String("a")}, "sk": {S: aws.String(`b","c`)}},
+	}
+
+	got, err = flattenTableItemsByKey(items, "pk", "sk")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(got) != len(items) {
+		t.Errorf("got keys %v, expected %d distinct keys", got, len(items))
+	}
+
+	if _, err := flattenTableItemsByKey(items, "pk", ""); err == nil || !strings.Contains(err.Error(), `duplicate item with key "a"`) {
+		t.Errorf("expected duplicate item error, got %v", err)
+	}
+
+	if _, err := flattenTableItemsByKey(items, "id", ""); err == nil || !strings.Contains(err.Error(), `missing key attribute "id"`) {
+		t.Errorf("expected missing key attribute error, got %v", err)
+	}
+}
+
+func TestDecodeTableItemsCSV(t *testing.T) {
+	items, err := decodeTableItemsCSV(strings.NewReader("id,count,active,note\na,1,true,\nb,2,FALSE,hello\n"), map[string]string{
+		"count":  dynamodb.ScalarAttributeTypeN,
+		"active": "BOOL",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []map[string]*dynamodb.AttributeValue{
+		{"id": {S: aws.String("a")}, "count": {N: aws.String("1")}, "active": {BOOL: aws.Bool(true)}},
+		{"id": {S: aws.String("b")}, "count": {N: aws.String("2")}, "active": {BOOL: aws.Bool(false)}, "note": {S: aws.String("hello")}},
+	}
+
+	if !reflect.DeepEqual(items, expected) {
+		t.Errorf("got %v, expected %v", items, expected)
+	}
+
+	if _, err := decodeTableItemsCSV(strings.NewReader("id,active\na,yes\n"), map[string]string{"active": "BOOL"}); err == nil || !strings.Contains(err.Error(), `line 2: invalid BOOL value "yes"`) {
+		t.Errorf("expected invalid BOOL error, got %v", err)
+	}
+}
@@ -0,0 +1,174 @@
+package dynamodb_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdynamodb "github.com/hashicorp/terraform-provider-aws/internal/service/dynamodb"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccDynamoDBTableItems_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_table_items.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableItemsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableItemsConfig_basic(rName, `["one", "two", "three"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableItemCount(rName, 3),
+					resource.TestCheckResourceAttr(resourceName, "hash_key", "id"),
+					resource.TestCheckResourceAttr(resourceName, "item.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "item.two", `{"id":{"S":"two"},"value":{"S":"two"}}`),
+					resource.TestCheckResourceAttr(resourceName, "items.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "table_name", rName),
+				),
+			},
+			{
+				Config: testAccTableItemsConfig_basic(rName, `["one", "three", "four", "five"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableItemCount(rName, 4),
+					resource.TestCheckResourceAttr(resourceName, "item.%", "4"),
+					resource.TestCheckNoResourceAttr(resourceName, "item.two"),
+					resource.TestCheckResourceAttr(resourceName, "item.five", `{"id":{"S":"five"},"value":{"S":"five"}}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTableItems_sourceCSV(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_table_items.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableItemsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableItemsConfig_sourceCSV(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableItemCount(rName, 2),
+					resource.TestCheckResourceAttr(resourceName, "item.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, `item.["a","1"]`, `{"count":{"N":"10"},"pk":{"S":"a"},"sk":{"N":"1"}}`),
+					resource.TestCheckResourceAttr(resourceName, "source.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "source.0.format", "CSV"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTableItemsDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_dynamodb_table_items" {
+			continue
+		}
+
+		attrs := rs.Primary.Attributes
+
+		for k, v := range attrs {
+			if k == "item.%" || len(k) < len("item.") || k[:len("item.")] != "item." {
+				continue
+			}
+
+			attributes, err := tfdynamodb.ExpandTableItemAttributes(v)
+			if err != nil {
+				return err
+			}
+
+			key := tfdynamodb.BuildTableItemqueryKey(attributes, attrs["hash_key"], attrs["range_key"])
+
+			_, err = tfdynamodb.FindTableItem(conn, attrs["table_name"], key)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("DynamoDB table item %s in %s still exists.", k, rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccTableItemsConfig_basic(rName, ids string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name         = %[1]q
+  billing_mode = "PAY_PER_REQUEST"
+  hash_key     = "id"
+
+  attribute {
+    name = "id"
+    type = "S"
+  }
+}
+
+resource "aws_dynamodb_table_items" "test" {
+  table_name = aws_dynamodb_table.test.name
+  hash_key   = aws_dynamodb_table.test.hash_key
+
+  items = [for id in %[2]s : jsonencode({
+    id    = { S = id }
+    value = { S = id }
+  })]
+}
+`, rName, ids)
+}
+
+func testAccTableItemsConfig_sourceCSV(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name         = %[1]q
+  billing_mode = "PAY_PER_REQUEST"
+  hash_key     = "pk"
+  range_key    = "sk"
+
+  attribute {
+    name = "pk"
+    type = "S"
+  }
+
+  attribute {
+    name = "sk"
+    type = "N"
+  }
+}
+
+resource "aws_dynamodb_table_items" "test" {
+  table_name = aws_dynamodb_table.test.name
+  hash_key   = aws_dynamodb_table.test.hash_key
+  range_key  = aws_dynamodb_table.test.range_key
+
+  source {
+    format = "CSV"
+    path   = "test-fixtures/table_items.csv"
+
+    csv_attribute_types = {
+      count = "N"
+      sk    = "N"
+    }
+  }
+}
+`, rName)
+}
@@ -2,10 +2,13 @@ package dynamodb
 
 import (
 	"context"
+	"fmt"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -284,3 +287,45 @@ func waitContributorInsightsDeleted(ctx context.Context, conn *dynamodb.DynamoDB
 
 	return err
 }
+
+func waitImportTableCompleted(conn *dynamodb.DynamoDB, arn string, timeout time.Duration) (*dynamodb.ImportTableDescription, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{dynamodb.ImportStatusInProgress},
+		Target:  []string{dynamodb.ImportStatusCompleted},
+		Timeout: maxDuration(createTableTimeout, timeout),
+		Refresh: statusImportTable(conn, arn),
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*dynamodb.ImportTableDescription); ok {
+		if v := aws.StringValue(output.FailureMessage); v != "" {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(output.FailureCode), v))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitTableExportCompleted(ctx context.Context, conn *dynamodb.DynamoDB, arn string, timeout time.Duration) (*dynamodb.ExportDescription, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{dynamodb.ExportStatusInProgress},
+		Target:  []string{dynamodb.ExportStatusCompleted},
+		Timeout: timeout,
+		Refresh: statusTableExport(ctx, conn, arn),
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*dynamodb.ExportDescription); ok {
+		if v := aws.StringValue(output.FailureMessage); v != "" {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(output.FailureCode), v))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
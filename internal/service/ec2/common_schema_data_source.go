@@ -42,3 +42,12 @@ func DataSourceFiltersSchema() *schema.Schema {
 		},
 	}
 }
+
+// DataSourceFiltersSchemaForOperation returns a DataSourceFiltersSchema whose filter names
+// are validated at plan time against those documented for the specified EC2 API operation.
+func DataSourceFiltersSchemaForOperation(operation string) *schema.Schema {
+	v := DataSourceFiltersSchema()
+	v.Elem.(*schema.Resource).Schema["name"].ValidateDiagFunc = validFilterName(operation)
+
+	return v
+}
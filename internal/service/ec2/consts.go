@@ -285,3 +285,31 @@ func securityGroupRuleType_Values() []string {
 		securityGroupRuleTypeIngress,
 	}
 }
+
+const (
+	cloudInitContentTypeBoothook    = "text/cloud-boothook"
+	cloudInitContentTypeCloudConfig = "text/cloud-config"
+	cloudInitContentTypeShellScript = "text/x-shellscript"
+)
+
+func cloudInitContentType_Values() []string {
+	return []string{
+		cloudInitContentTypeBoothook,
+		cloudInitContentTypeCloudConfig,
+		cloudInitContentTypeShellScript,
+	}
+}
+
+const (
+	cloudInitGzipAlways = "always"
+	cloudInitGzipAuto   = "auto"
+	cloudInitGzipNever  = "never"
+)
+
+func cloudInitGzip_Values() []string {
+	return []string{
+		cloudInitGzipAlways,
+		cloudInitGzipAuto,
+		cloudInitGzipNever,
+	}
+}
@@ -39,7 +39,7 @@ func DataSourceEBSSnapshot() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
-			"filter": DataSourceFiltersSchema(),
+			"filter": DataSourceFiltersSchemaForOperation("DescribeSnapshots"),
 			"kms_key_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -88,7 +88,8 @@ func DataSourceEBSSnapshot() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"tags": tftags.TagsSchemaComputed(),
+			"tag_filter": TagFiltersSchema(),
+			"tags":       tftags.TagsSchemaComputed(),
 			"volume_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -123,6 +124,10 @@ func dataSourceEBSSnapshotRead(d *schema.ResourceData, meta interface{}) error {
 		d.Get("filter").(*schema.Set),
 	)...)
 
+	input.Filters = append(input.Filters, BuildTagFiltersList(
+		d.Get("tag_filter").(*schema.Set),
+	)...)
+
 	if len(input.Filters) == 0 {
 		input.Filters = nil
 	}
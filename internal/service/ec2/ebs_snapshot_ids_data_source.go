@@ -21,7 +21,7 @@ func DataSourceEBSSnapshotIDs() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
-			"filter": DataSourceFiltersSchema(),
+			"filter": DataSourceFiltersSchemaForOperation("DescribeSnapshots"),
 			"ids": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -37,6 +37,7 @@ func DataSourceEBSSnapshotIDs() *schema.Resource {
 				Optional: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"tag_filter": TagFiltersSchema(),
 		},
 	}
 }
@@ -58,6 +59,10 @@ func dataSourceEBSSnapshotIDsRead(d *schema.ResourceData, meta interface{}) erro
 		d.Get("filter").(*schema.Set),
 	)...)
 
+	input.Filters = append(input.Filters, BuildTagFiltersList(
+		d.Get("tag_filter").(*schema.Set),
+	)...)
+
 	if len(input.Filters) == 0 {
 		input.Filters = nil
 	}
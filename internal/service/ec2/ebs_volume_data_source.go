@@ -34,7 +34,7 @@ func DataSourceEBSVolume() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
-			"filter": DataSourceFiltersSchema(),
+			"filter": DataSourceFiltersSchemaForOperation("DescribeVolumes"),
 			"iops": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -64,7 +64,8 @@ func DataSourceEBSVolume() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"tags": tftags.TagsSchemaComputed(),
+			"tag_filter": TagFiltersSchema(),
+			"tags":       tftags.TagsSchemaComputed(),
 			"throughput": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -91,6 +92,10 @@ func dataSourceEBSVolumeRead(d *schema.ResourceData, meta interface{}) error {
 		d.Get("filter").(*schema.Set),
 	)...)
 
+	input.Filters = append(input.Filters, BuildTagFiltersList(
+		d.Get("tag_filter").(*schema.Set),
+	)...)
+
 	if len(input.Filters) == 0 {
 		input.Filters = nil
 	}
@@ -20,13 +20,14 @@ func DataSourceEBSVolumes() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
-			"filter": DataSourceFiltersSchema(),
+			"filter": DataSourceFiltersSchemaForOperation("DescribeVolumes"),
 			"ids": {
 				Type:     schema.TypeList,
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
-			"tags": tftags.TagsSchema(),
+			"tag_filter": TagFiltersSchema(),
+			"tags":       tftags.TagsSchema(),
 		},
 	}
 }
@@ -44,6 +45,10 @@ func dataSourceEBSVolumesRead(d *schema.ResourceData, meta interface{}) error {
 		d.Get("filter").(*schema.Set),
 	)...)
 
+	input.Filters = append(input.Filters, BuildTagFiltersList(
+		d.Get("tag_filter").(*schema.Set),
+	)...)
+
 	if len(input.Filters) == 0 {
 		input.Filters = nil
 	}
@@ -87,7 +87,7 @@ func DataSourceAMI() *schema.Resource {
 				Optional: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
-			"filter": DataSourceFiltersSchema(),
+			"filter": DataSourceFiltersSchemaForOperation("DescribeImages"),
 			"hypervisor": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -206,7 +206,8 @@ func DataSourceAMI() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
-			"tags": tftags.TagsSchemaComputed(),
+			"tag_filter": TagFiltersSchema(),
+			"tags":       tftags.TagsSchemaComputed(),
 			"tpm_support": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -243,6 +244,10 @@ func dataSourceAMIRead(d *schema.ResourceData, meta interface{}) error {
 		params.Filters = BuildFiltersDataSource(v.(*schema.Set))
 	}
 
+	if v, ok := d.GetOk("tag_filter"); ok {
+		params.Filters = append(params.Filters, BuildTagFiltersList(v.(*schema.Set))...)
+	}
+
 	log.Printf("[DEBUG] Reading AMI: %s", params)
 	resp, err := conn.DescribeImages(params)
 	if err != nil {
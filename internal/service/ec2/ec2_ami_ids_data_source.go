@@ -25,7 +25,7 @@ func DataSourceAMIIDs() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
-			"filter": DataSourceFiltersSchema(),
+			"filter": DataSourceFiltersSchemaForOperation("DescribeImages"),
 			"executable_users": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -55,6 +55,7 @@ func DataSourceAMIIDs() *schema.Resource {
 				Default:  false,
 				Optional: true,
 			},
+			"tag_filter": TagFiltersSchema(),
 		},
 	}
 }
@@ -73,6 +74,10 @@ func dataSourceAMIIDsRead(d *schema.ResourceData, meta interface{}) error {
 		params.Filters = BuildFiltersDataSource(v.(*schema.Set))
 	}
 
+	if v, ok := d.GetOk("tag_filter"); ok {
+		params.Filters = append(params.Filters, BuildTagFiltersList(v.(*schema.Set))...)
+	}
+
 	log.Printf("[DEBUG] Reading AMI IDs: %s", params)
 	resp, err := conn.DescribeImages(params)
 	if err != nil {
@@ -157,40 +157,41 @@ func resourceAMILaunchPermissionDelete(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceAMILaunchPermissionImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	const importIDSeparator = "/"
-	parts := strings.Split(d.Id(), importIDSeparator)
+	parts, err := amiLaunchPermissionImportIDFormat.Parse(d.Id())
+
+	if err != nil {
+		return nil, err
+	}
+
+	permissionID, imageID := parts[0], parts[1]
 
 	// Heuristic to identify the permission type.
 	var ok bool
-	if n := len(parts); n >= 2 {
-		if permissionID, imageID := strings.Join(parts[:n-1], importIDSeparator), parts[n-1]; permissionID != "" && imageID != "" {
-			if regexp.MustCompile(`^\d{12}$`).MatchString(permissionID) {
-				// AWS account ID.
-				d.SetId(AMILaunchPermissionCreateResourceID(imageID, permissionID, "", "", ""))
+	if regexp.MustCompile(`^\d{12}$`).MatchString(permissionID) {
+		// AWS account ID.
+		d.SetId(AMILaunchPermissionCreateResourceID(imageID, permissionID, "", "", ""))
+		ok = true
+	} else if arn.IsARN(permissionID) {
+		if v, _ := arn.Parse(permissionID); v.Service == "organizations" {
+			// See https://docs.aws.amazon.com/service-authorization/latest/reference/list_awsorganizations.html#awsorganizations-resources-for-iam-policies.
+			if strings.HasPrefix(v.Resource, "organization/") {
+				// Organization ARN.
+				d.SetId(AMILaunchPermissionCreateResourceID(imageID, "", "", permissionID, ""))
 				ok = true
-			} else if arn.IsARN(permissionID) {
-				if v, _ := arn.Parse(permissionID); v.Service == "organizations" {
-					// See https://docs.aws.amazon.com/service-authorization/latest/reference/list_awsorganizations.html#awsorganizations-resources-for-iam-policies.
-					if strings.HasPrefix(v.Resource, "organization/") {
-						// Organization ARN.
-						d.SetId(AMILaunchPermissionCreateResourceID(imageID, "", "", permissionID, ""))
-						ok = true
-					} else if strings.HasPrefix(v.Resource, "ou/") {
-						// Organizational unit ARN.
-						d.SetId(AMILaunchPermissionCreateResourceID(imageID, "", "", "", permissionID))
-						ok = true
-					}
-				}
-			} else {
-				// Group name.
-				d.SetId(AMILaunchPermissionCreateResourceID(imageID, "", permissionID, "", ""))
+			} else if strings.HasPrefix(v.Resource, "ou/") {
+				// Organizational unit ARN.
+				d.SetId(AMILaunchPermissionCreateResourceID(imageID, "", "", "", permissionID))
 				ok = true
 			}
 		}
+	} else {
+		// Group name.
+		d.SetId(AMILaunchPermissionCreateResourceID(imageID, "", permissionID, "", ""))
+		ok = true
 	}
 
 	if !ok {
-		return nil, fmt.Errorf("unexpected format for ID (%[1]s), expected [ACCOUNT-ID|GROUP-NAME|ORGANIZATION-ARN|ORGANIZATIONAL-UNIT-ARN]%[2]sIMAGE-ID", d.Id(), importIDSeparator)
+		return nil, fmt.Errorf("unexpected format for ID (%[1]s), expected %[2]s", d.Id(), amiLaunchPermissionImportIDFormat)
 	}
 
 	return []*schema.ResourceData{d}, nil
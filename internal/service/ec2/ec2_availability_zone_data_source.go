@@ -24,7 +24,7 @@ func DataSourceAvailabilityZone() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
-			"filter": CustomFiltersSchema(),
+			"filter": CustomFiltersSchemaForOperation("DescribeAvailabilityZones"),
 			"group_name": {
 				Type:     schema.TypeString,
 				Computed: true,
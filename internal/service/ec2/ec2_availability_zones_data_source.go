@@ -36,7 +36,7 @@ func DataSourceAvailabilityZones() *schema.Resource {
 				Optional: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
-			"filter": CustomFiltersSchema(),
+			"filter": CustomFiltersSchemaForOperation("DescribeAvailabilityZones"),
 			"group_names": {
 				Type:     schema.TypeSet,
 				Computed: true,
@@ -0,0 +1,229 @@
+package ec2
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"mime/multipart"
+	"net/textproto"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// userDataMaxSize is the maximum size of EC2 instance user data, before base64 encoding.
+	userDataMaxSize = 16 * 1024
+	// cloudInitGzipThreshold is the rendered size above which "auto" gzips the document.
+	cloudInitGzipThreshold = userDataMaxSize * 9 / 10
+)
+
+func DataSourceCloudInitConfig() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceCloudInitConfigRead,
+
+		Schema: map[string]*schema.Schema{
+			"boundary": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "MIMEBOUNDARY",
+				ValidateFunc: validation.StringLenBetween(1, 70),
+			},
+			"gzip": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      cloudInitGzipAuto,
+				ValidateFunc: validation.StringInSlice(cloudInitGzip_Values(), false),
+			},
+			"gzipped": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"part": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"content": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"content_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(cloudInitContentType_Values(), false),
+						},
+						"filename": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"merge_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"rendered": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"rendered_base64": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceCloudInitConfigRead(d *schema.ResourceData, meta interface{}) error {
+	parts := expandCloudInitParts(d.Get("part").([]interface{}))
+
+	rendered, err := renderCloudInitConfig(parts, d.Get("boundary").(string))
+
+	if err != nil {
+		return fmt.Errorf("rendering cloud-init config: %w", err)
+	}
+
+	userData, gzipped, err := compressCloudInitConfig(rendered, d.Get("gzip").(string))
+
+	if err != nil {
+		return fmt.Errorf("rendering cloud-init config: %w", err)
+	}
+
+	d.SetId(strconv.Itoa(create.StringHashcode(rendered)))
+	d.Set("gzipped", gzipped)
+	d.Set("rendered", rendered)
+	d.Set("rendered_base64", base64.StdEncoding.EncodeToString(userData))
+
+	return nil
+}
+
+type cloudInitPart struct {
+	content     string
+	contentType string
+	filename    string
+	mergeType   string
+}
+
+func expandCloudInitParts(tfList []interface{}) []cloudInitPart {
+	var parts []cloudInitPart
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		parts = append(parts, cloudInitPart{
+			content:     tfMap["content"].(string),
+			contentType: tfMap["content_type"].(string),
+			filename:    tfMap["filename"].(string),
+			mergeType:   tfMap["merge_type"].(string),
+		})
+	}
+
+	return parts
+}
+
+// validateCloudInitPart returns an error if a part's content is not valid for its content type.
+func validateCloudInitPart(part cloudInitPart) error {
+	switch part.contentType {
+	case cloudInitContentTypeCloudConfig:
+		var v map[string]interface{}
+
+		if err := yaml.Unmarshal([]byte(part.content), &v); err != nil {
+			return fmt.Errorf("invalid cloud-config YAML: %w", err)
+		}
+	case cloudInitContentTypeShellScript:
+		if !strings.HasPrefix(part.content, "#!") {
+			return fmt.Errorf("shell script must start with an interpreter directive (#!)")
+		}
+	}
+
+	return nil
+}
+
+// renderCloudInitConfig assembles the parts into a cloud-init MIME multipart document.
+func renderCloudInitConfig(parts []cloudInitPart, boundary string) (string, error) {
+	var buf bytes.Buffer
+
+	fmt.Fprintf(&buf, "Content-Type: multipart/mixed; boundary=%q\r\n", boundary)
+	buf.WriteString("MIME-Version: 1.0\r\n\r\n")
+
+	w := multipart.NewWriter(&buf)
+
+	if err := w.SetBoundary(boundary); err != nil {
+		return "", err
+	}
+
+	for i, part := range parts {
+		if err := validateCloudInitPart(part); err != nil {
+			return "", fmt.Errorf("part %d: %w", i, err)
+		}
+
+		header := textproto.MIMEHeader{}
+		header.Set("Content-Transfer-Encoding", "7bit")
+		header.Set("Content-Type", part.contentType)
+		header.Set("Mime-Version", "1.0")
+
+		if part.filename != "" {
+			header.Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", part.filename))
+		}
+
+		if part.mergeType != "" {
+			header.Set("X-Merge-Type", part.mergeType)
+		}
+
+		pw, err := w.CreatePart(header)
+
+		if err != nil {
+			return "", err
+		}
+
+		if _, err := pw.Write([]byte(part.content)); err != nil {
+			return "", err
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
+
+// compressCloudInitConfig returns the user data for a rendered document, gzipping it as configured,
+// and whether it was gzipped. An error is returned if the user data exceeds the EC2 size limit.
+func compressCloudInitConfig(rendered, mode string) ([]byte, bool, error) {
+	userData := []byte(rendered)
+	gzipped := mode == cloudInitGzipAlways || (mode == cloudInitGzipAuto && len(userData) > cloudInitGzipThreshold)
+
+	if gzipped {
+		var buf bytes.Buffer
+		w := gzip.NewWriter(&buf)
+
+		if _, err := w.Write(userData); err != nil {
+			return nil, false, err
+		}
+
+		if err := w.Close(); err != nil {
+			return nil, false, err
+		}
+
+		userData = buf.Bytes()
+	}
+
+	if n := len(userData); n > userDataMaxSize {
+		return nil, false, fmt.Errorf("user data is %d bytes, which exceeds the %d byte limit", n, userDataMaxSize)
+	}
+
+	return userData, gzipped, nil
+}
@@ -0,0 +1,130 @@
+package ec2_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccEC2CloudInitConfigDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_ec2_cloudinit_config.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudInitConfigDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "gzipped", "false"),
+					resource.TestMatchResourceAttr(dataSourceName, "rendered", regexp.MustCompile(`^Content-Type: multipart/mixed; boundary="MIMEBOUNDARY"\r\nMIME-Version: 1.0\r\n`)),
+					resource.TestMatchResourceAttr(dataSourceName, "rendered", regexp.MustCompile(`Content-Type: text/cloud-config\r\n`)),
+					resource.TestMatchResourceAttr(dataSourceName, "rendered", regexp.MustCompile(`Content-Disposition: attachment; filename="init.sh"\r\n`)),
+					resource.TestMatchResourceAttr(dataSourceName, "rendered", regexp.MustCompile(`X-Merge-Type: list\(append\)\+dict\(recurse_array\)\+str\(\)\r\n`)),
+					resource.TestMatchResourceAttr(dataSourceName, "rendered", regexp.MustCompile(`\r\n--MIMEBOUNDARY--\r\n$`)),
+					resource.TestCheckResourceAttrSet(dataSourceName, "rendered_base64"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2CloudInitConfigDataSource_gzip(t *testing.T) {
+	dataSourceName := "data.aws_ec2_cloudinit_config.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudInitConfigDataSourceConfig_gzip("always", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "gzipped", "true"),
+					// Base64 encoded gzip magic number.
+					resource.TestMatchResourceAttr(dataSourceName, "rendered_base64", regexp.MustCompile(`^H4sI`)),
+				),
+			},
+			{
+				Config: testAccCloudInitConfigDataSourceConfig_gzip("auto", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "gzipped", "false"),
+				),
+			},
+			{
+				Config: testAccCloudInitConfigDataSourceConfig_gzip("auto", 1500),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "gzipped", "true"),
+				),
+			},
+			{
+				Config:      testAccCloudInitConfigDataSourceConfig_gzip("never", 1500),
+				ExpectError: regexp.MustCompile(`exceeds the 16384 byte limit`),
+			},
+		},
+	})
+}
+
+func TestAccEC2CloudInitConfigDataSource_invalidCloudConfig(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCloudInitConfigDataSourceConfig_invalidCloudConfig,
+				ExpectError: regexp.MustCompile(`part 0: invalid cloud-config YAML`),
+			},
+		},
+	})
+}
+
+const testAccCloudInitConfigDataSourceConfig_basic = `
+data "aws_ec2_cloudinit_config" "test" {
+  part {
+    content_type = "text/cloud-config"
+    content      = <<-EOT
+      #cloud-config
+      packages:
+        - httpd
+    EOT
+    merge_type   = "list(append)+dict(recurse_array)+str()"
+  }
+
+  part {
+    content_type = "text/x-shellscript"
+    filename     = "init.sh"
+    content      = <<-EOT
+      #!/bin/sh
+      systemctl start httpd
+    EOT
+  }
+}
+`
+
+func testAccCloudInitConfigDataSourceConfig_gzip(mode string, lines int) string {
+	return fmt.Sprintf(`
+data "aws_ec2_cloudinit_config" "test" {
+  gzip = %[1]q
+
+  part {
+    content_type = "text/x-shellscript"
+    content      = "#!/bin/sh\n${join("", [for i in range(%[2]d) : "echo 'line ${i}'\n"])}"
+  }
+}
+`, mode, lines)
+}
+
+const testAccCloudInitConfigDataSourceConfig_invalidCloudConfig = `
+data "aws_ec2_cloudinit_config" "test" {
+  part {
+    content_type = "text/cloud-config"
+    content      = "packages: [httpd"
+  }
+}
+`
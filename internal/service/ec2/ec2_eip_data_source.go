@@ -41,7 +41,7 @@ func DataSourceEIP() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"filter": CustomFiltersSchema(),
+			"filter": CustomFiltersSchemaForOperation("DescribeAddresses"),
 			"id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -80,7 +80,8 @@ func DataSourceEIP() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"tags": tftags.TagsSchemaComputed(),
+			"tag_filter": TagFiltersSchema(),
+			"tags":       tftags.TagsSchemaComputed(),
 		},
 	}
 }
@@ -107,6 +108,10 @@ func dataSourceEIPRead(d *schema.ResourceData, meta interface{}) error {
 		d.Get("filter").(*schema.Set),
 	)...)
 
+	input.Filters = append(input.Filters, BuildTagFiltersList(
+		d.Get("tag_filter").(*schema.Set),
+	)...)
+
 	if len(input.Filters) == 0 {
 		// Don't send an empty filters list; the EC2 API won't accept it.
 		input.Filters = nil
@@ -25,13 +25,14 @@ func DataSourceEIPs() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
-			"filter": DataSourceFiltersSchema(),
+			"filter": DataSourceFiltersSchemaForOperation("DescribeAddresses"),
 			"public_ips": {
 				Type:     schema.TypeList,
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
-			"tags": tftags.TagsSchemaComputed(),
+			"tag_filter": TagFiltersSchema(),
+			"tags":       tftags.TagsSchemaComputed(),
 		},
 	}
 }
@@ -52,6 +53,10 @@ func dataSourceEIPsRead(d *schema.ResourceData, meta interface{}) error {
 			BuildFiltersDataSource(filters.(*schema.Set))...)
 	}
 
+	if v, ok := d.GetOk("tag_filter"); ok {
+		input.Filters = append(input.Filters, BuildTagFiltersList(v.(*schema.Set))...)
+	}
+
 	if len(input.Filters) == 0 {
 		input.Filters = nil
 	}
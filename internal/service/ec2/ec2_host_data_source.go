@@ -38,7 +38,7 @@ func DataSourceHost() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
-			"filter": DataSourceFiltersSchema(),
+			"filter": DataSourceFiltersSchemaForOperation("DescribeHosts"),
 			"host_id": {
 				Type:     schema.TypeString,
 				Optional: true,
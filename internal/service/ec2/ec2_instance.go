@@ -18,6 +18,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -34,10 +35,10 @@ import (
 func ResourceInstance() *schema.Resource {
 	//lintignore:R011
 	return &schema.Resource{
-		Create: resourceInstanceCreate,
-		Read:   resourceInstanceRead,
-		Update: resourceInstanceUpdate,
-		Delete: resourceInstanceDelete,
+		CreateWithoutTimeout: resourceInstanceCreate,
+		ReadWithoutTimeout:   resourceInstanceRead,
+		UpdateWithoutTimeout: resourceInstanceUpdate,
+		DeleteWithoutTimeout: resourceInstanceDelete,
 
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -197,7 +198,6 @@ func ResourceInstance() *schema.Resource {
 							Type:             schema.TypeInt,
 							Optional:         true,
 							Computed:         true,
-							ForceNew:         true,
 							DiffSuppressFunc: iopsDiffSuppressFunc,
 						},
 						"kms_key_id": {
@@ -217,7 +217,6 @@ func ResourceInstance() *schema.Resource {
 							Type:             schema.TypeInt,
 							Optional:         true,
 							Computed:         true,
-							ForceNew:         true,
 							DiffSuppressFunc: throughputDiffSuppressFunc,
 						},
 						"volume_id": {
@@ -228,7 +227,6 @@ func ResourceInstance() *schema.Resource {
 							Type:     schema.TypeInt,
 							Optional: true,
 							Computed: true,
-							ForceNew: true,
 						},
 						"volume_type": {
 							Type:         schema.TypeString,
@@ -327,6 +325,43 @@ func ResourceInstance() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"in_place_resize": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"drain": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"auto_scaling_group_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"should_decrement_desired_capacity": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  true,
+									},
+								},
+							},
+						},
+						"verify_compatibility": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"wait_for_status_checks": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+					},
+				},
+			},
 			"instance_initiated_shutdown_behavior": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -774,6 +809,22 @@ func ResourceInstance() *schema.Resource {
 			customdiff.ComputedIf("launch_template.0.name", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
 				return diff.HasChange("launch_template.0.id")
 			}),
+			// EBS block device volume_size, iops and throughput are ForceNew unless opted in to in-place modification with `in_place_resize`.
+			func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+				if expandInstanceResizeOptions(diff.Get("in_place_resize").([]interface{})) != nil {
+					return nil
+				}
+
+				o, n := diff.GetChange("ebs_block_device")
+
+				for _, k := range instanceEBSBlockDeviceVolumeChangedKeys(o.(*schema.Set), n.(*schema.Set)) {
+					if err := diff.ForceNew(k); err != nil {
+						return err
+					}
+				}
+
+				return nil
+			},
 			customdiff.ForceNewIf("user_data", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
 				return diff.Get("user_data_replace_on_change").(bool)
 			}),
@@ -800,14 +851,14 @@ func throughputDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool
 	return strings.ToLower(v) != ec2.VolumeTypeGp3 && new == "0"
 }
 
-func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).EC2Conn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
 	instanceOpts, err := buildInstanceOpts(d, meta)
 	if err != nil {
-		return fmt.Errorf("error collecting instance settings: %w", err)
+		return diag.Errorf("error collecting instance settings: %s", err)
 	}
 
 	tagSpecifications := tagSpecificationsFromKeyValueTags(tags, ec2.ResourceTypeInstance)
@@ -852,9 +903,9 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	log.Printf("[DEBUG] Creating EC2 Instance: %s", input)
-	outputRaw, err := tfresource.RetryWhen(propagationTimeout,
+	outputRaw, err := tfresource.RetryWhenContext(ctx, propagationTimeout,
 		func() (interface{}, error) {
-			return conn.RunInstances(input)
+			return conn.RunInstancesWithContext(ctx, input)
 		},
 		func(err error) (bool, error) {
 			// IAM instance profiles can take ~10 seconds to propagate in AWS:
@@ -873,7 +924,7 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 	)
 
 	if err != nil {
-		return fmt.Errorf("creating EC2 Instance: %w", err)
+		return diag.Errorf("creating EC2 Instance: %s", err)
 	}
 
 	instance := outputRaw.(*ec2.Reservation).Instances[0]
@@ -883,7 +934,7 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 	instance, err = WaitInstanceCreated(conn, d.Id(), d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
-		return fmt.Errorf("waiting for EC2 Instance (%s) create: %w", d.Id(), err)
+		return diag.Errorf("waiting for EC2 Instance (%s) create: %s", d.Id(), err)
 	}
 
 	// Initialize the connection info
@@ -933,10 +984,10 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	// Update if we need to
-	return resourceInstanceUpdate(d, meta)
+	return resourceInstanceUpdate(ctx, d, meta)
 }
 
-func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).EC2Conn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
@@ -950,14 +1001,14 @@ func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if err != nil {
-		return fmt.Errorf("reading EC2 Instance (%s): %w", d.Id(), err)
+		return diag.Errorf("reading EC2 Instance (%s): %s", d.Id(), err)
 	}
 
 	instanceType := aws.StringValue(instance.InstanceType)
 	instanceTypeInfo, err := FindInstanceTypeByName(conn, instanceType)
 
 	if err != nil {
-		return fmt.Errorf("reading EC2 Instance Type (%s): %w", instanceType, err)
+		return diag.Errorf("reading EC2 Instance Type (%s): %s", instanceType, err)
 	}
 
 	d.Set("instance_state", instance.State.Name)
@@ -996,24 +1047,24 @@ func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if err := d.Set("enclave_options", flattenEnclaveOptions(instance.EnclaveOptions)); err != nil {
-		return fmt.Errorf("error setting enclave_options: %w", err)
+		return diag.Errorf("error setting enclave_options: %s", err)
 	}
 
 	if instance.MaintenanceOptions != nil {
 		if err := d.Set("maintenance_options", []interface{}{flattenInstanceMaintenanceOptions(instance.MaintenanceOptions)}); err != nil {
-			return fmt.Errorf("error setting maintenance_options: %w", err)
+			return diag.Errorf("error setting maintenance_options: %s", err)
 		}
 	} else {
 		d.Set("maintenance_options", nil)
 	}
 
 	if err := d.Set("metadata_options", flattenInstanceMetadataOptions(instance.MetadataOptions)); err != nil {
-		return fmt.Errorf("error setting metadata_options: %w", err)
+		return diag.Errorf("error setting metadata_options: %s", err)
 	}
 
 	if instance.PrivateDnsNameOptions != nil {
 		if err := d.Set("private_dns_name_options", []interface{}{flattenPrivateDNSNameOptionsResponse(instance.PrivateDnsNameOptions)}); err != nil {
-			return fmt.Errorf("error setting private_dns_name_options: %w", err)
+			return diag.Errorf("error setting private_dns_name_options: %s", err)
 		}
 	} else {
 		d.Set("private_dns_name_options", nil)
@@ -1032,7 +1083,7 @@ func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
 		name, err := InstanceProfileARNToName(aws.StringValue(instance.IamInstanceProfile.Arn))
 
 		if err != nil {
-			return fmt.Errorf("error setting iam_instance_profile: %w", err)
+			return diag.Errorf("error setting iam_instance_profile: %s", err)
 		}
 
 		d.Set("iam_instance_profile", name)
@@ -1044,11 +1095,11 @@ func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
 		launchTemplate, err := flattenInstanceLaunchTemplate(conn, d.Id(), d.Get("launch_template.0.version").(string))
 
 		if err != nil {
-			return fmt.Errorf("reading EC2 Instance (%s) launch template: %w", d.Id(), err)
+			return diag.Errorf("reading EC2 Instance (%s) launch template: %s", d.Id(), err)
 		}
 
 		if err := d.Set("launch_template", launchTemplate); err != nil {
-			return fmt.Errorf("error setting launch_template: %w", err)
+			return diag.Errorf("error setting launch_template: %s", err)
 		}
 	}
 
@@ -1092,7 +1143,7 @@ func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
 			networkInterfaces = append(networkInterfaces, ni)
 		}
 		if err := d.Set("network_interface", networkInterfaces); err != nil {
-			return fmt.Errorf("Error setting network_interfaces: %v", err)
+			return diag.Errorf("Error setting network_interfaces: %v", err)
 		}
 
 		// Set primary network interface details
@@ -1130,7 +1181,7 @@ func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if err := d.Set("secondary_private_ips", secondaryPrivateIPs); err != nil {
-		return fmt.Errorf("Error setting private_ips for AWS Instance (%s): %w", d.Id(), err)
+		return diag.Errorf("Error setting private_ips for AWS Instance (%s): %s", d.Id(), err)
 	}
 
 	if err := d.Set("ipv6_addresses", ipv6Addresses); err != nil {
@@ -1151,35 +1202,35 @@ func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
 
 	//lintignore:AWSR002
 	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
-		return fmt.Errorf("error setting tags: %w", err)
+		return diag.Errorf("error setting tags: %s", err)
 	}
 
 	if err := d.Set("tags_all", tags.Map()); err != nil {
-		return fmt.Errorf("error setting tags_all: %w", err)
+		return diag.Errorf("error setting tags_all: %s", err)
 	}
 
 	if _, ok := d.GetOk("volume_tags"); ok && !blockDeviceTagsDefined(d) {
 		volumeTags, err := readVolumeTags(conn, d.Id())
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		if err := d.Set("volume_tags", KeyValueTags(volumeTags).IgnoreAWS().Map()); err != nil {
-			return fmt.Errorf("error setting volume_tags: %s", err)
+			return diag.Errorf("error setting volume_tags: %s", err)
 		}
 	}
 
 	if err := readSecurityGroups(d, instance, conn); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Retrieve instance shutdown behavior
 	if err := readInstanceShutdownBehavior(d, conn); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := readBlockDevices(d, instance, conn); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if _, ok := d.GetOk("ephemeral_block_device"); !ok {
 		d.Set("ephemeral_block_device", []interface{}{})
@@ -1198,12 +1249,12 @@ func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
 
 	// Instance attributes
 	{
-		attr, err := conn.DescribeInstanceAttribute(&ec2.DescribeInstanceAttributeInput{
+		attr, err := conn.DescribeInstanceAttributeWithContext(ctx, &ec2.DescribeInstanceAttributeInput{
 			Attribute:  aws.String(ec2.InstanceAttributeNameDisableApiStop),
 			InstanceId: aws.String(d.Id()),
 		})
 		if err != nil && !verify.ErrorISOUnsupported(meta.(*conns.AWSClient).Partition, err) {
-			return fmt.Errorf("reading EC2 Instance (%s) attribute: %w ", d.Id(), err)
+			return diag.Errorf("reading EC2 Instance (%s) attribute: %s ", d.Id(), err)
 		}
 		if !verify.ErrorISOUnsupported(meta.(*conns.AWSClient).Partition, err) {
 			d.Set("disable_api_stop", attr.DisableApiStop.Value)
@@ -1213,25 +1264,25 @@ func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
 		if isSnowballEdgeInstance(d.Id()) {
 			log.Printf("[INFO] Determined deploying to Snowball Edge based off Instance ID %s. Skip setting the 'disable_api_termination' attribute.", d.Id())
 		} else {
-			output, err := conn.DescribeInstanceAttribute(&ec2.DescribeInstanceAttributeInput{
+			output, err := conn.DescribeInstanceAttributeWithContext(ctx, &ec2.DescribeInstanceAttributeInput{
 				Attribute:  aws.String(ec2.InstanceAttributeNameDisableApiTermination),
 				InstanceId: aws.String(d.Id()),
 			})
 
 			if err != nil {
-				return fmt.Errorf("reading EC2 Instance (%s) attribute: %w ", d.Id(), err)
+				return diag.Errorf("reading EC2 Instance (%s) attribute: %s ", d.Id(), err)
 			}
 
 			d.Set("disable_api_termination", output.DisableApiTermination.Value)
 		}
 	}
 	{
-		attr, err := conn.DescribeInstanceAttribute(&ec2.DescribeInstanceAttributeInput{
+		attr, err := conn.DescribeInstanceAttributeWithContext(ctx, &ec2.DescribeInstanceAttributeInput{
 			Attribute:  aws.String(ec2.InstanceAttributeNameUserData),
 			InstanceId: aws.String(d.Id()),
 		})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		if attr.UserData != nil && attr.UserData.Value != nil {
 			// Since user_data and user_data_base64 conflict with each other,
@@ -1259,12 +1310,12 @@ func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		if err != nil {
-			return fmt.Errorf("reading EC2 Instance (%s) credit specification: %w", d.Id(), err)
+			return diag.Errorf("reading EC2 Instance (%s) credit specification: %s", d.Id(), err)
 		}
 
 		if instanceCreditSpecification != nil {
 			if err := d.Set("credit_specification", []interface{}{flattenInstanceCreditSpecification(instanceCreditSpecification)}); err != nil {
-				return fmt.Errorf("error setting credit_specification: %w", err)
+				return diag.Errorf("error setting credit_specification: %s", err)
 			}
 		} else {
 			d.Set("credit_specification", nil)
@@ -1274,7 +1325,7 @@ func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
 	if d.Get("get_password_data").(bool) {
 		passwordData, err := getInstancePasswordData(aws.StringValue(instance.InstanceId), conn)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		d.Set("password_data", passwordData)
 	} else {
@@ -1284,7 +1335,7 @@ func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
 
 	if instance.CapacityReservationSpecification != nil {
 		if err := d.Set("capacity_reservation_specification", []interface{}{flattenCapacityReservationSpecificationResponse(instance.CapacityReservationSpecification)}); err != nil {
-			return fmt.Errorf("error setting capacity_reservation_specification: %w", err)
+			return diag.Errorf("error setting capacity_reservation_specification: %s", err)
 		}
 	} else {
 		d.Set("capacity_reservation_specification", nil)
@@ -1293,28 +1344,28 @@ func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).EC2Conn
 
 	if d.HasChange("tags_all") && !d.IsNewResource() {
 		o, n := d.GetChange("tags_all")
 
 		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
-			return fmt.Errorf("error updating tags: %s", err)
+			return diag.Errorf("error updating tags: %s", err)
 		}
 	}
 
 	if d.HasChange("volume_tags") && !d.IsNewResource() {
 		volumeIds, err := getInstanceVolumeIDs(conn, d.Id())
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		o, n := d.GetChange("volume_tags")
 
 		for _, volumeId := range volumeIds {
 			if err := UpdateTags(conn, volumeId, o, n); err != nil {
-				return fmt.Errorf("error updating volume_tags (%s): %s", volumeId, err)
+				return diag.Errorf("error updating volume_tags (%s): %s", volumeId, err)
 			}
 		}
 	}
@@ -1329,9 +1380,9 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 			},
 		}
 
-		resp, err := conn.DescribeIamInstanceProfileAssociations(request)
+		resp, err := conn.DescribeIamInstanceProfileAssociationsWithContext(ctx, request)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		// An Iam Instance Profile has been provided and is pending a change
@@ -1340,7 +1391,7 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 			// Does not have an Iam Instance Profile associated with it, need to associate
 			if len(resp.IamInstanceProfileAssociations) == 0 {
 				if err := associateInstanceProfile(d, conn); err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 			} else {
 				// Has an Iam Instance Profile associated with it, need to replace the association
@@ -1359,14 +1410,14 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 				if instanceState != "" {
 					if instanceState == ec2.InstanceStateNameStopped || instanceState == ec2.InstanceStateNameStopping || instanceState == ec2.InstanceStateNameShuttingDown {
 						if err := disassociateInstanceProfile(associationId, conn); err != nil {
-							return err
+							return diag.FromErr(err)
 						}
 						if err := associateInstanceProfile(d, conn); err != nil {
-							return err
+							return diag.FromErr(err)
 						}
 					} else {
-						err := resource.Retry(propagationTimeout, func() *resource.RetryError {
-							_, err := conn.ReplaceIamInstanceProfileAssociation(input)
+						err := resource.RetryContext(ctx, propagationTimeout, func() *resource.RetryError {
+							_, err := conn.ReplaceIamInstanceProfileAssociationWithContext(ctx, input)
 							if err != nil {
 								if tfawserr.ErrMessageContains(err, "InvalidParameterValue", "Invalid IAM Instance Profile") {
 									return resource.RetryableError(err)
@@ -1376,10 +1427,10 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 							return nil
 						})
 						if tfresource.TimedOut(err) {
-							_, err = conn.ReplaceIamInstanceProfileAssociation(input)
+							_, err = conn.ReplaceIamInstanceProfileAssociationWithContext(ctx, input)
 						}
 						if err != nil {
-							return fmt.Errorf("Error replacing instance profile association: %s", err)
+							return diag.Errorf("Error replacing instance profile association: %s", err)
 						}
 					}
 				}
@@ -1390,13 +1441,13 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 				// Has an Iam Instance Profile associated with it, need to remove the association
 				associationId := resp.IamInstanceProfileAssociations[0].AssociationId
 				if err := disassociateInstanceProfile(associationId, conn); err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 			}
 		}
 
 		if _, err := WaitInstanceIAMInstanceProfileUpdated(conn, d.Id(), d.Get("iam_instance_profile").(string)); err != nil {
-			return fmt.Errorf("error waiting for EC2 Instance (%s) IAM Instance Profile update: %w", d.Id(), err)
+			return diag.Errorf("error waiting for EC2 Instance (%s) IAM Instance Profile update: %s", d.Id(), err)
 		}
 	}
 
@@ -1411,14 +1462,14 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		// if a diff has occurred, it's not because it's a new instance.
 		if d.HasChange("source_dest_check") && !d.IsNewResource() || d.IsNewResource() && !sourceDestCheck {
 			log.Printf("[INFO] Modifying `source_dest_check` on Instance %s", d.Id())
-			_, err := conn.ModifyInstanceAttribute(&ec2.ModifyInstanceAttributeInput{
+			_, err := conn.ModifyInstanceAttributeWithContext(ctx, &ec2.ModifyInstanceAttributeInput{
 				InstanceId: aws.String(d.Id()),
 				SourceDestCheck: &ec2.AttributeBooleanValue{
 					Value: aws.Bool(sourceDestCheck),
 				},
 			})
 			if err != nil {
-				return create.Error(names.EC2, create.ErrActionUpdating, "Instance", d.Id(), err)
+				return diag.FromErr(create.Error(names.EC2, create.ErrActionUpdating, "Instance", d.Id(), err))
 			}
 		}
 	}
@@ -1427,7 +1478,7 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		instance, err := FindInstanceByID(conn, d.Id())
 
 		if err != nil {
-			return fmt.Errorf("reading EC2 Instance (%s): %w", d.Id(), err)
+			return diag.Errorf("reading EC2 Instance (%s): %s", d.Id(), err)
 		}
 
 		var primaryInterface *ec2.InstanceNetworkInterface
@@ -1439,7 +1490,7 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 
 		if d.HasChange("secondary_private_ips") {
 			if primaryInterface == nil || primaryInterface.NetworkInterfaceId == nil {
-				return fmt.Errorf("Failed to update secondary_private_ips on %q, which does not contain a primary network interface",
+				return diag.Errorf("Failed to update secondary_private_ips on %q, which does not contain a primary network interface",
 					d.Id())
 			}
 			o, n := d.GetChange("secondary_private_ips")
@@ -1461,9 +1512,9 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 					PrivateIpAddresses: flex.ExpandStringSet(unassignIps),
 				}
 				log.Printf("[INFO] Unassigning secondary_private_ips on Instance %q", d.Id())
-				_, err := conn.UnassignPrivateIpAddresses(input)
+				_, err := conn.UnassignPrivateIpAddressesWithContext(ctx, input)
 				if err != nil {
-					return fmt.Errorf("Failure to unassign Secondary Private IPs: %w", err)
+					return diag.Errorf("Failure to unassign Secondary Private IPs: %s", err)
 				}
 			}
 
@@ -1475,16 +1526,16 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 					PrivateIpAddresses: flex.ExpandStringSet(assignIps),
 				}
 				log.Printf("[INFO] Assigning secondary_private_ips on Instance %q", d.Id())
-				_, err := conn.AssignPrivateIpAddresses(input)
+				_, err := conn.AssignPrivateIpAddressesWithContext(ctx, input)
 				if err != nil {
-					return fmt.Errorf("Failure to assign Secondary Private IPs: %w", err)
+					return diag.Errorf("Failure to assign Secondary Private IPs: %s", err)
 				}
 			}
 		}
 
 		if d.HasChange("vpc_security_group_ids") {
 			if primaryInterface.NetworkInterfaceId == nil {
-				return fmt.Errorf("Failed to update vpc_security_group_ids on %q, which does not contain a primary network interface",
+				return diag.Errorf("Failed to update vpc_security_group_ids on %q, which does not contain a primary network interface",
 					d.Id())
 			}
 			var groups []*string
@@ -1495,7 +1546,7 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 			}
 
 			if len(groups) < 1 {
-				return fmt.Errorf("VPC-based instances require at least one security group to be attached.")
+				return diag.Errorf("VPC-based instances require at least one security group to be attached.")
 			}
 			// If a user has multiple network interface attachments on the target EC2 instance, simply modifying the
 			// instance attributes via a `ModifyInstanceAttributes()` request would fail with the following error message:
@@ -1503,11 +1554,11 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 			// Thus, we need to actually modify the primary network interface for the new security groups, as the primary
 			// network interface is where we modify/create security group assignments during Create.
 			log.Printf("[INFO] Modifying `vpc_security_group_ids` on Instance %q", d.Id())
-			if _, err := conn.ModifyNetworkInterfaceAttribute(&ec2.ModifyNetworkInterfaceAttributeInput{
+			if _, err := conn.ModifyNetworkInterfaceAttributeWithContext(ctx, &ec2.ModifyNetworkInterfaceAttributeInput{
 				NetworkInterfaceId: primaryInterface.NetworkInterfaceId,
 				Groups:             groups,
 			}); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -1518,17 +1569,25 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		// Only one attribute can be modified at a time, else we get
 		// "InvalidParameterCombination: Fields for multiple attribute types specified"
 		if d.HasChange("instance_type") {
-			log.Printf("[INFO] Modifying instance type %s", d.Id())
+			if opts := expandInstanceResizeOptions(d.Get("in_place_resize").([]interface{})); opts != nil {
+				autoScalingConn := meta.(*conns.AWSClient).AutoScalingConn
 
-			input := &ec2.ModifyInstanceAttributeInput{
-				InstanceId: aws.String(d.Id()),
-				InstanceType: &ec2.AttributeValue{
-					Value: aws.String(d.Get("instance_type").(string)),
-				},
-			}
+				if err := resizeInstance(ctx, conn, autoScalingConn, d.Id(), d.Get("instance_type").(string), opts, d.Timeout(schema.TimeoutUpdate)); err != nil {
+					return diag.Errorf("updating EC2 Instance (%s) type: %s", d.Id(), err)
+				}
+			} else {
+				log.Printf("[INFO] Modifying instance type %s", d.Id())
 
-			if err := modifyInstanceAttributeWithStopStart(conn, input); err != nil {
-				return fmt.Errorf("updating EC2 Instance (%s) type: %w", d.Id(), err)
+				input := &ec2.ModifyInstanceAttributeInput{
+					InstanceId: aws.String(d.Id()),
+					InstanceType: &ec2.AttributeValue{
+						Value: aws.String(d.Get("instance_type").(string)),
+					},
+				}
+
+				if err := modifyInstanceAttributeWithStopStart(conn, input); err != nil {
+					return diag.Errorf("updating EC2 Instance (%s) type: %s", d.Id(), err)
+				}
 			}
 		}
 
@@ -1555,7 +1614,7 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 			}
 
 			if err := modifyInstanceAttributeWithStopStart(conn, input); err != nil {
-				return fmt.Errorf("updating EC2 Instance (%s) user data: %w", d.Id(), err)
+				return diag.Errorf("updating EC2 Instance (%s) user data: %s", d.Id(), err)
 			}
 		}
 
@@ -1578,33 +1637,33 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 			}
 
 			if err := modifyInstanceAttributeWithStopStart(conn, input); err != nil {
-				return fmt.Errorf("updating EC2 Instance (%s) user data base64: %w", d.Id(), err)
+				return diag.Errorf("updating EC2 Instance (%s) user data base64: %s", d.Id(), err)
 			}
 		}
 	}
 
 	if d.HasChange("disable_api_stop") && !d.IsNewResource() {
 		if err := disableInstanceAPIStop(conn, d.Id(), d.Get("disable_api_stop").(bool)); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	if d.HasChange("disable_api_termination") && !d.IsNewResource() {
 		if err := disableInstanceAPITermination(conn, d.Id(), d.Get("disable_api_termination").(bool)); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	if d.HasChange("instance_initiated_shutdown_behavior") {
 		log.Printf("[INFO] Modifying instance %s", d.Id())
-		_, err := conn.ModifyInstanceAttribute(&ec2.ModifyInstanceAttributeInput{
+		_, err := conn.ModifyInstanceAttributeWithContext(ctx, &ec2.ModifyInstanceAttributeInput{
 			InstanceId: aws.String(d.Id()),
 			InstanceInitiatedShutdownBehavior: &ec2.AttributeValue{
 				Value: aws.String(d.Get("instance_initiated_shutdown_behavior").(string)),
 			},
 		})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -1612,17 +1671,17 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		autoRecovery := d.Get("maintenance_options.0.auto_recovery").(string)
 
 		log.Printf("[INFO] Modifying instance automatic recovery settings %s", d.Id())
-		_, err := conn.ModifyInstanceMaintenanceOptions(&ec2.ModifyInstanceMaintenanceOptionsInput{
+		_, err := conn.ModifyInstanceMaintenanceOptionsWithContext(ctx, &ec2.ModifyInstanceMaintenanceOptionsInput{
 			AutoRecovery: aws.String(autoRecovery),
 			InstanceId:   aws.String(d.Id()),
 		})
 
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		if _, err := WaitInstanceMaintenanceOptionsAutoRecoveryUpdated(conn, d.Id(), autoRecovery, d.Timeout(schema.TimeoutUpdate)); err != nil {
-			return fmt.Errorf("waiting for EC2 Instance (%s) maintenance options update: %w", d.Id(), err)
+			return diag.Errorf("waiting for EC2 Instance (%s) maintenance options update: %s", d.Id(), err)
 		}
 	}
 
@@ -1630,17 +1689,17 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		var mErr error
 		if d.Get("monitoring").(bool) {
 			log.Printf("[DEBUG] Enabling monitoring for Instance (%s)", d.Id())
-			_, mErr = conn.MonitorInstances(&ec2.MonitorInstancesInput{
+			_, mErr = conn.MonitorInstancesWithContext(ctx, &ec2.MonitorInstancesInput{
 				InstanceIds: []*string{aws.String(d.Id())},
 			})
 		} else {
 			log.Printf("[DEBUG] Disabling monitoring for Instance (%s)", d.Id())
-			_, mErr = conn.UnmonitorInstances(&ec2.UnmonitorInstancesInput{
+			_, mErr = conn.UnmonitorInstancesWithContext(ctx, &ec2.UnmonitorInstancesInput{
 				InstanceIds: []*string{aws.String(d.Id())},
 			})
 		}
 		if mErr != nil {
-			return fmt.Errorf("Error updating Instance monitoring: %s", mErr)
+			return diag.Errorf("Error updating Instance monitoring: %s", mErr)
 		}
 	}
 
@@ -1653,10 +1712,10 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 			}
 
 			log.Printf("[DEBUG] Modifying EC2 Instance credit specification: %s", input)
-			_, err := conn.ModifyInstanceCreditSpecification(input)
+			_, err := conn.ModifyInstanceCreditSpecificationWithContext(ctx, input)
 
 			if err != nil {
-				return fmt.Errorf("updating EC2 Instance (%s) credit specification: %w", d.Id(), err)
+				return diag.Errorf("updating EC2 Instance (%s) credit specification: %s", d.Id(), err)
 			}
 		}
 	}
@@ -1676,19 +1735,19 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 					input.InstanceMetadataTags = aws.String(tfMap["instance_metadata_tags"].(string))
 				}
 
-				_, err := conn.ModifyInstanceMetadataOptions(input)
+				_, err := conn.ModifyInstanceMetadataOptionsWithContext(ctx, input)
 				if tfawserr.ErrMessageContains(err, errCodeUnsupportedOperation, "InstanceMetadataTags") {
 					log.Printf("[WARN] updating EC2 Instance (%s) metadata options: %s. Retrying without instance metadata tags.", d.Id(), err)
 					input.InstanceMetadataTags = nil
 
-					_, err = conn.ModifyInstanceMetadataOptions(input)
+					_, err = conn.ModifyInstanceMetadataOptionsWithContext(ctx, input)
 				}
 				if err != nil {
-					return fmt.Errorf("updating EC2 Instance (%s) metadata options: %w", d.Id(), err)
+					return diag.Errorf("updating EC2 Instance (%s) metadata options: %s", d.Id(), err)
 				}
 
 				if _, err := WaitInstanceMetadataOptionsApplied(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
-					return fmt.Errorf("waiting for EC2 Instance (%s) metadata options update: %w", d.Id(), err)
+					return diag.Errorf("waiting for EC2 Instance (%s) metadata options update: %s", d.Id(), err)
 				}
 			}
 		}
@@ -1723,7 +1782,7 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 						// Volume defaults to gp2
 						t = ec2.VolumeTypeGp2
 					}
-					return fmt.Errorf("error updating instance: iops attribute not supported for type %s", t)
+					return diag.Errorf("error updating instance: iops attribute not supported for type %s", t)
 				}
 				modifyVolume = true
 				input.Iops = aws.Int64(int64(v))
@@ -1733,7 +1792,7 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 			if v, ok := d.Get("root_block_device.0.throughput").(int); ok && v != 0 {
 				// Enforce throughput usage with a valid volume type
 				if t, ok := d.Get("root_block_device.0.volume_type").(string); ok && t != ec2.VolumeTypeGp3 {
-					return fmt.Errorf("error updating instance: throughput attribute not supported for type %s", t)
+					return diag.Errorf("error updating instance: throughput attribute not supported for type %s", t)
 				}
 				modifyVolume = true
 				input.Throughput = aws.Int64(int64(v))
@@ -1741,14 +1800,14 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 		if modifyVolume {
 			log.Printf("[DEBUG] Modifying volume: %s", input)
-			_, err := conn.ModifyVolume(input)
+			_, err := conn.ModifyVolumeWithContext(ctx, input)
 
 			if err != nil {
-				return fmt.Errorf("updating EC2 Instance (%s) volume (%s): %w", d.Id(), volumeID, err)
+				return diag.Errorf("updating EC2 Instance (%s) volume (%s): %s", d.Id(), volumeID, err)
 			}
 
-			if _, err := WaitVolumeModificationComplete(conn, volumeID, d.Timeout(schema.TimeoutUpdate)); err != nil {
-				return fmt.Errorf("waiting for EC2 Instance (%s) volume (%s) update: %w", d.Id(), volumeID, err)
+			if _, err := WaitVolumeModificationComplete(ctx, conn, volumeID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diag.Errorf("waiting for EC2 Instance (%s) volume (%s) update: %s", d.Id(), volumeID, err)
 			}
 		}
 
@@ -1768,14 +1827,14 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 				}
 
 				log.Printf("[DEBUG] Modifying EC2 Instance attribute: %s", input)
-				_, err := conn.ModifyInstanceAttribute(input)
+				_, err := conn.ModifyInstanceAttributeWithContext(ctx, input)
 
 				if err != nil {
-					return fmt.Errorf("updating EC2 Instance (%s) root block device (%s) DeleteOnTermination attribute: %w", d.Id(), deviceName, err)
+					return diag.Errorf("updating EC2 Instance (%s) root block device (%s) DeleteOnTermination attribute: %s", d.Id(), deviceName, err)
 				}
 
 				if _, err := WaitInstanceRootBlockDeviceDeleteOnTerminationUpdated(conn, d.Id(), v, d.Timeout(schema.TimeoutUpdate)); err != nil {
-					return fmt.Errorf("waiting for EC2 Instance (%s) root block device DeleteOnTermination update: %w", d.Id(), err)
+					return diag.Errorf("waiting for EC2 Instance (%s) root block device DeleteOnTermination update: %s", d.Id(), err)
 				}
 			}
 		}
@@ -1784,7 +1843,17 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 			o, n := d.GetChange("root_block_device.0.tags")
 
 			if err := UpdateTags(conn, volumeID, o, n); err != nil {
-				return fmt.Errorf("error updating tags for volume (%s): %s", volumeID, err)
+				return diag.Errorf("error updating tags for volume (%s): %s", volumeID, err)
+			}
+		}
+	}
+
+	if d.HasChange("ebs_block_device") && !d.IsNewResource() {
+		o, n := d.GetChange("ebs_block_device")
+
+		if inputs := instanceEBSBlockDeviceVolumeModifications(o.(*schema.Set), n.(*schema.Set)); len(inputs) > 0 {
+			if err := modifyInstanceEBSBlockDeviceVolumes(ctx, conn, d.Id(), inputs, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -1800,14 +1869,14 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 				}
 
 				log.Printf("[DEBUG] Modifying EC2 Instance capacity reservation attributes: %s", input)
-				_, err := conn.ModifyInstanceCapacityReservationAttributes(input)
+				_, err := conn.ModifyInstanceCapacityReservationAttributesWithContext(ctx, input)
 
 				if err != nil {
-					return fmt.Errorf("updating EC2 Instance (%s) capacity reservation attributes: %w", d.Id(), err)
+					return diag.Errorf("updating EC2 Instance (%s) capacity reservation attributes: %s", d.Id(), err)
 				}
 
 				if _, err := WaitInstanceCapacityReservationSpecificationUpdated(conn, d.Id(), v); err != nil {
-					return fmt.Errorf("waiting for EC2 Instance (%s) capacity reservation attributes update: %w", d.Id(), err)
+					return diag.Errorf("waiting for EC2 Instance (%s) capacity reservation attributes update: %s", d.Id(), err)
 				}
 			}
 		}
@@ -1816,10 +1885,10 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 	// TODO(mitchellh): wait for the attributes we modified to
 	// persist the change...
 
-	return resourceInstanceRead(d, meta)
+	return resourceInstanceRead(ctx, d, meta)
 }
 
-func resourceInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).EC2Conn
 
 	if err := disableInstanceAPITermination(conn, d.Id(), false); err != nil {
@@ -1833,7 +1902,7 @@ func resourceInstanceDelete(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if err := terminateInstance(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
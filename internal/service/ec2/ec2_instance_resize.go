@@ -0,0 +1,386 @@
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"golang.org/x/exp/slices"
+)
+
+// instanceResizeOptions configures an opt-in, orchestrated change of an instance's type.
+type instanceResizeOptions struct {
+	autoScalingGroupName           string
+	shouldDecrementDesiredCapacity bool
+	verifyCompatibility            bool
+	waitForStatusChecks            bool
+}
+
+// expandInstanceResizeOptions returns the options configured by an `in_place_resize` block, or nil if there is no such block.
+func expandInstanceResizeOptions(tfList []interface{}) *instanceResizeOptions {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	// An empty block opts in with the default options.
+	opts := &instanceResizeOptions{
+		shouldDecrementDesiredCapacity: true,
+		verifyCompatibility:            true,
+		waitForStatusChecks:            true,
+	}
+
+	tfMap, ok := tfList[0].(map[string]interface{})
+
+	if !ok {
+		return opts
+	}
+
+	if v, ok := tfMap["drain"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		opts.autoScalingGroupName = tfMap["auto_scaling_group_name"].(string)
+		opts.shouldDecrementDesiredCapacity = tfMap["should_decrement_desired_capacity"].(bool)
+	}
+
+	if v, ok := tfMap["verify_compatibility"].(bool); ok {
+		opts.verifyCompatibility = v
+	}
+
+	if v, ok := tfMap["wait_for_status_checks"].(bool); ok {
+		opts.waitForStatusChecks = v
+	}
+
+	return opts
+}
+
+// resizeInstance changes an instance's type, optionally verifying that the new type is compatible with the instance,
+// draining the instance by moving it to Standby in its Auto Scaling group and waiting for its status checks to pass.
+// If the resize fails after the instance has been moved to Standby, the instance is still returned to service,
+// so that it isn't left out of its Auto Scaling group.
+func resizeInstance(ctx context.Context, conn *ec2.EC2, autoScalingConn *autoscaling.AutoScaling, id, instanceType string, opts *instanceResizeOptions, timeout time.Duration) (err error) {
+	if opts.verifyCompatibility {
+		if err := verifyInstanceTypeCompatibility(conn, id, instanceType); err != nil {
+			return err
+		}
+	}
+
+	// Whether the instance must be returned to service if the resize fails.
+	standby := false
+
+	defer func() {
+		if err == nil || !standby {
+			return
+		}
+
+		log.Printf("[WARN] EC2 Instance (%s) resize failed, returning it to service: %s", id, err)
+
+		if exitErr := exitAutoScalingInstanceStandby(ctx, autoScalingConn, id, opts.autoScalingGroupName, timeout); exitErr != nil {
+			err = multierror.Append(err, exitErr)
+		}
+	}()
+
+	if opts.autoScalingGroupName != "" {
+		log.Printf("[INFO] Moving EC2 Instance (%s) to Standby in Auto Scaling Group (%s)", id, opts.autoScalingGroupName)
+		_, err := autoScalingConn.EnterStandbyWithContext(ctx, &autoscaling.EnterStandbyInput{
+			AutoScalingGroupName:           aws.String(opts.autoScalingGroupName),
+			InstanceIds:                    aws.StringSlice([]string{id}),
+			ShouldDecrementDesiredCapacity: aws.Bool(opts.shouldDecrementDesiredCapacity),
+		})
+
+		if err != nil {
+			return fmt.Errorf("moving EC2 Instance (%s) to Standby in Auto Scaling Group (%s): %w", id, opts.autoScalingGroupName, err)
+		}
+
+		standby = true
+
+		if _, err := waitAutoScalingInstanceInStandby(ctx, autoScalingConn, id, timeout); err != nil {
+			return fmt.Errorf("waiting for EC2 Instance (%s) to enter Standby: %w", id, err)
+		}
+	}
+
+	log.Printf("[INFO] Modifying instance type %s", id)
+	input := &ec2.ModifyInstanceAttributeInput{
+		InstanceId: aws.String(id),
+		InstanceType: &ec2.AttributeValue{
+			Value: aws.String(instanceType),
+		},
+	}
+
+	if err := modifyInstanceAttributeWithStopStart(conn, input); err != nil {
+		return err
+	}
+
+	if opts.waitForStatusChecks {
+		if _, err := WaitInstanceStatusChecksPassed(ctx, conn, id, timeout); err != nil {
+			return fmt.Errorf("waiting for EC2 Instance (%s) status checks: %w", id, err)
+		}
+	}
+
+	if standby {
+		// Returning to service is attempted only once.
+		standby = false
+
+		if err := exitAutoScalingInstanceStandby(ctx, autoScalingConn, id, opts.autoScalingGroupName, timeout); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// exitAutoScalingInstanceStandby returns an instance in Standby to service in its Auto Scaling group.
+func exitAutoScalingInstanceStandby(ctx context.Context, conn *autoscaling.AutoScaling, id, autoScalingGroupName string, timeout time.Duration) error {
+	log.Printf("[INFO] Returning EC2 Instance (%s) to service in Auto Scaling Group (%s)", id, autoScalingGroupName)
+	_, err := conn.ExitStandbyWithContext(ctx, &autoscaling.ExitStandbyInput{
+		AutoScalingGroupName: aws.String(autoScalingGroupName),
+		InstanceIds:          aws.StringSlice([]string{id}),
+	})
+
+	if err != nil {
+		return fmt.Errorf("moving EC2 Instance (%s) out of Standby in Auto Scaling Group (%s): %w", id, autoScalingGroupName, err)
+	}
+
+	if _, err := waitAutoScalingInstanceInService(ctx, conn, id, timeout); err != nil {
+		return fmt.Errorf("waiting for EC2 Instance (%s) to return to service: %w", id, err)
+	}
+
+	return nil
+}
+
+// verifyInstanceTypeCompatibility returns an error if an instance cannot run as the specified instance type.
+func verifyInstanceTypeCompatibility(conn *ec2.EC2, id, instanceType string) error {
+	instance, err := FindInstanceByID(conn, id)
+
+	if err != nil {
+		return fmt.Errorf("reading EC2 Instance (%s): %w", id, err)
+	}
+
+	current, err := FindInstanceTypeByName(conn, aws.StringValue(instance.InstanceType))
+
+	if err != nil {
+		return fmt.Errorf("reading EC2 Instance Type (%s): %w", aws.StringValue(instance.InstanceType), err)
+	}
+
+	target, err := FindInstanceTypeByName(conn, instanceType)
+
+	if err != nil {
+		return fmt.Errorf("reading EC2 Instance Type (%s): %w", instanceType, err)
+	}
+
+	if err := instanceTypeCompatible(instance, current, target); err != nil {
+		return fmt.Errorf("EC2 Instance (%s) cannot be resized to %s: %w", id, instanceType, err)
+	}
+
+	return nil
+}
+
+// instanceTypeCompatible returns an error if the instance, currently of type `current`, cannot run as type `target`.
+func instanceTypeCompatible(instance *ec2.Instance, current, target *ec2.InstanceTypeInfo) error {
+	var errs *multierror.Error
+	targetType := aws.StringValue(target.InstanceType)
+
+	if v := aws.StringValue(instance.Architecture); v != "" && target.ProcessorInfo != nil {
+		if !slices.Contains(aws.StringValueSlice(target.ProcessorInfo.SupportedArchitectures), v) {
+			errs = multierror.Append(errs, fmt.Errorf("instance type %s does not support architecture %s", targetType, v))
+		}
+	}
+
+	if v := aws.StringValue(instance.BootMode); v != "" && len(target.SupportedBootModes) > 0 {
+		if !slices.Contains(aws.StringValueSlice(target.SupportedBootModes), v) {
+			errs = multierror.Append(errs, fmt.Errorf("instance type %s does not support boot mode %s", targetType, v))
+		}
+	}
+
+	if target.NetworkInfo != nil && aws.StringValue(target.NetworkInfo.EnaSupport) == ec2.EnaSupportRequired && !aws.BoolValue(instance.EnaSupport) {
+		errs = multierror.Append(errs, fmt.Errorf("instance type %s requires ENA, which is not enabled on the instance", targetType))
+	}
+
+	// EBS volumes are exposed as NVMe block devices on Nitro instance types,
+	// so moving to or from one changes the device names seen by the operating system.
+	if current.EbsInfo != nil && target.EbsInfo != nil {
+		currentNVMe := aws.StringValue(current.EbsInfo.NvmeSupport)
+		targetNVMe := aws.StringValue(target.EbsInfo.NvmeSupport)
+
+		switch {
+		case currentNVMe == ec2.EbsNvmeSupportUnsupported && targetNVMe == ec2.EbsNvmeSupportRequired:
+			errs = multierror.Append(errs, fmt.Errorf("instance type %s requires NVMe EBS volumes, which instance type %s does not support", targetType, aws.StringValue(current.InstanceType)))
+		case currentNVMe == ec2.EbsNvmeSupportRequired && targetNVMe == ec2.EbsNvmeSupportUnsupported:
+			errs = multierror.Append(errs, fmt.Errorf("instance type %s does not support NVMe EBS volumes, which instance type %s requires", targetType, aws.StringValue(current.InstanceType)))
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// instanceEBSBlockDeviceVolumeModifications returns the volume modifications needed to move each existing EBS block device
+// from its old to its new size, IOPS and throughput. Block devices that are only being added or removed are ignored.
+func instanceEBSBlockDeviceVolumeModifications(o, n *schema.Set) []*ec2.ModifyVolumeInput {
+	old := make(map[string]map[string]interface{}, o.Len())
+
+	for _, tfMapRaw := range o.List() {
+		tfMap := tfMapRaw.(map[string]interface{})
+		old[tfMap["device_name"].(string)] = tfMap
+	}
+
+	var inputs []*ec2.ModifyVolumeInput
+
+	for _, tfMapRaw := range n.List() {
+		tfMap := tfMapRaw.(map[string]interface{})
+		oldMap, ok := old[tfMap["device_name"].(string)]
+
+		if !ok {
+			continue
+		}
+
+		volumeID, ok := oldMap["volume_id"].(string)
+
+		if !ok || volumeID == "" {
+			continue
+		}
+
+		input := &ec2.ModifyVolumeInput{
+			VolumeId: aws.String(volumeID),
+		}
+		modifyVolume := false
+
+		if v, ok := tfMap["volume_size"].(int); ok && v != 0 && v != oldMap["volume_size"].(int) {
+			modifyVolume = true
+			input.Size = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["iops"].(int); ok && v != 0 && v != oldMap["iops"].(int) {
+			modifyVolume = true
+			input.Iops = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["throughput"].(int); ok && v != 0 && v != oldMap["throughput"].(int) {
+			modifyVolume = true
+			input.Throughput = aws.Int64(int64(v))
+		}
+
+		if modifyVolume {
+			inputs = append(inputs, input)
+		}
+	}
+
+	return inputs
+}
+
+// instanceEBSBlockDeviceVolumeChangedKeys returns the keys of the size, IOPS and throughput attributes
+// of existing EBS block devices that change from their old to their new values.
+func instanceEBSBlockDeviceVolumeChangedKeys(o, n *schema.Set) []string {
+	old := make(map[string]map[string]interface{}, o.Len())
+
+	for _, tfMapRaw := range o.List() {
+		tfMap := tfMapRaw.(map[string]interface{})
+		old[tfMap["device_name"].(string)] = tfMap
+	}
+
+	var keys []string
+
+	for _, tfMapRaw := range n.List() {
+		tfMap := tfMapRaw.(map[string]interface{})
+		oldMap, ok := old[tfMap["device_name"].(string)]
+
+		if !ok {
+			continue
+		}
+
+		// Set element keys use the element's non-negative hash code.
+		code := n.F(tfMap)
+		if code < 0 {
+			code = -code
+		}
+
+		for _, k := range []string{"iops", "throughput", "volume_size"} {
+			if v, ok := tfMap[k].(int); ok && v != 0 && v != oldMap[k].(int) {
+				keys = append(keys, fmt.Sprintf("ebs_block_device.%d.%s", code, k))
+			}
+		}
+	}
+
+	return keys
+}
+
+// modifyInstanceEBSBlockDeviceVolumes modifies EBS block device volumes in place, waiting for each to reach the optimizing state.
+func modifyInstanceEBSBlockDeviceVolumes(ctx context.Context, conn *ec2.EC2, id string, inputs []*ec2.ModifyVolumeInput, timeout time.Duration) error {
+	for i, input := range inputs {
+		volumeID := aws.StringValue(input.VolumeId)
+
+		log.Printf("[INFO] Modifying EC2 Instance (%s) volume (%s), %d of %d", id, volumeID, i+1, len(inputs))
+		if _, err := conn.ModifyVolumeWithContext(ctx, input); err != nil {
+			return fmt.Errorf("updating EC2 Instance (%s) volume (%s): %w", id, volumeID, err)
+		}
+
+		if _, err := WaitVolumeModificationComplete(ctx, conn, volumeID, timeout); err != nil {
+			return fmt.Errorf("waiting for EC2 Instance (%s) volume (%s) update: %w", id, volumeID, err)
+		}
+	}
+
+	return nil
+}
+
+func findAutoScalingInstanceByID(ctx context.Context, conn *autoscaling.AutoScaling, id string) (*autoscaling.InstanceDetails, error) {
+	input := &autoscaling.DescribeAutoScalingInstancesInput{
+		InstanceIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := conn.DescribeAutoScalingInstancesWithContext(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.AutoScalingInstances) == 0 || output.AutoScalingInstances[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.AutoScalingInstances[0], nil
+}
+
+func statusAutoScalingInstanceLifecycleState(conn *autoscaling.AutoScaling, id string) func(context.Context) (*autoscaling.InstanceDetails, string, error) {
+	return func(ctx context.Context) (*autoscaling.InstanceDetails, string, error) {
+		output, err := findAutoScalingInstanceByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.LifecycleState), nil
+	}
+}
+
+func waitAutoScalingInstanceInStandby(ctx context.Context, conn *autoscaling.AutoScaling, id string, timeout time.Duration) (*autoscaling.InstanceDetails, error) {
+	return tfresource.Wait(ctx, statusAutoScalingInstanceLifecycleState(conn, id), tfresource.WaiterOpts{
+		Description: fmt.Sprintf("EC2 Instance (%s) entering Standby", id),
+		Pending:     []string{autoscaling.LifecycleStateEnteringStandby, autoscaling.LifecycleStateInService},
+		Target:      []string{autoscaling.LifecycleStateStandby},
+		Timeout:     timeout,
+	})
+}
+
+func waitAutoScalingInstanceInService(ctx context.Context, conn *autoscaling.AutoScaling, id string, timeout time.Duration) (*autoscaling.InstanceDetails, error) {
+	return tfresource.Wait(ctx, statusAutoScalingInstanceLifecycleState(conn, id), tfresource.WaiterOpts{
+		Description: fmt.Sprintf("EC2 Instance (%s) returning to service", id),
+		// Returning to service runs any launch lifecycle hooks.
+		Pending: []string{
+			autoscaling.LifecycleStatePending,
+			autoscaling.LifecycleStatePendingProceed,
+			autoscaling.LifecycleStatePendingWait,
+			autoscaling.LifecycleStateStandby,
+		},
+		Target:  []string{autoscaling.LifecycleStateInService},
+		Timeout: timeout,
+	})
+}
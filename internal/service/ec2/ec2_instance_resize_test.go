@@ -0,0 +1,199 @@
+package ec2
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestInstanceTypeCompatible(t *testing.T) {
+	instanceTypeInfo := func(name, arch, ena, nvme string) *ec2.InstanceTypeInfo {
+		return &ec2.InstanceTypeInfo{
+			EbsInfo: &ec2.EbsInfo{
+				NvmeSupport: aws.String(nvme),
+			},
+			InstanceType: aws.String(name),
+			NetworkInfo: &ec2.NetworkInfo{
+				EnaSupport: aws.String(ena),
+			},
+			ProcessorInfo: &ec2.ProcessorInfo{
+				SupportedArchitectures: aws.StringSlice([]string{arch}),
+			},
+			SupportedBootModes: aws.StringSlice([]string{ec2.BootModeTypeLegacyBios, ec2.BootModeTypeUefi}),
+		}
+	}
+
+	m5Large := instanceTypeInfo("m5.large", ec2.ArchitectureTypeX8664, ec2.EnaSupportRequired, ec2.EbsNvmeSupportRequired)
+	m6gLarge := instanceTypeInfo("m6g.large", ec2.ArchitectureTypeArm64, ec2.EnaSupportRequired, ec2.EbsNvmeSupportRequired)
+	m5XLarge := instanceTypeInfo("m5.xlarge", ec2.ArchitectureTypeX8664, ec2.EnaSupportRequired, ec2.EbsNvmeSupportRequired)
+	t2Large := instanceTypeInfo("t2.large", ec2.ArchitectureTypeX8664, ec2.EnaSupportUnsupported, ec2.EbsNvmeSupportUnsupported)
+
+	testCases := []struct {
+		TestName    string
+		Instance    *ec2.Instance
+		Current     *ec2.InstanceTypeInfo
+		Target      *ec2.InstanceTypeInfo
+		ExpectError bool
+	}{
+		{
+			TestName: "same family",
+			Instance: &ec2.Instance{
+				Architecture: aws.String(ec2.ArchitectureValuesX8664),
+				EnaSupport:   aws.Bool(true),
+			},
+			Current: m5Large,
+			Target:  m5XLarge,
+		},
+		{
+			TestName: "architecture",
+			Instance: &ec2.Instance{
+				Architecture: aws.String(ec2.ArchitectureValuesX8664),
+				EnaSupport:   aws.Bool(true),
+			},
+			Current:     m5Large,
+			Target:      m6gLarge,
+			ExpectError: true,
+		},
+		{
+			TestName: "ENA not enabled",
+			Instance: &ec2.Instance{
+				Architecture: aws.String(ec2.ArchitectureValuesX8664),
+				EnaSupport:   aws.Bool(false),
+			},
+			Current:     t2Large,
+			Target:      m5Large,
+			ExpectError: true,
+		},
+		{
+			TestName: "NVMe not supported",
+			Instance: &ec2.Instance{
+				Architecture: aws.String(ec2.ArchitectureValuesX8664),
+				EnaSupport:   aws.Bool(true),
+			},
+			Current:     m5Large,
+			Target:      t2Large,
+			ExpectError: true,
+		},
+		{
+			TestName: "boot mode",
+			Instance: &ec2.Instance{
+				Architecture: aws.String(ec2.ArchitectureValuesX8664),
+				BootMode:     aws.String(ec2.BootModeValuesUefi),
+				EnaSupport:   aws.Bool(true),
+			},
+			Current: m5Large,
+			Target: &ec2.InstanceTypeInfo{
+				InstanceType:       aws.String("m5.2xlarge"),
+				SupportedBootModes: aws.StringSlice([]string{ec2.BootModeTypeLegacyBios}),
+			},
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			err := instanceTypeCompatible(testCase.Instance, testCase.Current, testCase.Target)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatal("expected error, got none")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestInstanceEBSBlockDeviceVolumeModifications(t *testing.T) {
+	ebsBlockDevice := func(deviceName, volumeID string, volumeSize, iops, throughput int) map[string]interface{} {
+		return map[string]interface{}{
+			"device_name": deviceName,
+			"iops":        iops,
+			"throughput":  throughput,
+			"volume_id":   volumeID,
+			"volume_size": volumeSize,
+		}
+	}
+	hash := func(v interface{}) int {
+		return schema.HashString(v.(map[string]interface{})["device_name"])
+	}
+
+	o := schema.NewSet(hash, []interface{}{
+		ebsBlockDevice("/dev/sdb", "vol-1", 10, 3000, 125),
+		ebsBlockDevice("/dev/sdc", "vol-2", 10, 3000, 125),
+		ebsBlockDevice("/dev/sdd", "vol-3", 10, 3000, 125),
+	})
+	n := schema.NewSet(hash, []interface{}{
+		ebsBlockDevice("/dev/sdb", "", 20, 3000, 125),
+		ebsBlockDevice("/dev/sdc", "", 10, 4000, 250),
+		ebsBlockDevice("/dev/sdd", "", 10, 0, 0),
+		ebsBlockDevice("/dev/sde", "", 30, 3000, 125),
+	})
+
+	got := make(map[string]*ec2.ModifyVolumeInput)
+
+	for _, v := range instanceEBSBlockDeviceVolumeModifications(o, n) {
+		got[aws.StringValue(v.VolumeId)] = v
+	}
+
+	if len(got) != 2 {
+		t.Fatalf("expected 2 volume modifications, got %d", len(got))
+	}
+
+	if v := got["vol-1"]; v == nil || aws.Int64Value(v.Size) != 20 || v.Iops != nil || v.Throughput != nil {
+		t.Errorf("unexpected vol-1 modification: %s", v)
+	}
+
+	if v := got["vol-2"]; v == nil || v.Size != nil || aws.Int64Value(v.Iops) != 4000 || aws.Int64Value(v.Throughput) != 250 {
+		t.Errorf("unexpected vol-2 modification: %s", v)
+	}
+}
+
+func TestInstanceEBSBlockDeviceVolumeChangedKeys(t *testing.T) {
+	ebsBlockDevice := func(deviceName string, volumeSize, iops, throughput int) map[string]interface{} {
+		return map[string]interface{}{
+			"device_name": deviceName,
+			"iops":        iops,
+			"throughput":  throughput,
+			"volume_size": volumeSize,
+		}
+	}
+	hash := func(v interface{}) int {
+		return schema.HashString(v.(map[string]interface{})["device_name"])
+	}
+	key := func(deviceName, k string) string {
+		return fmt.Sprintf("ebs_block_device.%d.%s", hash(map[string]interface{}{"device_name": deviceName}), k)
+	}
+
+	o := schema.NewSet(hash, []interface{}{
+		ebsBlockDevice("/dev/sdb", 10, 3000, 125),
+		ebsBlockDevice("/dev/sdc", 10, 3000, 125),
+		ebsBlockDevice("/dev/sdd", 10, 3000, 125),
+	})
+	n := schema.NewSet(hash, []interface{}{
+		ebsBlockDevice("/dev/sdb", 20, 3000, 125),
+		ebsBlockDevice("/dev/sdc", 10, 4000, 250),
+		ebsBlockDevice("/dev/sdd", 10, 0, 0),
+		ebsBlockDevice("/dev/sde", 30, 3000, 125),
+	})
+
+	got := instanceEBSBlockDeviceVolumeChangedKeys(o, n)
+	sort.Strings(got)
+
+	want := []string{
+		key("/dev/sdb", "volume_size"),
+		key("/dev/sdc", "iops"),
+		key("/dev/sdc", "throughput"),
+	}
+	sort.Strings(want)
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
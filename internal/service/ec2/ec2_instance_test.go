@@ -1986,6 +1986,152 @@ func TestAccEC2Instance_changeInstanceType(t *testing.T) {
 	})
 }
 
+func TestAccEC2Instance_InPlaceResize_instanceType(t *testing.T) {
+	var before ec2.Instance
+	var after ec2.Instance
+	resourceName := "aws_instance.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_inPlaceResize(rName, "t3.micro"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &before),
+					resource.TestCheckResourceAttr(resourceName, "instance_type", "t3.micro"),
+					resource.TestCheckResourceAttr(resourceName, "in_place_resize.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "in_place_resize.0.verify_compatibility", "true"),
+					resource.TestCheckResourceAttr(resourceName, "in_place_resize.0.wait_for_status_checks", "true"),
+				),
+			},
+			{
+				Config: testAccInstanceConfig_inPlaceResize(rName, "t3.small"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &after),
+					testAccCheckInstanceNotRecreated(&before, &after),
+					resource.TestCheckResourceAttr(resourceName, "instance_type", "t3.small"),
+					resource.TestCheckResourceAttr(resourceName, "instance_state", "running"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2Instance_InPlaceResize_incompatibleInstanceType(t *testing.T) {
+	var v ec2.Instance
+	resourceName := "aws_instance.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_inPlaceResize(rName, "t3.micro"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &v),
+				),
+			},
+			{
+				Config:      testAccInstanceConfig_inPlaceResize(rName, "t4g.micro"),
+				ExpectError: regexp.MustCompile(`does not support architecture x86_64`),
+			},
+		},
+	})
+}
+
+func TestAccEC2Instance_InPlaceResize_ebsBlockDevice(t *testing.T) {
+	var before ec2.Instance
+	var after ec2.Instance
+	resourceName := "aws_instance.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_inPlaceResizeEBSBlockDevice(rName, 10, 3000, 125),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &before),
+					resource.TestCheckResourceAttr(resourceName, "ebs_block_device.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "ebs_block_device.*", map[string]string{
+						"device_name": "/dev/sdb",
+						"iops":        "3000",
+						"throughput":  "125",
+						"volume_size": "10",
+					}),
+				),
+			},
+			{
+				Config: testAccInstanceConfig_inPlaceResizeEBSBlockDevice(rName, 20, 4000, 250),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &after),
+					testAccCheckInstanceNotRecreated(&before, &after),
+					resource.TestCheckResourceAttr(resourceName, "ebs_block_device.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "ebs_block_device.*", map[string]string{
+						"device_name": "/dev/sdb",
+						"iops":        "4000",
+						"throughput":  "250",
+						"volume_size": "20",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2Instance_EBSBlockDevice_modifyVolumeForcesNew(t *testing.T) {
+	var before ec2.Instance
+	var after ec2.Instance
+	resourceName := "aws_instance.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_ebsBlockDeviceVolume(rName, 10, 3000, 125),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &before),
+					resource.TestCheckResourceAttr(resourceName, "ebs_block_device.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "ebs_block_device.*", map[string]string{
+						"device_name": "/dev/sdb",
+						"iops":        "3000",
+						"throughput":  "125",
+						"volume_size": "10",
+					}),
+				),
+			},
+			{
+				Config: testAccInstanceConfig_ebsBlockDeviceVolume(rName, 20, 4000, 250),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &after),
+					testAccCheckInstanceRecreated(&before, &after),
+					resource.TestCheckResourceAttr(resourceName, "ebs_block_device.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "ebs_block_device.*", map[string]string{
+						"device_name": "/dev/sdb",
+						"iops":        "4000",
+						"throughput":  "250",
+						"volume_size": "20",
+					}),
+				),
+			},
+		},
+	})
+}
+
 func TestAccEC2Instance_changeInstanceTypeAndUserData(t *testing.T) {
 	var v ec2.Instance
 	resourceName := "aws_instance.test"
@@ -5303,6 +5449,77 @@ resource "aws_instance" "test" {
 `, rName))
 }
 
+func testAccInstanceConfig_inPlaceResize(rName, instanceType string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLatestAmazonLinuxHVMEBSAMI(),
+		testAccInstanceVPCConfig(rName, false, 0),
+		fmt.Sprintf(`
+resource "aws_instance" "test" {
+  ami           = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
+  instance_type = %[2]q
+  subnet_id     = aws_subnet.test.id
+
+  in_place_resize {}
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, instanceType))
+}
+
+func testAccInstanceConfig_inPlaceResizeEBSBlockDevice(rName string, volumeSize, iops, throughput int) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLatestAmazonLinuxHVMEBSAMI(),
+		testAccInstanceVPCConfig(rName, false, 0),
+		fmt.Sprintf(`
+resource "aws_instance" "test" {
+  ami           = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
+  instance_type = "t3.micro"
+  subnet_id     = aws_subnet.test.id
+
+  ebs_block_device {
+    device_name = "/dev/sdb"
+    volume_type = "gp3"
+    volume_size = %[2]d
+    iops        = %[3]d
+    throughput  = %[4]d
+  }
+
+  in_place_resize {}
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, volumeSize, iops, throughput))
+}
+
+func testAccInstanceConfig_ebsBlockDeviceVolume(rName string, volumeSize, iops, throughput int) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLatestAmazonLinuxHVMEBSAMI(),
+		testAccInstanceVPCConfig(rName, false, 0),
+		fmt.Sprintf(`
+resource "aws_instance" "test" {
+  ami           = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
+  instance_type = "t3.micro"
+  subnet_id     = aws_subnet.test.id
+
+  ebs_block_device {
+    device_name = "/dev/sdb"
+    volume_type = "gp3"
+    volume_size = %[2]d
+    iops        = %[3]d
+    throughput  = %[4]d
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, volumeSize, iops, throughput))
+}
+
 func testAccInstanceConfig_updateType(rName string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigLatestAmazonLinuxHVMEBSAMI(),
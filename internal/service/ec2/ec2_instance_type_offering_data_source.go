@@ -20,7 +20,7 @@ func DataSourceInstanceTypeOffering() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
-			"filter": DataSourceFiltersSchema(),
+			"filter": DataSourceFiltersSchemaForOperation("DescribeInstanceTypeOfferings"),
 			"instance_type": {
 				Type:     schema.TypeString,
 				Computed: true,
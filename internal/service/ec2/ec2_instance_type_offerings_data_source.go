@@ -20,7 +20,7 @@ func DataSourceInstanceTypeOfferings() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
-			"filter": DataSourceFiltersSchema(),
+			"filter": DataSourceFiltersSchemaForOperation("DescribeInstanceTypeOfferings"),
 			"instance_types": {
 				Type:     schema.TypeList,
 				Computed: true,
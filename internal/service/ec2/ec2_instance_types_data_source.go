@@ -19,7 +19,7 @@ func DataSourceInstanceTypes() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
-			"filter": DataSourceFiltersSchema(),
+			"filter": DataSourceFiltersSchemaForOperation("DescribeInstanceTypes"),
 			"instance_types": {
 				Type:     schema.TypeList,
 				Computed: true,
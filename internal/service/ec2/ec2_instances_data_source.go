@@ -22,7 +22,7 @@ func DataSourceInstances() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
-			"filter": DataSourceFiltersSchema(),
+			"filter": DataSourceFiltersSchemaForOperation("DescribeInstances"),
 			"ids": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -47,6 +47,7 @@ func DataSourceInstances() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"tag_filter": TagFiltersSchema(),
 		},
 	}
 }
@@ -76,6 +77,10 @@ func dataSourceInstancesRead(d *schema.ResourceData, meta interface{}) error {
 		d.Get("filter").(*schema.Set),
 	)...)
 
+	input.Filters = append(input.Filters, BuildTagFiltersList(
+		d.Get("tag_filter").(*schema.Set),
+	)...)
+
 	if len(input.Filters) == 0 {
 		input.Filters = nil
 	}
@@ -30,7 +30,7 @@ func DataSourceKeyPair() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"filter": DataSourceFiltersSchema(),
+			"filter": DataSourceFiltersSchemaForOperation("DescribeKeyPairs"),
 			"fingerprint": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -56,7 +56,8 @@ func DataSourceKeyPair() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"tags": tftags.TagsSchemaComputed(),
+			"tag_filter": TagFiltersSchema(),
+			"tags":       tftags.TagsSchemaComputed(),
 		},
 	}
 }
@@ -71,6 +72,10 @@ func dataSourceKeyPairRead(d *schema.ResourceData, meta interface{}) error {
 		input.Filters = BuildFiltersDataSource(v.(*schema.Set))
 	}
 
+	if v, ok := d.GetOk("tag_filter"); ok {
+		input.Filters = append(input.Filters, BuildTagFiltersList(v.(*schema.Set))...)
+	}
+
 	if v, ok := d.GetOk("key_name"); ok {
 		input.KeyNames = aws.StringSlice([]string{v.(string)})
 	}
@@ -199,7 +199,7 @@ func DataSourceLaunchTemplate() *schema.Resource {
 					},
 				},
 			},
-			"filter": DataSourceFiltersSchema(),
+			"filter": DataSourceFiltersSchemaForOperation("DescribeLaunchTemplates"),
 			"hibernation_options": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -719,6 +719,7 @@ func DataSourceLaunchTemplate() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"tag_filter": TagFiltersSchema(),
 			"tag_specifications": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -764,6 +765,10 @@ func dataSourceLaunchTemplateRead(d *schema.ResourceData, meta interface{}) erro
 		d.Get("filter").(*schema.Set),
 	)...)
 
+	input.Filters = append(input.Filters, BuildTagFiltersList(
+		d.Get("tag_filter").(*schema.Set),
+	)...)
+
 	input.Filters = append(input.Filters, BuildTagFilterList(
 		Tags(tftags.New(d.Get("tags").(map[string]interface{}))),
 	)...)
@@ -19,7 +19,7 @@ func DataSourceSpotPrice() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
-			"filter": DataSourceFiltersSchema(),
+			"filter": DataSourceFiltersSchemaForOperation("DescribeSpotPriceHistory"),
 			"instance_type": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 )
 
@@ -106,6 +107,75 @@ func CustomFiltersSchema() *schema.Schema {
 	}
 }
 
+// CustomFiltersSchemaForOperation returns a CustomFiltersSchema whose filter
+// names are validated at plan time against those documented for the specified
+// EC2 API operation, e.g. "DescribeSubnets". See validFilterName for details.
+func CustomFiltersSchemaForOperation(operation string) *schema.Schema {
+	v := CustomFiltersSchema()
+	v.Elem.(*schema.Resource).Schema["name"].ValidateDiagFunc = validFilterName(operation)
+
+	return v
+}
+
+// TagFiltersSchema returns a *schema.Schema that represents a set of
+// typed tag filters, for data sources wrapping "Describe..." API calls
+// that support "tag:<key>" filters.
+//
+// It is conventional for an attribute of this type to be included as a
+// top-level attribute called "tag_filter". Unlike a "tag:<key>" custom
+// filter, the tag key cannot be mistyped as part of the filter name, and
+// unlike "tags", each tag can match any of several values. In Terraform
+// configuration, the tag filter blocks then look like this:
+//
+//	tag_filter {
+//	  key    = "Environment"
+//	  values = ["staging", "prod*"]
+//	}
+func TagFiltersSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"key": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+				"values": {
+					Type:     schema.TypeSet,
+					Required: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+			},
+		},
+	}
+}
+
+// BuildTagFiltersList takes the set value extracted from a schema
+// attribute conforming to the schema returned by TagFiltersSchema,
+// and transforms it into a []*ec2.Filter of "tag:<key>" filters.
+func BuildTagFiltersList(tagFilterSet *schema.Set) []*ec2.Filter {
+	if tagFilterSet == nil {
+		return nil
+	}
+
+	var filters []*ec2.Filter
+
+	for _, tfMapRaw := range tagFilterSet.List() {
+		tfMap := tfMapRaw.(map[string]interface{})
+
+		filters = append(filters, &ec2.Filter{
+			Name:   aws.String(filterNameTagPrefix + tfMap["key"].(string)),
+			Values: flex.ExpandStringSet(tfMap["values"].(*schema.Set)),
+		})
+	}
+
+	return filters
+}
+
 // CustomFiltersBlock is the Plugin Framework variant of CustomFiltersSchema.
 func CustomFiltersBlock() tfsdk.Block {
 	return tfsdk.Block{
@@ -0,0 +1,1254 @@
+// Code generated by internal/service/ec2/generate/filters/main.go; DO NOT EDIT.
+
+package ec2
+
+// filterNames maps each EC2 API operation that accepts filters to the filter names documented for it.
+// Names of the form "tag:<key>" match any tag key.
+var filterNames = map[string][]string{
+	"DescribeAddresses": {
+		"allocation-id",
+		"association-id",
+		"instance-id",
+		"network-border-group",
+		"network-interface-id",
+		"network-interface-owner-id",
+		"private-ip-address",
+		"public-ip",
+		"tag-key",
+		"tag:<key>",
+	},
+	"DescribeAvailabilityZones": {
+		"group-name",
+		"message",
+		"opt-in-status",
+		"parent-zoneID",
+		"parent-zoneName",
+		"region-name",
+		"state",
+		"zone-id",
+		"zone-name",
+		"zone-type",
+	},
+	"DescribeBundleTasks": {
+		"bundle-id",
+		"error-code",
+		"error-message",
+		"instance-id",
+		"progress",
+		"s3-bucket",
+		"s3-prefix",
+		"start-time",
+		"state",
+		"update-time",
+	},
+	"DescribeCapacityReservationFleets": {
+		"allocation-strategy",
+		"instance-match-criteria",
+		"state",
+		"tenancy",
+	},
+	"DescribeCapacityReservations": {
+		"availability-zone",
+		"end-date",
+		"end-date-type",
+		"instance-match-criteria",
+		"instance-platform",
+		"instance-type",
+		"outpost-arn",
+		"owner-id",
+		"placement-group-arn",
+		"start-date",
+		"state",
+		"tenancy",
+	},
+	"DescribeCarrierGateways": {
+		"carrier-gateway-id",
+		"owner-id",
+		"state",
+		"tag-key",
+		"tag:<key>",
+		"vpc-id",
+	},
+	"DescribeClassicLinkInstances": {
+		"group-id",
+		"instance-id",
+		"tag-key",
+		"tag:<key>",
+		"vpc-id",
+	},
+	"DescribeClientVpnAuthorizationRules": {
+		"description",
+		"destination-cidr",
+		"group-id",
+	},
+	"DescribeClientVpnConnections": {
+		"connection-id",
+		"username",
+	},
+	"DescribeClientVpnEndpoints": {
+		"endpoint-id",
+		"transport-protocol",
+	},
+	"DescribeClientVpnRoutes": {
+		"destination-cidr",
+		"origin",
+		"target-subnet",
+	},
+	"DescribeClientVpnTargetNetworks": {
+		"association-id",
+		"target-network-id",
+		"vpc-id",
+	},
+	"DescribeCoipPools": {
+		"coip-pool.local-gateway-route-table-id",
+		"coip-pool.pool-id",
+	},
+	"DescribeCustomerGateways": {
+		"bgp-asn",
+		"customer-gateway-id",
+		"ip-address",
+		"state",
+		"tag-key",
+		"tag:<key>",
+		"type",
+	},
+	"DescribeDhcpOptions": {
+		"dhcp-options-id",
+		"key",
+		"owner-id",
+		"tag-key",
+		"tag:<key>",
+		"value",
+	},
+	"DescribeEgressOnlyInternetGateways": {
+		"tag-key",
+		"tag:<key>",
+	},
+	"DescribeElasticGpus": {
+		"availability-zone",
+		"elastic-gpu-health",
+		"elastic-gpu-state",
+		"elastic-gpu-type",
+		"instance-id",
+	},
+	"DescribeFastLaunchImages": {
+		"owner-id",
+		"resource-type",
+		"state",
+	},
+	"DescribeFastSnapshotRestores": {
+		"availability-zone",
+		"owner-id",
+		"snapshot-id",
+		"state",
+	},
+	"DescribeFleetInstances": {
+		"instance-type",
+	},
+	"DescribeFleets": {
+		"activity-status",
+		"excess-capacity-termination-policy",
+		"fleet-state",
+		"replace-unhealthy-instances",
+		"type",
+	},
+	"DescribeFlowLogs": {
+		"deliver-log-status",
+		"flow-log-id",
+		"log-destination-type",
+		"log-group-name",
+		"resource-id",
+		"tag-key",
+		"tag:<key>",
+		"traffic-type",
+	},
+	"DescribeFpgaImages": {
+		"create-time",
+		"fpga-image-global-id",
+		"fpga-image-id",
+		"name",
+		"owner-id",
+		"product-code",
+		"shell-version",
+		"state",
+		"tag-key",
+		"tag:<key>",
+		"update-time",
+	},
+	"DescribeHostReservationOfferings": {
+		"instance-family",
+		"payment-option",
+	},
+	"DescribeHostReservations": {
+		"instance-family",
+		"payment-option",
+		"state",
+		"tag-key",
+		"tag:<key>",
+	},
+	"DescribeHosts": {
+		"auto-placement",
+		"availability-zone",
+		"client-token",
+		"host-reservation-id",
+		"instance-type",
+		"state",
+		"tag-key",
+	},
+	"DescribeIamInstanceProfileAssociations": {
+		"instance-id",
+		"state",
+	},
+	"DescribeImages": {
+		"architecture",
+		"block-device-mapping.delete-on-termination",
+		"block-device-mapping.device-name",
+		"block-device-mapping.encrypted",
+		"block-device-mapping.snapshot-id",
+		"block-device-mapping.volume-size",
+		"block-device-mapping.volume-type",
+		"creation-date",
+		"description",
+		"ena-support",
+		"hypervisor",
+		"image-id",
+		"image-type",
+		"is-public",
+		"kernel-id",
+		"manifest-location",
+		"name",
+		"owner-alias",
+		"owner-id",
+		"platform",
+		"product-code",
+		"product-code.type",
+		"ramdisk-id",
+		"root-device-name",
+		"root-device-type",
+		"source-instance-id",
+		"sriov-net-support",
+		"state",
+		"state-reason-code",
+		"state-reason-message",
+		"tag-key",
+		"tag:<key>",
+		"virtualization-type",
+	},
+	"DescribeInstanceConnectEndpoints": {
+		"instance-connect-endpoint-id",
+		"state",
+		"subnet-id",
+		"tag-key",
+		"tag-value",
+		"tag:<key>",
+		"vpc-id",
+	},
+	"DescribeInstanceCreditSpecifications": {
+		"instance-id",
+	},
+	"DescribeInstanceEventWindows": {
+		"dedicated-host-id",
+		"event-window-name",
+		"instance-id",
+		"instance-tag",
+		"instance-tag-key",
+		"instance-tag-value",
+		"tag-key",
+		"tag-value",
+		"tag:<key>",
+	},
+	"DescribeInstanceStatus": {
+		"availability-zone",
+		"event.code",
+		"event.description",
+		"event.instance-event-id",
+		"event.not-after",
+		"event.not-before",
+		"event.not-before-deadline",
+		"instance-state-code",
+		"instance-state-name",
+		"instance-status.reachability",
+		"instance-status.status",
+		"system-status.reachability",
+		"system-status.status",
+	},
+	"DescribeInstanceTopology": {
+		"availability-zone",
+		"instance-type",
+		"zone-id",
+	},
+	"DescribeInstanceTypeOfferings": {
+		"instance-type",
+		"location",
+	},
+	"DescribeInstanceTypes": {
+		"auto-recovery-supported",
+		"bare-metal",
+		"burstable-performance-supported",
+		"current-generation",
+		"ebs-info.ebs-optimized-info.baseline-bandwidth-in-mbps",
+		"ebs-info.ebs-optimized-info.baseline-iops",
+		"ebs-info.ebs-optimized-info.baseline-throughput-in-mbps",
+		"ebs-info.ebs-optimized-info.maximum-bandwidth-in-mbps",
+		"ebs-info.ebs-optimized-info.maximum-iops",
+		"ebs-info.ebs-optimized-info.maximum-throughput-in-mbps",
+		"ebs-info.ebs-optimized-support",
+		"ebs-info.encryption-support",
+		"ebs-info.nvme-support",
+		"free-tier-eligible",
+		"hibernation-supported",
+		"hypervisor",
+		"instance-storage-info.disk.count",
+		"instance-storage-info.disk.size-in-gb",
+		"instance-storage-info.disk.type",
+		"instance-storage-info.encryption-support",
+		"instance-storage-info.nvme-support",
+		"instance-storage-info.total-size-in-gb",
+		"instance-storage-supported",
+		"instance-type",
+		"memory-info.size-in-mib",
+		"network-info.efa-info.maximum-efa-interfaces",
+		"network-info.efa-supported",
+		"network-info.ena-support",
+		"network-info.encryption-in-transit-supported",
+		"network-info.ipv4-addresses-per-interface",
+		"network-info.ipv6-addresses-per-interface",
+		"network-info.ipv6-supported",
+		"network-info.maximum-network-cards",
+		"network-info.maximum-network-interfaces",
+		"network-info.network-performance",
+		"nitro-enclaves-support",
+		"nitro-tpm-info.supported-versions",
+		"nitro-tpm-support",
+		"processor-info.supported-architecture",
+		"processor-info.supported-features",
+		"processor-info.sustained-clock-speed-in-ghz",
+		"supported-boot-mode",
+		"supported-root-device-type",
+		"supported-usage-class",
+		"supported-virtualization-type",
+		"vcpu-info.default-cores",
+		"vcpu-info.default-threads-per-core",
+		"vcpu-info.default-vcpus",
+		"vcpu-info.valid-cores",
+		"vcpu-info.valid-threads-per-core",
+	},
+	"DescribeInstances": {
+		"affinity",
+		"architecture",
+		"availability-zone",
+		"block-device-mapping.attach-time",
+		"block-device-mapping.delete-on-termination",
+		"block-device-mapping.device-name",
+		"block-device-mapping.status",
+		"block-device-mapping.volume-id",
+		"boot-mode",
+		"capacity-reservation-id",
+		"capacity-reservation-specification.capacity-reservation-preference",
+		"capacity-reservation-specification.capacity-reservation-target.capacity-reservation-id",
+		"capacity-reservation-specification.capacity-reservation-target.capacity-reservation-resource-group-arn",
+		"client-token",
+		"current-instance-boot-mode",
+		"dns-name",
+		"ebs-optimized",
+		"ena-support",
+		"enclave-options.enabled",
+		"hibernation-options.configured",
+		"host-id",
+		"hypervisor",
+		"iam-instance-profile.arn",
+		"iam-instance-profile.id",
+		"iam-instance-profile.name",
+		"image-id",
+		"instance-id",
+		"instance-lifecycle",
+		"instance-state-code",
+		"instance-state-name",
+		"instance-type",
+		"instance.group-id",
+		"instance.group-name",
+		"ip-address",
+		"ipv6-address",
+		"kernel-id",
+		"key-name",
+		"launch-index",
+		"launch-time",
+		"maintenance-options.auto-recovery",
+		"metadata-options.http-endpoint",
+		"metadata-options.http-protocol-ipv4",
+		"metadata-options.http-protocol-ipv6",
+		"metadata-options.http-put-response-hop-limit",
+		"metadata-options.http-tokens",
+		"metadata-options.instance-metadata-tags",
+		"metadata-options.state",
+		"monitoring-state",
+		"network-interface.addresses.association.allocation-id",
+		"network-interface.addresses.association.association-id",
+		"network-interface.addresses.association.carrier-ip",
+		"network-interface.addresses.association.customer-owned-ip",
+		"network-interface.addresses.association.ip-owner-id",
+		"network-interface.addresses.association.public-dns-name",
+		"network-interface.addresses.association.public-ip",
+		"network-interface.addresses.primary",
+		"network-interface.addresses.private-dns-name",
+		"network-interface.addresses.private-ip-address",
+		"network-interface.association.allocation-id",
+		"network-interface.association.association-id",
+		"network-interface.association.carrier-ip",
+		"network-interface.association.customer-owned-ip",
+		"network-interface.association.ip-owner-id",
+		"network-interface.association.public-dns-name",
+		"network-interface.association.public-ip",
+		"network-interface.attachment.attach-time",
+		"network-interface.attachment.attachment-id",
+		"network-interface.attachment.delete-on-termination",
+		"network-interface.attachment.device-index",
+		"network-interface.attachment.instance-id",
+		"network-interface.attachment.instance-owner-id",
+		"network-interface.attachment.network-card-index",
+		"network-interface.attachment.status",
+		"network-interface.availability-zone",
+		"network-interface.deny-all-igw-traffic",
+		"network-interface.description",
+		"network-interface.group-id",
+		"network-interface.group-name",
+		"network-interface.ipv4-prefixes.ipv4-prefix",
+		"network-interface.ipv6-address",
+		"network-interface.ipv6-addresses.ipv6-address",
+		"network-interface.ipv6-addresses.is-primary-ipv6",
+		"network-interface.ipv6-native",
+		"network-interface.ipv6-prefixes.ipv6-prefix",
+		"network-interface.mac-address",
+		"network-interface.network-interface-id",
+		"network-interface.outpost-arn",
+		"network-interface.owner-id",
+		"network-interface.private-dns-name",
+		"network-interface.private-ip-address",
+		"network-interface.public-dns-name",
+		"network-interface.requester-id",
+		"network-interface.requester-managed",
+		"network-interface.source-dest-check",
+		"network-interface.status",
+		"network-interface.subnet-id",
+		"network-interface.tag-key",
+		"network-interface.tag-value",
+		"network-interface.vpc-id",
+		"outpost-arn",
+		"owner-id",
+		"placement-group-name",
+		"placement-partition-number",
+		"platform",
+		"platform-details",
+		"private-dns-name",
+		"private-dns-name-options.enable-resource-name-dns-a-record",
+		"private-dns-name-options.enable-resource-name-dns-aaaa-record",
+		"private-dns-name-options.hostname-type",
+		"private-ip-address",
+		"product-code",
+		"product-code.type",
+		"ramdisk-id",
+		"reason",
+		"requester-id",
+		"reservation-id",
+		"root-device-name",
+		"root-device-type",
+		"source-dest-check",
+		"spot-instance-request-id",
+		"state-reason-code",
+		"state-reason-message",
+		"subnet-id",
+		"tag-key",
+		"tag:<key>",
+		"tenancy",
+		"tpm-support",
+		"usage-operation",
+		"usage-operation-update-time",
+		"virtualization-type",
+		"vpc-id",
+	},
+	"DescribeInternetGateways": {
+		"attachment.state",
+		"attachment.vpc-id",
+		"internet-gateway-id",
+		"owner-id",
+		"tag-key",
+		"tag:<key>",
+	},
+	"DescribeIpamPools": {
+		"address-family",
+		"allocation-default-netmask-length",
+		"allocation-max-netmask-length",
+		"allocation-min-netmask-length",
+		"auto-import",
+		"aws-service",
+		"description",
+		"ipam-arn",
+		"ipam-pool-arn",
+		"ipam-pool-id",
+		"ipam-region",
+		"ipam-scope-arn",
+		"ipam-scope-type",
+		"locale",
+		"owner-id",
+		"pool-depth",
+		"public-ip-source",
+		"publicly-advertisable",
+		"source-ipam-pool-id",
+		"state",
+		"tag-key",
+		"tag:<key>",
+	},
+	"DescribeIpv6Pools": {
+		"tag-key",
+		"tag:<key>",
+	},
+	"DescribeKeyPairs": {
+		"fingerprint",
+		"key-name",
+		"key-pair-id",
+		"tag-key",
+		"tag:<key>",
+	},
+	"DescribeLaunchTemplateVersions": {
+		"create-time",
+		"ebs-optimized",
+		"host-resource-group-arn",
+		"http-endpoint",
+		"http-protocol-ipv4",
+		"http-tokens",
+		"iam-instance-profile",
+		"image-id",
+		"instance-type",
+		"is-default-version",
+		"kernel-id",
+		"license-configuration-arn",
+		"network-card-index",
+		"ram-disk-id",
+	},
+	"DescribeLaunchTemplates": {
+		"create-time",
+		"launch-template-name",
+		"tag-key",
+		"tag:<key>",
+	},
+	"DescribeLocalGatewayRouteTableVirtualInterfaceGroupAssociations": {
+		"local-gateway-id",
+		"local-gateway-route-table-arn",
+		"local-gateway-route-table-id",
+		"local-gateway-route-table-virtual-interface-group-association-id",
+		"local-gateway-route-table-virtual-interface-group-id",
+		"owner-id",
+		"state",
+	},
+	"DescribeLocalGatewayRouteTableVpcAssociations": {
+		"local-gateway-id",
+		"local-gateway-route-table-arn",
+		"local-gateway-route-table-id",
+		"local-gateway-route-table-vpc-association-id",
+		"owner-id",
+		"state",
+		"vpc-id",
+	},
+	"DescribeLocalGatewayRouteTables": {
+		"local-gateway-id",
+		"local-gateway-route-table-arn",
+		"local-gateway-route-table-id",
+		"outpost-arn",
+		"owner-id",
+		"state",
+	},
+	"DescribeLocalGatewayVirtualInterfaceGroups": {
+		"local-gateway-id",
+		"local-gateway-virtual-interface-group-id",
+		"local-gateway-virtual-interface-id",
+		"owner-id",
+	},
+	"DescribeLocalGatewayVirtualInterfaces": {
+		"local-address",
+		"local-bgp-asn",
+		"local-gateway-id",
+		"local-gateway-virtual-interface-id",
+		"owner-id",
+		"peer-address",
+		"peer-bgp-asn",
+		"vlan",
+	},
+	"DescribeLocalGateways": {
+		"local-gateway-id",
+		"outpost-arn",
+		"owner-id",
+		"state",
+	},
+	"DescribeLockedSnapshots": {
+		"lock-state",
+	},
+	"DescribeManagedPrefixLists": {
+		"owner-id",
+		"prefix-list-id",
+		"prefix-list-name",
+	},
+	"DescribeMovingAddresses": {
+		"moving-status",
+	},
+	"DescribeNatGateways": {
+		"nat-gateway-id",
+		"state",
+		"subnet-id",
+		"tag-key",
+		"tag:<key>",
+		"vpc-id",
+	},
+	"DescribeNetworkAcls": {
+		"association.association-id",
+		"association.network-acl-id",
+		"association.subnet-id",
+		"default",
+		"entry.cidr",
+		"entry.egress",
+		"entry.icmp.code",
+		"entry.icmp.type",
+		"entry.ipv6-cidr",
+		"entry.port-range.from",
+		"entry.port-range.to",
+		"entry.protocol",
+		"entry.rule-action",
+		"entry.rule-number",
+		"network-acl-id",
+		"owner-id",
+		"tag-key",
+		"tag:<key>",
+		"vpc-id",
+	},
+	"DescribeNetworkInsightsAnalyses": {
+		"path-found",
+		"status",
+	},
+	"DescribeNetworkInsightsPaths": {
+		"destination",
+		"filter-at-destination.destination-address",
+		"filter-at-destination.destination-port-range",
+		"filter-at-destination.source-address",
+		"filter-at-destination.source-port-range",
+		"filter-at-source.destination-address",
+		"filter-at-source.destination-port-range",
+		"filter-at-source.source-address",
+		"filter-at-source.source-port-range",
+		"protocol",
+		"source",
+	},
+	"DescribeNetworkInterfacePermissions": {
+		"network-interface-permission.aws-account-id",
+		"network-interface-permission.aws-service",
+		"network-interface-permission.network-interface-id",
+		"network-interface-permission.network-interface-permission-id",
+		"network-interface-permission.permission",
+	},
+	"DescribeNetworkInterfaces": {
+		"addresses.association.owner-id",
+		"addresses.association.public-ip",
+		"addresses.primary",
+		"addresses.private-ip-address",
+		"association.allocation-id",
+		"association.association-id",
+		"association.ip-owner-id",
+		"association.public-dns-name",
+		"association.public-ip",
+		"attachment.attach-time",
+		"attachment.attachment-id",
+		"attachment.delete-on-termination",
+		"attachment.device-index",
+		"attachment.instance-id",
+		"attachment.instance-owner-id",
+		"attachment.status",
+		"availability-zone",
+		"description",
+		"group-id",
+		"interface-type",
+		"ipv6-addresses.ipv6-address",
+		"mac-address",
+		"network-interface-id",
+		"owner-id",
+		"private-dns-name",
+		"private-ip-address",
+		"requester-id",
+		"requester-managed",
+		"source-dest-check",
+		"status",
+		"subnet-id",
+		"tag-key",
+		"tag:<key>",
+		"vpc-id",
+	},
+	"DescribePlacementGroups": {
+		"group-arn",
+		"group-name",
+		"spread-level",
+		"state",
+		"strategy",
+		"tag-key",
+		"tag:<key>",
+	},
+	"DescribePrefixLists": {
+		"prefix-list-id",
+		"prefix-list-name",
+	},
+	"DescribePublicIpv4Pools": {
+		"tag-key",
+		"tag:<key>",
+	},
+	"DescribeRegions": {
+		"endpoint",
+		"opt-in-status",
+		"region-name",
+	},
+	"DescribeReplaceRootVolumeTasks": {
+		"instance-id",
+	},
+	"DescribeReservedInstances": {
+		"availability-zone",
+		"duration",
+		"end",
+		"fixed-price",
+		"instance-type",
+		"product-description",
+		"reserved-instances-id",
+		"scope",
+		"start",
+		"state",
+		"tag-key",
+		"tag:<key>",
+		"usage-price",
+	},
+	"DescribeReservedInstancesListings": {
+		"reserved-instances-id",
+		"reserved-instances-listing-id",
+		"status",
+		"status-message",
+	},
+	"DescribeReservedInstancesModifications": {
+		"client-token",
+		"create-date",
+		"effective-date",
+		"modification-result.reserved-instances-id",
+		"modification-result.target-configuration.availability-zone",
+		"modification-result.target-configuration.instance-count",
+		"modification-result.target-configuration.instance-type",
+		"reserved-instances-id",
+		"reserved-instances-modification-id",
+		"status",
+		"status-message",
+		"update-date",
+	},
+	"DescribeReservedInstancesOfferings": {
+		"availability-zone",
+		"duration",
+		"fixed-price",
+		"instance-type",
+		"marketplace",
+		"product-description",
+		"reserved-instances-offering-id",
+		"scope",
+		"usage-price",
+	},
+	"DescribeRouteTables": {
+		"association.main",
+		"association.route-table-association-id",
+		"association.route-table-id",
+		"association.subnet-id",
+		"owner-id",
+		"route-table-id",
+		"route.destination-cidr-block",
+		"route.destination-ipv6-cidr-block",
+		"route.destination-prefix-list-id",
+		"route.egress-only-internet-gateway-id",
+		"route.gateway-id",
+		"route.instance-id",
+		"route.nat-gateway-id",
+		"route.origin",
+		"route.state",
+		"route.transit-gateway-id",
+		"route.vpc-peering-connection-id",
+		"tag-key",
+		"tag:<key>",
+		"vpc-id",
+	},
+	"DescribeScheduledInstanceAvailability": {
+		"availability-zone",
+		"instance-type",
+		"platform",
+	},
+	"DescribeScheduledInstances": {
+		"availability-zone",
+		"instance-type",
+		"platform",
+	},
+	"DescribeSecurityGroupRules": {
+		"group-id",
+		"security-group-rule-id",
+		"tag:<key>",
+	},
+	"DescribeSecurityGroups": {
+		"description",
+		"egress.ip-permission.cidr",
+		"egress.ip-permission.from-port",
+		"egress.ip-permission.group-id",
+		"egress.ip-permission.group-name",
+		"egress.ip-permission.ipv6-cidr",
+		"egress.ip-permission.prefix-list-id",
+		"egress.ip-permission.protocol",
+		"egress.ip-permission.to-port",
+		"egress.ip-permission.user-id",
+		"group-id",
+		"group-name",
+		"ip-permission.cidr",
+		"ip-permission.from-port",
+		"ip-permission.group-id",
+		"ip-permission.group-name",
+		"ip-permission.ipv6-cidr",
+		"ip-permission.prefix-list-id",
+		"ip-permission.protocol",
+		"ip-permission.to-port",
+		"ip-permission.user-id",
+		"owner-id",
+		"tag-key",
+		"tag:<key>",
+		"vpc-id",
+	},
+	"DescribeSnapshotTierStatus": {
+		"last-tiering-operation",
+		"snapshot-id",
+		"volume-id",
+	},
+	"DescribeSnapshots": {
+		"description",
+		"encrypted",
+		"owner-alias",
+		"owner-id",
+		"progress",
+		"snapshot-id",
+		"start-time",
+		"status",
+		"storage-tier",
+		"tag-key",
+		"tag:<key>",
+		"volume-id",
+		"volume-size",
+	},
+	"DescribeSpotInstanceRequests": {
+		"availability-zone-group",
+		"create-time",
+		"fault-code",
+		"fault-message",
+		"instance-id",
+		"launch-group",
+		"launch.block-device-mapping.delete-on-termination",
+		"launch.block-device-mapping.device-name",
+		"launch.block-device-mapping.snapshot-id",
+		"launch.block-device-mapping.volume-size",
+		"launch.block-device-mapping.volume-type",
+		"launch.group-id",
+		"launch.group-name",
+		"launch.image-id",
+		"launch.instance-type",
+		"launch.kernel-id",
+		"launch.key-name",
+		"launch.monitoring-enabled",
+		"launch.ramdisk-id",
+		"launched-availability-zone",
+		"network-interface.addresses.primary",
+		"network-interface.delete-on-termination",
+		"network-interface.description",
+		"network-interface.device-index",
+		"network-interface.group-id",
+		"network-interface.network-interface-id",
+		"network-interface.private-ip-address",
+		"network-interface.subnet-id",
+		"product-description",
+		"spot-instance-request-id",
+		"spot-price",
+		"state",
+		"status-code",
+		"status-message",
+		"tag-key",
+		"tag:<key>",
+		"type",
+		"valid-from",
+		"valid-until",
+	},
+	"DescribeSpotPriceHistory": {
+		"availability-zone",
+		"instance-type",
+		"product-description",
+		"spot-price",
+		"timestamp",
+	},
+	"DescribeStoreImageTasks": {
+		"bucket",
+		"task-state",
+	},
+	"DescribeSubnets": {
+		"availability-zone",
+		"availability-zone-id",
+		"availabilityZone",
+		"availabilityZoneId",
+		"available-ip-address-count",
+		"cidr",
+		"cidr-block",
+		"cidrBlock",
+		"customer-owned-ipv4-pool",
+		"default-for-az",
+		"defaultForAz",
+		"enable-dns64",
+		"enable-lni-at-device-index",
+		"ipv6-cidr-block-association.association-id",
+		"ipv6-cidr-block-association.ipv6-cidr-block",
+		"ipv6-cidr-block-association.state",
+		"ipv6-native",
+		"map-customer-owned-ip-on-launch",
+		"map-public-ip-on-launch",
+		"outpost-arn",
+		"owner-id",
+		"private-dns-name-options-on-launch.enable-resource-name-dns-a-record",
+		"private-dns-name-options-on-launch.enable-resource-name-dns-aaaa-record",
+		"private-dns-name-options-on-launch.hostname-type",
+		"state",
+		"subnet-arn",
+		"subnet-id",
+		"tag-key",
+		"tag:<key>",
+		"vpc-id",
+	},
+	"DescribeTags": {
+		"key",
+		"resource-id",
+		"resource-type",
+		"tag:<key>",
+		"value",
+	},
+	"DescribeTrafficMirrorFilters": {
+		"description",
+		"traffic-mirror-filter-id",
+	},
+	"DescribeTrafficMirrorSessions": {
+		"description",
+		"network-interface-id",
+		"owner-id",
+		"packet-length",
+		"session-number",
+		"traffic-mirror-filter-id",
+		"traffic-mirror-session-id",
+		"traffic-mirror-target-id",
+		"virtual-network-id",
+	},
+	"DescribeTrafficMirrorTargets": {
+		"description",
+		"network-interface-id",
+		"network-load-balancer-arn",
+		"owner-id",
+		"traffic-mirror-target-id",
+	},
+	"DescribeTransitGatewayAttachments": {
+		"association.state",
+		"association.transit-gateway-route-table-id",
+		"resource-id",
+		"resource-owner-id",
+		"resource-type",
+		"state",
+		"transit-gateway-attachment-id",
+		"transit-gateway-id",
+		"transit-gateway-owner-id",
+	},
+	"DescribeTransitGatewayConnectPeers": {
+		"state",
+		"transit-gateway-attachment-id",
+		"transit-gateway-connect-peer-id",
+	},
+	"DescribeTransitGatewayConnects": {
+		"options.protocol",
+		"state",
+		"transit-gateway-attachment-id",
+		"transit-gateway-id",
+		"transport-transit-gateway-attachment-id",
+	},
+	"DescribeTransitGatewayMulticastDomains": {
+		"state",
+		"transit-gateway-id",
+		"transit-gateway-multicast-domain-id",
+	},
+	"DescribeTransitGatewayPeeringAttachments": {
+		"local-owner-id",
+		"remote-owner-id",
+		"state",
+		"tag-key",
+		"tag:<key>",
+		"transit-gateway-attachment-id",
+		"transit-gateway-id",
+	},
+	"DescribeTransitGatewayRouteTables": {
+		"default-association-route-table",
+		"default-propagation-route-table",
+		"state",
+		"transit-gateway-id",
+		"transit-gateway-route-table-id",
+	},
+	"DescribeTransitGatewayVpcAttachments": {
+		"state",
+		"transit-gateway-attachment-id",
+		"transit-gateway-id",
+		"vpc-id",
+	},
+	"DescribeTransitGateways": {
+		"options.amazon-side-asn",
+		"options.association-default-route-table-id",
+		"options.auto-accept-shared-attachments",
+		"options.default-route-table-association",
+		"options.default-route-table-propagation",
+		"options.dns-support",
+		"options.propagation-default-route-table-id",
+		"options.vpn-ecmp-support",
+		"owner-id",
+		"state",
+		"transit-gateway-id",
+	},
+	"DescribeTrunkInterfaceAssociations": {
+		"gre-key",
+		"interface-protocol",
+	},
+	"DescribeVolumeStatus": {
+		"action.code",
+		"action.description",
+		"action.event-id",
+		"availability-zone",
+		"event.description",
+		"event.event-id",
+		"event.event-type",
+		"event.not-after",
+		"event.not-before",
+		"volume-status.details-name",
+		"volume-status.details-status",
+		"volume-status.status",
+	},
+	"DescribeVolumes": {
+		"attachment.attach-time",
+		"attachment.delete-on-termination",
+		"attachment.device",
+		"attachment.instance-id",
+		"attachment.status",
+		"availability-zone",
+		"create-time",
+		"encrypted",
+		"fast-restored",
+		"multi-attach-enabled",
+		"size",
+		"snapshot-id",
+		"status",
+		"tag-key",
+		"tag:<key>",
+		"volume-id",
+		"volume-type",
+	},
+	"DescribeVolumesModifications": {
+		"modification-state",
+		"original-iops",
+		"original-size",
+		"original-volume-type",
+		"originalMultiAttachEnabled",
+		"start-time",
+		"target-iops",
+		"target-size",
+		"target-volume-type",
+		"targetMultiAttachEnabled",
+		"volume-id",
+	},
+	"DescribeVpcClassicLink": {
+		"is-classic-link-enabled",
+		"tag-key",
+		"tag:<key>",
+	},
+	"DescribeVpcEndpointConnectionNotifications": {
+		"connection-notification-arn",
+		"connection-notification-id",
+		"connection-notification-state",
+		"connection-notification-type",
+		"service-id",
+		"vpc-endpoint-id",
+	},
+	"DescribeVpcEndpointConnections": {
+		"ip-address-type",
+		"service-id",
+		"vpc-endpoint-id",
+		"vpc-endpoint-owner",
+		"vpc-endpoint-state",
+	},
+	"DescribeVpcEndpointServiceConfigurations": {
+		"service-id",
+		"service-name",
+		"service-state",
+		"supported-ip-address-types",
+		"tag-key",
+		"tag:<key>",
+	},
+	"DescribeVpcEndpointServicePermissions": {
+		"principal",
+		"principal-type",
+	},
+	"DescribeVpcEndpointServices": {
+		"owner",
+		"service-name",
+		"service-type",
+		"supported-ip-address-types",
+		"tag-key",
+		"tag:<key>",
+	},
+	"DescribeVpcEndpoints": {
+		"ip-address-type",
+		"service-name",
+		"tag-key",
+		"tag:<key>",
+		"vpc-endpoint-id",
+		"vpc-endpoint-state",
+		"vpc-endpoint-type",
+		"vpc-id",
+	},
+	"DescribeVpcPeeringConnections": {
+		"accepter-vpc-info.cidr-block",
+		"accepter-vpc-info.owner-id",
+		"accepter-vpc-info.vpc-id",
+		"expiration-time",
+		"requester-vpc-info.cidr-block",
+		"requester-vpc-info.owner-id",
+		"requester-vpc-info.vpc-id",
+		"status-code",
+		"status-message",
+		"tag-key",
+		"tag:<key>",
+		"vpc-peering-connection-id",
+	},
+	"DescribeVpcs": {
+		"cidr",
+		"cidr-block-association.association-id",
+		"cidr-block-association.cidr-block",
+		"cidr-block-association.state",
+		"dhcp-options-id",
+		"ipv6-cidr-block-association.association-id",
+		"ipv6-cidr-block-association.ipv6-cidr-block",
+		"ipv6-cidr-block-association.ipv6-pool",
+		"ipv6-cidr-block-association.state",
+		"is-default",
+		"owner-id",
+		"state",
+		"tag-key",
+		"tag:<key>",
+		"vpc-id",
+	},
+	"DescribeVpnConnections": {
+		"bgp-asn",
+		"customer-gateway-configuration",
+		"customer-gateway-id",
+		"option.static-routes-only",
+		"route.destination-cidr-block",
+		"state",
+		"tag-key",
+		"tag:<key>",
+		"transit-gateway-id",
+		"type",
+		"vpn-connection-id",
+		"vpn-gateway-id",
+	},
+	"DescribeVpnGateways": {
+		"amazon-side-asn",
+		"attachment.state",
+		"attachment.vpc-id",
+		"availability-zone",
+		"state",
+		"tag-key",
+		"tag:<key>",
+		"type",
+		"vpn-gateway-id",
+	},
+	"GetCoipPoolUsage": {
+		"coip-address-usage.allocation-id",
+		"coip-address-usage.aws-account-id",
+		"coip-address-usage.aws-service",
+		"coip-address-usage.co-ip",
+	},
+	"GetIpamPoolCidrs": {
+		"cidr",
+		"ipam-pool-cidr-id",
+		"netmask-length",
+		"state",
+	},
+	"GetSecurityGroupsForVpc": {
+		"description",
+		"group-id",
+		"group-name",
+		"owner-id",
+		"primary-vpc-id",
+	},
+	"GetSubnetCidrReservations": {
+		"reservationType",
+		"subnet-id",
+		"tag-key",
+		"tag:<key>",
+	},
+	"GetTransitGatewayAttachmentPropagations": {
+		"transit-gateway-route-table-id",
+	},
+	"GetTransitGatewayMulticastDomainAssociations": {
+		"resource-id",
+		"resource-type",
+		"state",
+		"subnet-id",
+		"transit-gateway-attachment-id",
+	},
+	"GetTransitGatewayPrefixListReferences": {
+		"attachment.resource-id",
+		"attachment.resource-type",
+		"attachment.transit-gateway-attachment-id",
+		"is-blackhole",
+		"prefix-list-id",
+		"prefix-list-owner-id",
+		"state",
+	},
+	"GetTransitGatewayRouteTableAssociations": {
+		"resource-id",
+		"resource-type",
+		"transit-gateway-attachment-id",
+	},
+	"GetTransitGatewayRouteTablePropagations": {
+		"resource-id",
+		"resource-type",
+		"transit-gateway-attachment-id",
+	},
+	"SearchLocalGatewayRoutes": {
+		"prefix-list-id",
+		"route-search.exact-match",
+		"route-search.longest-prefix-match",
+		"route-search.subnet-of-match",
+		"route-search.supernet-of-match",
+		"state",
+		"type",
+	},
+	"SearchTransitGatewayMulticastGroups": {
+		"group-ip-address",
+		"is-group-member",
+		"is-group-source",
+		"member-type",
+		"resource-id",
+		"resource-type",
+		"source-type",
+		"subnet-id",
+		"transit-gateway-attachment-id",
+	},
+	"SearchTransitGatewayRoutes": {
+		"attachment.resource-id",
+		"attachment.resource-type",
+		"prefix-list-id",
+		"route-search.exact-match",
+		"route-search.longest-prefix-match",
+		"route-search.subnet-of-match",
+		"route-search.supernet-of-match",
+		"state",
+		"type",
+	},
+}
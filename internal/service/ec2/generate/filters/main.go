@@ -0,0 +1,291 @@
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	"bytes"
+	_ "embed"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+const (
+	sdkModule = "github.com/aws/aws-sdk-go"
+)
+
+var (
+	// Bullets in the Filters field documentation look like "* availability-zone - The Availability Zone..." or "* prefix-list-id: The ID...".
+	filterNameRegexp = regexp.MustCompile(`^\* ([A-Za-z0-9:<>._-]+?)(?: -|:)\s`)
+	// Aliases are documented as "You can also use availabilityZone as the filter name."
+	filterAliasRegexp = regexp.MustCompile(`You can also use ([A-Za-z0-9:._-]+)(?: or ([A-Za-z0-9:._-]+))? as the filter names?`)
+	operationRegexp   = regexp.MustCompile(`^((?:Describe|Get|Search)[A-Za-z0-9]+)Input$`)
+)
+
+// undocumentedFilterNames are the filter names accepted by operations whose Filters field documentation
+// doesn't list them. They are the names of the fields of the operation's results, in kebab case.
+var undocumentedFilterNames = map[string][]string{
+	"DescribeIpamPools": {
+		"address-family",
+		"allocation-default-netmask-length",
+		"allocation-max-netmask-length",
+		"allocation-min-netmask-length",
+		"auto-import",
+		"aws-service",
+		"description",
+		"ipam-arn",
+		"ipam-pool-arn",
+		"ipam-pool-id",
+		"ipam-region",
+		"ipam-scope-arn",
+		"ipam-scope-type",
+		"locale",
+		"owner-id",
+		"pool-depth",
+		"public-ip-source",
+		"publicly-advertisable",
+		"source-ipam-pool-id",
+		"state",
+		"tag-key",
+		"tag:<key>",
+	},
+	"GetIpamPoolCidrs": {
+		"cidr",
+		"ipam-pool-cidr-id",
+		"netmask-length",
+		"state",
+	},
+}
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tmain.go [flags] [<generated-file>]\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+type TemplateData struct {
+	Operations []Operation
+}
+
+type Operation struct {
+	Name        string
+	FilterNames []string
+}
+
+//go:embed file.tmpl
+var tmpl string
+
+func main() {
+	log.SetFlags(0)
+	flag.Usage = usage
+	flag.Parse()
+
+	filename := `filters_gen.go`
+	if args := flag.Args(); len(args) > 0 {
+		filename = args[0]
+	}
+
+	output, err := exec.Command("go", "list", "-m", "-f", "{{.Dir}}", sdkModule).Output()
+
+	if err != nil {
+		log.Fatalf("error locating %s: %s", sdkModule, err)
+	}
+
+	source := filepath.Join(strings.TrimSpace(string(output)), "service", "ec2", "api.go")
+	file, err := parser.ParseFile(token.NewFileSet(), source, nil, parser.ParseComments)
+
+	if err != nil {
+		log.Fatalf("error parsing %s: %s", source, err)
+	}
+
+	operations := make(map[string][]string)
+
+	for _, decl := range file.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+
+		if !ok || genDecl.Tok != token.TYPE {
+			continue
+		}
+
+		for _, spec := range genDecl.Specs {
+			typeSpec := spec.(*ast.TypeSpec)
+			m := operationRegexp.FindStringSubmatch(typeSpec.Name.Name)
+
+			if m == nil {
+				continue
+			}
+
+			structType, ok := typeSpec.Type.(*ast.StructType)
+
+			if !ok {
+				continue
+			}
+
+			for _, field := range structType.Fields.List {
+				if !isFiltersField(field) {
+					continue
+				}
+
+				operations[m[1]] = filterNames(field.Doc.Text())
+			}
+		}
+	}
+
+	for name, filterNames := range undocumentedFilterNames {
+		names, ok := operations[name]
+
+		if !ok {
+			log.Fatalf("operation %s does not accept filters", name)
+		}
+
+		if len(names) > 0 {
+			log.Fatalf("filter names of operation %s are documented, remove them from undocumentedFilterNames", name)
+		}
+
+		operations[name] = filterNames
+	}
+
+	templateData := TemplateData{}
+
+	for name, filterNames := range operations {
+		if len(filterNames) > 0 {
+			templateData.Operations = append(templateData.Operations, Operation{
+				Name:        name,
+				FilterNames: filterNames,
+			})
+		}
+	}
+
+	sort.Slice(templateData.Operations, func(i, j int) bool {
+		return templateData.Operations[i].Name < templateData.Operations[j].Name
+	})
+
+	tplate, err := template.New("filters").Parse(tmpl)
+
+	if err != nil {
+		log.Fatalf("error parsing template: %s", err)
+	}
+
+	var buffer bytes.Buffer
+	err = tplate.Execute(&buffer, templateData)
+
+	if err != nil {
+		log.Fatalf("error executing template: %s", err)
+	}
+
+	generatedFileContents, err := format.Source(buffer.Bytes())
+
+	if err != nil {
+		log.Fatalf("error formatting generated file: %s", err)
+	}
+
+	f, err := os.Create(filename)
+
+	if err != nil {
+		log.Fatalf("error creating file (%s): %s", filename, err)
+	}
+
+	defer f.Close()
+
+	_, err = f.Write(generatedFileContents)
+
+	if err != nil {
+		log.Fatalf("error writing to file (%s): %s", filename, err)
+	}
+}
+
+// isFiltersField returns whether the struct field is a "Filters" (or "Filter") field of type []*Filter.
+func isFiltersField(field *ast.Field) bool {
+	if len(field.Names) != 1 {
+		return false
+	}
+
+	if name := field.Names[0].Name; name != "Filters" && name != "Filter" {
+		return false
+	}
+
+	arrayType, ok := field.Type.(*ast.ArrayType)
+
+	if !ok {
+		return false
+	}
+
+	starExpr, ok := arrayType.Elt.(*ast.StarExpr)
+
+	if !ok {
+		return false
+	}
+
+	ident, ok := starExpr.X.(*ast.Ident)
+
+	return ok && ident.Name == "Filter"
+}
+
+// filterNames returns the sorted filter names, including aliases, documented in a Filters field's doc comment.
+func filterNames(doc string) []string {
+	var bullets []string
+	var bullet []string
+
+	flush := func() {
+		if len(bullet) > 0 {
+			bullets = append(bullets, strings.Join(bullet, " "))
+			bullet = nil
+		}
+	}
+
+	for _, line := range strings.Split(doc, "\n") {
+		line = strings.TrimSpace(line)
+
+		switch {
+		case line == "":
+			flush()
+		case strings.HasPrefix(line, "* "):
+			flush()
+			bullet = append(bullet, line)
+		case len(bullet) > 0:
+			bullet = append(bullet, line)
+		}
+	}
+	flush()
+
+	names := make(map[string]struct{})
+
+	for _, bullet := range bullets {
+		m := filterNameRegexp.FindStringSubmatch(bullet)
+
+		if m == nil {
+			continue
+		}
+
+		names[m[1]] = struct{}{}
+
+		for _, m := range filterAliasRegexp.FindAllStringSubmatch(bullet, -1) {
+			for _, alias := range m[1:] {
+				if alias = strings.TrimRight(alias, "."); alias != "" {
+					names[alias] = struct{}{}
+				}
+			}
+		}
+	}
+
+	var result []string
+	for name := range names {
+		result = append(result, name)
+	}
+	sort.Strings(result)
+
+	return result
+}
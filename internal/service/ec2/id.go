@@ -2,9 +2,11 @@ package ec2
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/importid"
 )
 
 // RouteCreateID returns a route resource ID.
@@ -28,6 +30,118 @@ func VPNGatewayVPCAttachmentCreateID(vpnGatewayID, vpcID string) string {
 	return fmt.Sprintf("vpn-attachment-%x", create.StringHashcode(fmt.Sprintf("%s-%s", vpcID, vpnGatewayID)))
 }
 
+// securityGroupRuleImportIDFormat is the format of aws_security_group_rule import IDs.
+// The last part holds one or more sources joined by the separator.
+var securityGroupRuleImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "SECURITYGROUPID", Pattern: regexp.MustCompile(`^sg-`)},
+		{Name: "TYPE", Pattern: regexp.MustCompile(`^(ingress|egress)$`)},
+		{Name: "PROTOCOL"},
+		{Name: "FROMPORT"},
+		{Name: "TOPORT"},
+		{Name: "SOURCE", Greedy: true},
+	},
+	Separator: securityGroupRuleIDSeparator,
+}
+
+var trafficMirrorFilterRuleImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "filter-id"},
+		{Name: "rule-id", Greedy: true},
+	},
+	Separator: ":",
+}
+
+var routeImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "ROUTETABLEID"},
+		{Name: "DESTINATION"},
+	},
+	Separator: "_",
+}
+
+var clientVPNNetworkAssociationImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "EndpointID"},
+		{Name: "AssociationID"},
+	},
+	Separator: ",",
+}
+
+var vpcEndpointRouteTableAssociationImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "vpc-endpoint-id"},
+		{Name: "route-table-id"},
+	},
+	Separator: "/",
+}
+
+var subnetCIDRReservationImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "SUBNET_ID"},
+		{Name: "RESERVATION_ID"},
+	},
+	Separator: ":",
+}
+
+// Organization and organizational unit ARNs contain the separator.
+var amiLaunchPermissionImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "[ACCOUNT-ID|GROUP-NAME|ORGANIZATION-ARN|ORGANIZATIONAL-UNIT-ARN]", Greedy: true},
+		{Name: "IMAGE-ID"},
+	},
+	Separator: "/",
+}
+
+var networkACLRuleImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "NETWORK_ACL_ID"},
+		{Name: "RULE_NUMBER"},
+		{Name: "PROTOCOL"},
+		{Name: "EGRESS"},
+	},
+	Separator: NetworkACLRuleImportIDSeparator,
+}
+
+var volumeAttachmentImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "DEVICE_NAME"},
+		{Name: "VOLUME_ID"},
+		{Name: "INSTANCE_ID"},
+	},
+	Separator: ":",
+}
+
+var routeTableAssociationImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "target-id"},
+		{Name: "route-table-id"},
+	},
+	Separator: "/",
+}
+
+var vpcEndpointSubnetAssociationImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "vpc-endpoint-id"},
+		{Name: "subnet-id"},
+	},
+	Separator: "/",
+}
+
+func init() {
+	importid.Register("aws_security_group_rule", securityGroupRuleImportIDFormat)
+	importid.Register("aws_ec2_traffic_mirror_filter_rule", trafficMirrorFilterRuleImportIDFormat)
+	importid.Register("aws_route", routeImportIDFormat)
+	importid.Register("aws_ec2_client_vpn_network_association", clientVPNNetworkAssociationImportIDFormat)
+	importid.Register("aws_vpc_endpoint_route_table_association", vpcEndpointRouteTableAssociationImportIDFormat)
+	importid.Register("aws_ec2_subnet_cidr_reservation", subnetCIDRReservationImportIDFormat)
+	importid.Register("aws_ami_launch_permission", amiLaunchPermissionImportIDFormat)
+	importid.Register("aws_network_acl_rule", networkACLRuleImportIDFormat)
+	importid.Register("aws_volume_attachment", volumeAttachmentImportIDFormat)
+	importid.Register("aws_route_table_association", routeTableAssociationImportIDFormat)
+	importid.Register("aws_vpc_endpoint_subnet_association", vpcEndpointSubnetAssociationImportIDFormat)
+}
+
 const vpnGatewayRoutePropagationIDSeparator = "_"
 
 func VPNGatewayRoutePropagationCreateID(routeTableID, gatewayID string) string {
@@ -19,7 +19,7 @@ func DataSourceIPAMPoolCIDRs() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
-			"filter": DataSourceFiltersSchema(),
+			"filter": DataSourceFiltersSchemaForOperation("GetIpamPoolCidrs"),
 			"ipam_pool_id": {
 				Type:     schema.TypeString,
 				Required: true,
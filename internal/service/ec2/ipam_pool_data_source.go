@@ -55,7 +55,7 @@ func DataSourceIPAMPool() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"filter": DataSourceFiltersSchema(),
+			"filter": DataSourceFiltersSchemaForOperation("DescribeIpamPools"),
 			"id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -16,7 +16,7 @@ func DataSourceIPAMPools() *schema.Resource {
 		Read: dataSourceIPAMPoolsRead,
 
 		Schema: map[string]*schema.Schema{
-			"filter": DataSourceFiltersSchema(),
+			"filter": DataSourceFiltersSchemaForOperation("DescribeIpamPools"),
 			"ipam_pools": {
 				Type:     schema.TypeSet,
 				Computed: true,
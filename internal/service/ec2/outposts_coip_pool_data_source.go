@@ -47,7 +47,7 @@ func DataSourceCoIPPool() *schema.Resource {
 
 			"tags": tftags.TagsSchemaComputed(),
 
-			"filter": CustomFiltersSchema(),
+			"filter": CustomFiltersSchemaForOperation("DescribeCoipPools"),
 		},
 	}
 }
@@ -20,7 +20,7 @@ func DataSourceCoIPPools() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
-			"filter": DataSourceFiltersSchema(),
+			"filter": DataSourceFiltersSchemaForOperation("DescribeCoipPools"),
 			"pool_ids": {
 				Type:     schema.TypeList,
 				Computed: true,
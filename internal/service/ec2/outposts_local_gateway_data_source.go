@@ -32,7 +32,7 @@ func DataSourceLocalGateway() *schema.Resource {
 				Computed: true,
 			},
 
-			"filter": CustomFiltersSchema(),
+			"filter": CustomFiltersSchemaForOperation("DescribeLocalGateways"),
 
 			"state": {
 				Type:     schema.TypeString,
@@ -47,7 +47,7 @@ func DataSourceLocalGatewayRouteTable() *schema.Resource {
 
 			"tags": tftags.TagsSchemaComputed(),
 
-			"filter": CustomFiltersSchema(),
+			"filter": CustomFiltersSchemaForOperation("DescribeLocalGatewayRouteTables"),
 		},
 	}
 }
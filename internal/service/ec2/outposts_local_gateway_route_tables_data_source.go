@@ -20,7 +20,7 @@ func DataSourceLocalGatewayRouteTables() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
-			"filter": DataSourceFiltersSchema(),
+			"filter": DataSourceFiltersSchemaForOperation("DescribeLocalGatewayRouteTables"),
 			"ids": {
 				Type:     schema.TypeList,
 				Computed: true,
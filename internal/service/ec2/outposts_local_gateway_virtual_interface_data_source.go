@@ -20,7 +20,7 @@ func DataSourceLocalGatewayVirtualInterface() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
-			"filter": CustomFiltersSchema(),
+			"filter": CustomFiltersSchemaForOperation("DescribeLocalGatewayVirtualInterfaces"),
 			"id": {
 				Type:     schema.TypeString,
 				Optional: true,
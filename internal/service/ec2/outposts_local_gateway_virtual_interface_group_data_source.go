@@ -20,7 +20,7 @@ func DataSourceLocalGatewayVirtualInterfaceGroup() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
-			"filter": CustomFiltersSchema(),
+			"filter": CustomFiltersSchemaForOperation("DescribeLocalGatewayVirtualInterfaceGroups"),
 			"id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -20,7 +20,7 @@ func DataSourceLocalGatewayVirtualInterfaceGroups() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
-			"filter": DataSourceFiltersSchema(),
+			"filter": DataSourceFiltersSchemaForOperation("DescribeLocalGatewayVirtualInterfaceGroups"),
 			"ids": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -20,7 +20,7 @@ func DataSourceLocalGateways() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
-			"filter": DataSourceFiltersSchema(),
+			"filter": DataSourceFiltersSchemaForOperation("DescribeLocalGateways"),
 			"ids": {
 				Type:     schema.TypeList,
 				Computed: true,
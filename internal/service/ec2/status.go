@@ -256,6 +256,41 @@ func StatusInstanceState(conn *ec2.EC2, id string) resource.StateRefreshFunc {
 	}
 }
 
+// StatusInstanceStatusChecks returns the combined result of an instance's instance and system status checks.
+// The result is "impaired" if either check is impaired and "ok" only once both checks have passed.
+func StatusInstanceStatusChecks(conn *ec2.EC2, id string) func(context.Context) (*ec2.InstanceStatus, string, error) {
+	return func(ctx context.Context) (*ec2.InstanceStatus, string, error) {
+		output, err := FindInstanceStatusByIDWithContext(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		var instanceStatus, systemStatus string
+		if output.InstanceStatus != nil {
+			instanceStatus = aws.StringValue(output.InstanceStatus.Status)
+		}
+		if output.SystemStatus != nil {
+			systemStatus = aws.StringValue(output.SystemStatus.Status)
+		}
+
+		switch {
+		case instanceStatus == ec2.SummaryStatusImpaired || systemStatus == ec2.SummaryStatusImpaired:
+			return output, ec2.SummaryStatusImpaired, nil
+		case instanceStatus == ec2.SummaryStatusOk && systemStatus == ec2.SummaryStatusOk:
+			return output, ec2.SummaryStatusOk, nil
+		case instanceStatus == ec2.SummaryStatusOk:
+			return output, systemStatus, nil
+		default:
+			return output, instanceStatus, nil
+		}
+	}
+}
+
 func StatusInstanceCapacityReservationSpecificationEquals(conn *ec2.EC2, id string, expectedValue *ec2.CapacityReservationSpecification) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindInstanceByID(conn, id)
@@ -875,9 +910,9 @@ func StatusVolumeAttachmentState(conn *ec2.EC2, volumeID, instanceID, deviceName
 	}
 }
 
-func StatusVolumeModificationState(conn *ec2.EC2, id string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		output, err := FindVolumeModificationByID(conn, id)
+func StatusVolumeModificationState(conn *ec2.EC2, id string) func(context.Context) (*ec2.VolumeModification, string, error) {
+	return func(ctx context.Context) (*ec2.VolumeModification, string, error) {
+		output, err := FindVolumeModificationByIDWithContext(ctx, conn, id)
 
 		if tfresource.NotFound(err) {
 			return nil, "", nil
@@ -0,0 +1,302 @@
+package ec2
+
+import (
+	"fmt"
+	"math/big"
+	"math/bits"
+	"net"
+	"regexp"
+	"strconv"
+)
+
+// subnetPlanGroup is a named subnet, optionally spread across Availability Zones.
+type subnetPlanGroup struct {
+	availabilityZones []string
+	index             *int // Index of the group's block within the parent block, in units of the block's size. nil means the first free block.
+	name              string
+	netmaskLength     int
+}
+
+// subnetPlanAllocation is the CIDR block allocated to a subnet in an Availability Zone.
+type subnetPlanAllocation struct {
+	availabilityZone string
+	cidrBlock        string
+	name             string
+}
+
+// subnetPlanBlock is an IPv4 or IPv6 CIDR block, represented as a range of addresses.
+type subnetPlanBlock struct {
+	addressBits  int // 32 or 128.
+	base         *big.Int
+	prefixLength int
+}
+
+func parseSubnetPlanBlock(cidr string) (*subnetPlanBlock, error) {
+	_, ipnet, err := net.ParseCIDR(cidr)
+
+	if err != nil {
+		return nil, err
+	}
+
+	prefixLength, addressBits := ipnet.Mask.Size()
+	ip := ipnet.IP
+
+	if addressBits == net.IPv4len*8 {
+		ip = ip.To4()
+	}
+
+	return &subnetPlanBlock{
+		addressBits:  addressBits,
+		base:         new(big.Int).SetBytes(ip),
+		prefixLength: prefixLength,
+	}, nil
+}
+
+func (b *subnetPlanBlock) size() *big.Int {
+	return new(big.Int).Lsh(big.NewInt(1), uint(b.addressBits-b.prefixLength))
+}
+
+// last returns the last address in the block.
+func (b *subnetPlanBlock) last() *big.Int {
+	v := new(big.Int).Add(b.base, b.size())
+
+	return v.Sub(v, big.NewInt(1))
+}
+
+func (b *subnetPlanBlock) overlaps(o *subnetPlanBlock) bool {
+	return b.addressBits == o.addressBits && b.base.Cmp(o.last()) <= 0 && o.base.Cmp(b.last()) <= 0
+}
+
+func (b *subnetPlanBlock) String() string {
+	ip := make(net.IP, b.addressBits/8)
+	b.base.FillBytes(ip)
+
+	ipnet := &net.IPNet{
+		IP:   ip,
+		Mask: net.CIDRMask(b.prefixLength, b.addressBits),
+	}
+
+	return ipnet.String()
+}
+
+var availabilityZoneIDSlotRegexp = regexp.MustCompile(`-az(\d+)$`)
+
+// availabilityZoneSlot returns the slot of an Availability Zone within a subnet's block.
+// The slot is derived from the zone's letter (us-west-2a is slot 0) or number (usw2-az1 is slot 0),
+// so it doesn't depend on which other Availability Zones the subnet is spread across.
+func availabilityZoneSlot(availabilityZone string) (int, error) {
+	if m := availabilityZoneIDSlotRegexp.FindStringSubmatch(availabilityZone); m != nil {
+		if n, err := strconv.Atoi(m[1]); err == nil && n > 0 {
+			return n - 1, nil
+		}
+	}
+
+	if n := len(availabilityZone); n > 0 {
+		if c := availabilityZone[n-1]; c >= 'a' && c <= 'z' {
+			return int(c - 'a'), nil
+		}
+	}
+
+	return 0, fmt.Errorf("cannot determine slot for Availability Zone (%s)", availabilityZone)
+}
+
+// planSubnets allocates CIDR blocks for the subnet groups within the parent CIDR block, avoiding the reserved CIDR blocks.
+// Each group is allocated a block large enough for `availabilityZoneSlots` subnets (or a single subnet if it isn't
+// spread across Availability Zones), and each of its subnets is allocated the slot in that block determined by its
+// Availability Zone. Groups with an index are allocated the block at that index first; the other groups are then
+// allocated the first free block, in list order. Appending a group or adding an Availability Zone to a group
+// therefore doesn't move any existing subnet, and groups with an index never move. Removing or reordering groups
+// without an index may move the groups without an index listed after them.
+func planSubnets(cidrBlock string, reservedCIDRBlocks []string, availabilityZoneSlots int, groups []subnetPlanGroup) ([]subnetPlanAllocation, error) {
+	parent, err := parseSubnetPlanBlock(cidrBlock)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var used []*subnetPlanBlock
+
+	for _, v := range reservedCIDRBlocks {
+		block, err := parseSubnetPlanBlock(v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if block.overlaps(parent) {
+			used = append(used, block)
+		}
+	}
+
+	// Number of bits needed to number the Availability Zone slots.
+	slotBits := bits.Len(uint(availabilityZoneSlots - 1))
+	names := make(map[string]struct{})
+	prefixLengths := make([]int, len(groups))
+
+	for i, group := range groups {
+		if _, ok := names[group.name]; ok {
+			return nil, fmt.Errorf("duplicate subnet name (%s)", group.name)
+		}
+		names[group.name] = struct{}{}
+
+		if group.netmaskLength < parent.prefixLength || group.netmaskLength > parent.addressBits {
+			return nil, fmt.Errorf("subnet (%s) netmask length %d must be between %d and %d", group.name, group.netmaskLength, parent.prefixLength, parent.addressBits)
+		}
+
+		groupPrefixLength := group.netmaskLength
+
+		if len(group.availabilityZones) > 0 {
+			groupPrefixLength -= slotBits
+		}
+
+		if groupPrefixLength < parent.prefixLength {
+			return nil, fmt.Errorf("subnet (%s) with %d Availability Zone slots of netmask length %d does not fit in %s", group.name, availabilityZoneSlots, group.netmaskLength, cidrBlock)
+		}
+
+		prefixLengths[i] = groupPrefixLength
+	}
+
+	blocks := make([]*subnetPlanBlock, len(groups))
+
+	// Groups with an index are allocated first so that they don't depend on the other groups.
+	for i, group := range groups {
+		if group.index == nil {
+			continue
+		}
+
+		block, err := indexedSubnetPlanBlock(parent, prefixLengths[i], *group.index)
+
+		if err != nil {
+			return nil, fmt.Errorf("subnet (%s): %w", group.name, err)
+		}
+
+		for _, v := range used {
+			if block.overlaps(v) {
+				return nil, fmt.Errorf("subnet (%s) block %s at index %d overlaps %s", group.name, block, *group.index, v)
+			}
+		}
+
+		used = append(used, block)
+		blocks[i] = block
+	}
+
+	for i, group := range groups {
+		if group.index != nil {
+			continue
+		}
+
+		block := firstFreeSubnetPlanBlock(parent, prefixLengths[i], used)
+
+		if block == nil {
+			return nil, fmt.Errorf("no space left in %s for subnet (%s)", cidrBlock, group.name)
+		}
+
+		used = append(used, block)
+		blocks[i] = block
+	}
+
+	var allocations []subnetPlanAllocation
+
+	for i, group := range groups {
+		block := blocks[i]
+
+		if len(group.availabilityZones) == 0 {
+			allocations = append(allocations, subnetPlanAllocation{
+				cidrBlock: block.String(),
+				name:      group.name,
+			})
+
+			continue
+		}
+
+		slots := make(map[int]string)
+
+		for _, availabilityZone := range group.availabilityZones {
+			slot, err := availabilityZoneSlot(availabilityZone)
+
+			if err != nil {
+				return nil, fmt.Errorf("subnet (%s): %w", group.name, err)
+			}
+
+			if slot >= availabilityZoneSlots {
+				return nil, fmt.Errorf("subnet (%s): Availability Zone (%s) slot %d exceeds the %d available slots", group.name, availabilityZone, slot, availabilityZoneSlots)
+			}
+
+			if v, ok := slots[slot]; ok {
+				return nil, fmt.Errorf("subnet (%s): Availability Zones (%s) and (%s) use the same slot", group.name, v, availabilityZone)
+			}
+			slots[slot] = availabilityZone
+
+			subnet := &subnetPlanBlock{
+				addressBits:  parent.addressBits,
+				prefixLength: group.netmaskLength,
+			}
+			subnet.base = new(big.Int).Add(block.base, new(big.Int).Mul(big.NewInt(int64(slot)), subnet.size()))
+
+			allocations = append(allocations, subnetPlanAllocation{
+				availabilityZone: availabilityZone,
+				cidrBlock:        subnet.String(),
+				name:             group.name,
+			})
+		}
+	}
+
+	return allocations, nil
+}
+
+// indexedSubnetPlanBlock returns the block of the specified prefix length at the specified index within the parent block.
+func indexedSubnetPlanBlock(parent *subnetPlanBlock, prefixLength, index int) (*subnetPlanBlock, error) {
+	block := &subnetPlanBlock{
+		addressBits:  parent.addressBits,
+		prefixLength: prefixLength,
+	}
+
+	count := new(big.Int).Lsh(big.NewInt(1), uint(prefixLength-parent.prefixLength))
+
+	if index < 0 || big.NewInt(int64(index)).Cmp(count) >= 0 {
+		return nil, fmt.Errorf("index %d must be between 0 and %s", index, new(big.Int).Sub(count, big.NewInt(1)))
+	}
+
+	block.base = new(big.Int).Add(parent.base, new(big.Int).Mul(big.NewInt(int64(index)), block.size()))
+
+	return block, nil
+}
+
+// firstFreeSubnetPlanBlock returns the lowest block of the specified prefix length within the parent block
+// that doesn't overlap any used block, or nil if there is none.
+func firstFreeSubnetPlanBlock(parent *subnetPlanBlock, prefixLength int, used []*subnetPlanBlock) *subnetPlanBlock {
+	candidate := &subnetPlanBlock{
+		addressBits:  parent.addressBits,
+		base:         new(big.Int).Set(parent.base),
+		prefixLength: prefixLength,
+	}
+	size := candidate.size()
+	parentLast := parent.last()
+
+	for candidate.last().Cmp(parentLast) <= 0 {
+		var overlapping *subnetPlanBlock
+
+		for _, v := range used {
+			if candidate.overlaps(v) {
+				overlapping = v
+				break
+			}
+		}
+
+		if overlapping == nil {
+			return candidate
+		}
+
+		// Skip to the first aligned block after the overlapping block.
+		next := new(big.Int).Add(overlapping.last(), big.NewInt(1))
+		remainder := new(big.Int).Mod(next, size)
+
+		if remainder.Sign() != 0 {
+			next.Add(next, new(big.Int).Sub(size, remainder))
+		}
+
+		candidate.base = next
+	}
+
+	return nil
+}
@@ -0,0 +1,233 @@
+package ec2
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+)
+
+func TestPlanSubnets(t *testing.T) {
+	testCases := []struct {
+		TestName              string
+		CIDRBlock             string
+		ReservedCIDRBlocks    []string
+		AvailabilityZoneSlots int
+		Groups                []subnetPlanGroup
+		Expected              []string
+		ExpectError           bool
+	}{
+		{
+			TestName:              "single subnet",
+			CIDRBlock:             "10.0.0.0/16",
+			AvailabilityZoneSlots: 4,
+			Groups: []subnetPlanGroup{
+				{name: "a", netmaskLength: 24},
+			},
+			Expected: []string{"10.0.0.0/24"},
+		},
+		{
+			TestName:              "availability zone slots",
+			CIDRBlock:             "10.0.0.0/16",
+			AvailabilityZoneSlots: 4,
+			Groups: []subnetPlanGroup{
+				{name: "public", netmaskLength: 24, availabilityZones: []string{"us-west-2a", "us-west-2c"}},
+				{name: "private", netmaskLength: 20, availabilityZones: []string{"usw2-az2", "usw2-az1"}},
+			},
+			Expected: []string{"10.0.0.0/24", "10.0.2.0/24", "10.0.80.0/20", "10.0.64.0/20"},
+		},
+		{
+			TestName:              "packed",
+			CIDRBlock:             "10.0.0.0/16",
+			AvailabilityZoneSlots: 4,
+			Groups: []subnetPlanGroup{
+				{name: "small", netmaskLength: 26},
+				{name: "large", netmaskLength: 24},
+				{name: "small2", netmaskLength: 26},
+			},
+			Expected: []string{"10.0.0.0/26", "10.0.1.0/24", "10.0.0.64/26"},
+		},
+		{
+			TestName:              "reserved",
+			CIDRBlock:             "10.0.0.0/16",
+			ReservedCIDRBlocks:    []string{"10.0.0.0/23", "192.168.0.0/16"},
+			AvailabilityZoneSlots: 4,
+			Groups: []subnetPlanGroup{
+				{name: "a", netmaskLength: 24},
+			},
+			Expected: []string{"10.0.2.0/24"},
+		},
+		{
+			TestName:              "IPv6",
+			CIDRBlock:             "2001:db8::/56",
+			AvailabilityZoneSlots: 2,
+			Groups: []subnetPlanGroup{
+				{name: "a", netmaskLength: 64, availabilityZones: []string{"us-west-2b"}},
+				{name: "b", netmaskLength: 64},
+			},
+			Expected: []string{"2001:db8:0:1::/64", "2001:db8:0:2::/64"},
+		},
+		{
+			TestName:              "index",
+			CIDRBlock:             "10.0.0.0/16",
+			AvailabilityZoneSlots: 4,
+			Groups: []subnetPlanGroup{
+				{name: "a", netmaskLength: 24},
+				{name: "b", netmaskLength: 24, index: aws.Int(0)},
+				{name: "c", netmaskLength: 24, availabilityZones: []string{"us-west-2b"}, index: aws.Int(1)},
+			},
+			Expected: []string{"10.0.1.0/24", "10.0.0.0/24", "10.0.5.0/24"},
+		},
+		{
+			TestName:              "index out of range",
+			CIDRBlock:             "10.0.0.0/16",
+			AvailabilityZoneSlots: 4,
+			Groups: []subnetPlanGroup{
+				{name: "a", netmaskLength: 24, index: aws.Int(256)},
+			},
+			ExpectError: true,
+		},
+		{
+			TestName:              "index overlaps",
+			CIDRBlock:             "10.0.0.0/16",
+			ReservedCIDRBlocks:    []string{"10.0.0.0/23"},
+			AvailabilityZoneSlots: 4,
+			Groups: []subnetPlanGroup{
+				{name: "a", netmaskLength: 24, index: aws.Int(1)},
+			},
+			ExpectError: true,
+		},
+		{
+			TestName:              "no space",
+			CIDRBlock:             "10.0.0.0/24",
+			AvailabilityZoneSlots: 4,
+			Groups: []subnetPlanGroup{
+				{name: "a", netmaskLength: 25},
+				{name: "b", netmaskLength: 25},
+				{name: "c", netmaskLength: 25},
+			},
+			ExpectError: true,
+		},
+		{
+			TestName:              "duplicate name",
+			CIDRBlock:             "10.0.0.0/16",
+			AvailabilityZoneSlots: 4,
+			Groups: []subnetPlanGroup{
+				{name: "a", netmaskLength: 24},
+				{name: "a", netmaskLength: 24},
+			},
+			ExpectError: true,
+		},
+		{
+			TestName:              "slot out of range",
+			CIDRBlock:             "10.0.0.0/16",
+			AvailabilityZoneSlots: 4,
+			Groups: []subnetPlanGroup{
+				{name: "a", netmaskLength: 24, availabilityZones: []string{"us-east-1e"}},
+			},
+			ExpectError: true,
+		},
+		{
+			TestName:              "same slot",
+			CIDRBlock:             "10.0.0.0/16",
+			AvailabilityZoneSlots: 4,
+			Groups: []subnetPlanGroup{
+				{name: "a", netmaskLength: 24, availabilityZones: []string{"us-east-1a", "use1-az1"}},
+			},
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			allocations, err := planSubnets(testCase.CIDRBlock, testCase.ReservedCIDRBlocks, testCase.AvailabilityZoneSlots, testCase.Groups)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatal("expected error, got none")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if testCase.ExpectError {
+				return
+			}
+
+			var got []string
+			for _, v := range allocations {
+				got = append(got, v.cidrBlock)
+			}
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %v, expected %v", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestPlanSubnetsStable(t *testing.T) {
+	before, err := planSubnets("10.0.0.0/16", nil, 4, []subnetPlanGroup{
+		{name: "public", netmaskLength: 24, availabilityZones: []string{"us-west-2a", "us-west-2b"}},
+		{name: "private", netmaskLength: 20, availabilityZones: []string{"us-west-2a", "us-west-2b"}},
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Add an Availability Zone and append a subnet.
+	after, err := planSubnets("10.0.0.0/16", nil, 4, []subnetPlanGroup{
+		{name: "public", netmaskLength: 24, availabilityZones: []string{"us-west-2a", "us-west-2b", "us-west-2c"}},
+		{name: "private", netmaskLength: 20, availabilityZones: []string{"us-west-2a", "us-west-2b", "us-west-2c"}},
+		{name: "database", netmaskLength: 26},
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	allocated := make(map[subnetPlanAllocation]bool)
+	for _, v := range after {
+		allocated[v] = true
+	}
+
+	for _, v := range before {
+		if !allocated[v] {
+			t.Errorf("subnet %s in %s was renumbered", v.name, v.availabilityZone)
+		}
+	}
+}
+
+func TestPlanSubnetsStableIndex(t *testing.T) {
+	before, err := planSubnets("10.0.0.0/16", nil, 4, []subnetPlanGroup{
+		{name: "public", netmaskLength: 24, availabilityZones: []string{"us-west-2a", "us-west-2b"}, index: aws.Int(0)},
+		{name: "private", netmaskLength: 20, availabilityZones: []string{"us-west-2a", "us-west-2b"}, index: aws.Int(1)},
+		{name: "database", netmaskLength: 26, index: aws.Int(64)},
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Remove a subnet and reorder the others.
+	after, err := planSubnets("10.0.0.0/16", nil, 4, []subnetPlanGroup{
+		{name: "database", netmaskLength: 26, index: aws.Int(64)},
+		{name: "private", netmaskLength: 20, availabilityZones: []string{"us-west-2a", "us-west-2b"}, index: aws.Int(1)},
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	allocated := make(map[subnetPlanAllocation]bool)
+	for _, v := range before {
+		allocated[v] = true
+	}
+
+	for _, v := range after {
+		if !allocated[v] {
+			t.Errorf("subnet %s in %s was renumbered", v.name, v.availabilityZone)
+		}
+	}
+}
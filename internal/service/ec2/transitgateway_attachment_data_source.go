@@ -21,7 +21,7 @@ func DataSourceTransitGatewayAttachment() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"filter": CustomFiltersSchema(),
+			"filter": CustomFiltersSchemaForOperation("DescribeTransitGatewayAttachments"),
 			"resource_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -22,7 +22,7 @@ func DataSourceTransitGatewayConnect() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
-			"filter": DataSourceFiltersSchema(),
+			"filter": DataSourceFiltersSchemaForOperation("DescribeTransitGatewayConnects"),
 			"protocol": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -33,7 +33,7 @@ func DataSourceTransitGatewayConnectPeer() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"filter": DataSourceFiltersSchema(),
+			"filter": DataSourceFiltersSchemaForOperation("DescribeTransitGatewayConnectPeers"),
 			"inside_cidr_blocks": {
 				Type:     schema.TypeList,
 				Computed: true,
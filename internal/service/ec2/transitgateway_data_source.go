@@ -53,7 +53,7 @@ func DataSourceTransitGateway() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"filter": CustomFiltersSchema(),
+			"filter": CustomFiltersSchemaForOperation("DescribeTransitGateways"),
 			"id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -46,7 +46,7 @@ func DataSourceTransitGatewayMulticastDomain() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"filter": DataSourceFiltersSchema(),
+			"filter": DataSourceFiltersSchemaForOperation("DescribeTransitGatewayMulticastDomains"),
 			"igmpv2_support": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -34,7 +34,7 @@ func DataSourceTransitGatewayRouteTable() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
-			"filter": CustomFiltersSchema(),
+			"filter": CustomFiltersSchemaForOperation("DescribeTransitGatewayRouteTables"),
 			"id": {
 				Type:     schema.TypeString,
 				Optional: true,
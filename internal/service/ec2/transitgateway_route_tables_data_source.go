@@ -20,7 +20,7 @@ func DataSourceTransitGatewayRouteTables() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
-			"filter": DataSourceFiltersSchema(),
+			"filter": DataSourceFiltersSchemaForOperation("DescribeTransitGatewayRouteTables"),
 			"ids": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -29,7 +29,7 @@ func DataSourceTransitGatewayVPCAttachment() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"filter": CustomFiltersSchema(),
+			"filter": CustomFiltersSchemaForOperation("DescribeTransitGatewayVpcAttachments"),
 			"id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -20,7 +20,7 @@ func DataSourceTransitGatewayVPCAttachments() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
-			"filter": DataSourceFiltersSchema(),
+			"filter": DataSourceFiltersSchemaForOperation("DescribeTransitGatewayVpcAttachments"),
 			"ids": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -5,6 +5,10 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func validSecurityGroupRuleDescription(v interface{}, k string) (ws []string, errors []error) {
@@ -64,3 +68,148 @@ func validAmazonSideASN(v interface{}, k string) (ws []string, errors []error) {
 	}
 	return
 }
+
+const (
+	filterNameTagPrefix = "tag:"
+	filterNameTagKey    = filterNameTagPrefix + "<key>"
+)
+
+// validFilterName returns a validation function for the name of a filter passed to the specified EC2 API operation.
+// Names not documented for the operation are reported as warnings, never errors, as the documentation may lag the API.
+// Names that are close to a documented filter name include a suggestion.
+// "tag:<key>" filters are valid for any non-empty tag key if the operation supports them.
+func validFilterName(operation string) schema.SchemaValidateDiagFunc {
+	return func(v interface{}, path cty.Path) diag.Diagnostics {
+		name, ok := v.(string)
+
+		if !ok {
+			return nil
+		}
+
+		names, ok := filterNames[operation]
+
+		if !ok {
+			return nil
+		}
+
+		var diags diag.Diagnostics
+
+		if key := strings.TrimPrefix(name, filterNameTagPrefix); key != name {
+			switch {
+			case key == "":
+				diags = append(diags, diag.Diagnostic{
+					Severity:      diag.Warning,
+					Summary:       "Unknown filter name",
+					Detail:        fmt.Sprintf("filter name %q is missing the tag key, e.g. \"tag:Name\"", name),
+					AttributePath: path,
+				})
+			case !filterNameValid(filterNameTagKey, names):
+				diags = append(diags, diag.Diagnostic{
+					Severity:      diag.Warning,
+					Summary:       "Unknown filter name",
+					Detail:        fmt.Sprintf("%s does not support %q filters, valid filter names are: %s", operation, filterNameTagKey, strings.Join(names, ", ")),
+					AttributePath: path,
+				})
+			}
+
+			return diags
+		}
+
+		if filterNameValid(name, names) {
+			return nil
+		}
+
+		if suggestion := filterNameSuggestion(name, names); suggestion != "" {
+			return append(diags, diag.Diagnostic{
+				Severity:      diag.Warning,
+				Summary:       "Unknown filter name",
+				Detail:        fmt.Sprintf("filter name %q is not valid for %s, did you mean %q?", name, operation, suggestion),
+				AttributePath: path,
+			})
+		}
+
+		return append(diags, diag.Diagnostic{
+			Severity:      diag.Warning,
+			Summary:       "Unknown filter name",
+			Detail:        fmt.Sprintf("filter name %q is not documented for %s and may return no results, valid filter names are: %s", name, operation, strings.Join(names, ", ")),
+			AttributePath: path,
+		})
+	}
+}
+
+// filterNameValid returns whether the filter name is one of the valid names.
+// Names are compared ignoring case and punctuation, as EC2 accepts both "availability-zone" and "availabilityZone".
+func filterNameValid(name string, names []string) bool {
+	name = normalizeFilterName(name)
+
+	for _, v := range names {
+		if normalizeFilterName(v) == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// filterNameSuggestion returns the valid name closest to the filter name, or "" if none is close enough to be a likely typo.
+func filterNameSuggestion(name string, names []string) string {
+	var suggestion string
+	minDistance := len(name)/3 + 1
+
+	for _, v := range names {
+		if v == filterNameTagKey {
+			continue
+		}
+
+		if d := levenshteinDistance(strings.ToLower(name), strings.ToLower(v)); d < minDistance {
+			suggestion = v
+			minDistance = d
+		}
+	}
+
+	return suggestion
+}
+
+func normalizeFilterName(name string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '-', '_':
+			return -1
+		}
+
+		return r
+	}, strings.ToLower(name))
+}
+
+// levenshteinDistance returns the number of single character edits needed to change a into b.
+func levenshteinDistance(a, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+
+			current[j] = previous[j-1] + cost
+			if v := previous[j] + 1; v < current[j] {
+				current[j] = v
+			}
+			if v := current[j-1] + 1; v < current[j] {
+				current[j] = v
+			}
+		}
+
+		previous, current = current, previous
+	}
+
+	return previous[len(b)]
+}
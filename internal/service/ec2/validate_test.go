@@ -1,7 +1,15 @@
 package ec2
 
 import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strconv"
+	"strings"
 	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 )
 
 func TestValidSecurityGroupRuleDescription(t *testing.T) {
@@ -74,3 +82,166 @@ func TestValidAmazonSideASN(t *testing.T) {
 		}
 	}
 }
+
+func TestValidFilterName(t *testing.T) {
+	testCases := []struct {
+		TestName        string
+		Operation       string
+		Name            string
+		ExpectedError   string
+		ExpectedWarning string
+	}{
+		{
+			TestName:  "valid",
+			Operation: "DescribeSubnets",
+			Name:      "availability-zone",
+		},
+		{
+			TestName:  "valid alias",
+			Operation: "DescribeSubnets",
+			Name:      "availabilityZone",
+		},
+		{
+			TestName:  "valid tag",
+			Operation: "DescribeSubnets",
+			Name:      "tag:Name",
+		},
+		{
+			TestName:  "unknown operation",
+			Operation: "DescribeWidgets",
+			Name:      "widget-id",
+		},
+		{
+			TestName:        "typo",
+			Operation:       "DescribeSubnets",
+			Name:            "availability-zones",
+			ExpectedWarning: `did you mean "availability-zone"?`,
+		},
+		{
+			TestName:        "empty tag key",
+			Operation:       "DescribeSubnets",
+			Name:            "tag:",
+			ExpectedWarning: "missing the tag key",
+		},
+		{
+			TestName:        "tags not supported",
+			Operation:       "DescribePrefixLists",
+			Name:            "tag:Name",
+			ExpectedWarning: `DescribePrefixLists does not support "tag:<key>" filters`,
+		},
+		{
+			TestName:        "unknown",
+			Operation:       "DescribeSubnets",
+			Name:            "instance-type",
+			ExpectedWarning: `filter name "instance-type" is not documented for DescribeSubnets`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			diags := validFilterName(testCase.Operation)(testCase.Name, cty.Path{})
+
+			var errs, warnings []string
+			for _, d := range diags {
+				if d.Severity == diag.Error {
+					errs = append(errs, d.Detail)
+				} else {
+					warnings = append(warnings, d.Detail)
+				}
+			}
+
+			testCheckFilterNameDiagnostics(t, errs, testCase.ExpectedError)
+			testCheckFilterNameDiagnostics(t, warnings, testCase.ExpectedWarning)
+		})
+	}
+}
+
+func testCheckFilterNameDiagnostics(t *testing.T, got []string, expected string) {
+	t.Helper()
+
+	if expected == "" {
+		if len(got) > 0 {
+			t.Errorf("unexpected diagnostics: %v", got)
+		}
+
+		return
+	}
+
+	if len(got) != 1 || !strings.Contains(got[0], expected) {
+		t.Errorf("got %v, expected %q", got, expected)
+	}
+}
+
+// TestFilterNamesOperations verifies that every operation whose filter names are validated has generated filter names,
+// as validFilterName accepts any name for an unknown operation.
+func TestFilterNamesOperations(t *testing.T) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, ".", nil, 0)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var n int
+
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			ast.Inspect(file, func(node ast.Node) bool {
+				call, ok := node.(*ast.CallExpr)
+
+				if !ok || len(call.Args) != 1 {
+					return true
+				}
+
+				ident, ok := call.Fun.(*ast.Ident)
+
+				if !ok || !strings.HasSuffix(ident.Name, "SchemaForOperation") {
+					return true
+				}
+
+				lit, ok := call.Args[0].(*ast.BasicLit)
+
+				if !ok || lit.Kind != token.STRING {
+					return true
+				}
+
+				operation, err := strconv.Unquote(lit.Value)
+
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				n++
+
+				if _, ok := filterNames[operation]; !ok {
+					t.Errorf("%s: operation %s has no filter names, add it to generate/filters/main.go", fset.Position(call.Pos()), operation)
+				}
+
+				return true
+			})
+		}
+	}
+
+	if n == 0 {
+		t.Fatal("no operations found")
+	}
+}
+
+func TestLevenshteinDistance(t *testing.T) {
+	testCases := []struct {
+		A, B     string
+		Expected int
+	}{
+		{"", "", 0},
+		{"", "abc", 3},
+		{"tag-key", "tag-key", 0},
+		{"tag-keys", "tag-key", 1},
+		{"kitten", "sitting", 3},
+	}
+
+	for _, testCase := range testCases {
+		if got := levenshteinDistance(testCase.A, testCase.B); got != testCase.Expected {
+			t.Errorf("levenshteinDistance(%q, %q) = %d, expected %d", testCase.A, testCase.B, got, testCase.Expected)
+		}
+	}
+}
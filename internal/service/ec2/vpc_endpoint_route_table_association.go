@@ -3,7 +3,6 @@ package ec2
 import (
 	"fmt"
 	"log"
-	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
@@ -125,9 +124,9 @@ func resourceVPCEndpointRouteTableAssociationDelete(d *schema.ResourceData, meta
 }
 
 func resourceVPCEndpointRouteTableAssociationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	parts := strings.Split(d.Id(), "/")
-	if len(parts) != 2 {
-		return nil, fmt.Errorf("wrong format of import ID (%s), use: 'vpc-endpoint-id/route-table-id'", d.Id())
+	parts, err := vpcEndpointRouteTableAssociationImportIDFormat.Parse(d.Id())
+	if err != nil {
+		return nil, err
 	}
 
 	endpointID := parts[0]
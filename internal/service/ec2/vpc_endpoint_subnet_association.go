@@ -3,7 +3,6 @@ package ec2
 import (
 	"fmt"
 	"log"
-	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -149,9 +148,9 @@ func resourceVPCEndpointSubnetAssociationDelete(d *schema.ResourceData, meta int
 }
 
 func resourceVPCEndpointSubnetAssociationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	parts := strings.Split(d.Id(), "/")
-	if len(parts) != 2 {
-		return nil, fmt.Errorf("wrong format of import ID (%s), use: 'vpc-endpoint-id/subnet-id'", d.Id())
+	parts, err := vpcEndpointSubnetAssociationImportIDFormat.Parse(d.Id())
+	if err != nil {
+		return nil, err
 	}
 
 	endpointID := parts[0]
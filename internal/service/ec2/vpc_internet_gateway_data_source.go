@@ -42,7 +42,7 @@ func DataSourceInternetGateway() *schema.Resource {
 					},
 				},
 			},
-			"filter": CustomFiltersSchema(),
+			"filter": CustomFiltersSchemaForOperation("DescribeInternetGateways"),
 			"internet_gateway_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -52,7 +52,8 @@ func DataSourceInternetGateway() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"tags": tftags.TagsSchemaComputed(),
+			"tag_filter": TagFiltersSchema(),
+			"tags":       tftags.TagsSchemaComputed(),
 		},
 	}
 }
@@ -64,9 +65,10 @@ func dataSourceInternetGatewayRead(d *schema.ResourceData, meta interface{}) err
 	internetGatewayId, internetGatewayIdOk := d.GetOk("internet_gateway_id")
 	tags, tagsOk := d.GetOk("tags")
 	filter, filterOk := d.GetOk("filter")
+	tagFilter, tagFilterOk := d.GetOk("tag_filter")
 
-	if !internetGatewayIdOk && !filterOk && !tagsOk {
-		return fmt.Errorf("One of internet_gateway_id or filter or tags must be assigned")
+	if !internetGatewayIdOk && !filterOk && !tagsOk && !tagFilterOk {
+		return fmt.Errorf("One of internet_gateway_id or filter or tags or tag_filter must be assigned")
 	}
 
 	input := &ec2.DescribeInternetGatewaysInput{}
@@ -79,6 +81,9 @@ func dataSourceInternetGatewayRead(d *schema.ResourceData, meta interface{}) err
 	input.Filters = append(input.Filters, BuildCustomFilterList(
 		filter.(*schema.Set),
 	)...)
+	input.Filters = append(input.Filters, BuildTagFiltersList(
+		tagFilter.(*schema.Set),
+	)...)
 
 	igw, err := FindInternetGateway(conn, input)
 
@@ -46,7 +46,7 @@ func DataSourceManagedPrefixList() *schema.Resource {
 					},
 				},
 			},
-			"filter": CustomFiltersSchema(),
+			"filter": CustomFiltersSchemaForOperation("DescribeManagedPrefixLists"),
 			"id": {
 				Type:     schema.TypeString,
 				Computed: true,
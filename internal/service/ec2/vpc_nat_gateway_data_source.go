@@ -29,7 +29,7 @@ func DataSourceNATGateway() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"filter": CustomFiltersSchema(),
+			"filter": CustomFiltersSchemaForOperation("DescribeNatGateways"),
 			"id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -57,7 +57,8 @@ func DataSourceNATGateway() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
-			"tags": tftags.TagsSchemaComputed(),
+			"tag_filter": TagFiltersSchema(),
+			"tags":       tftags.TagsSchemaComputed(),
 			"vpc_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -94,6 +95,11 @@ func dataSourceNATGatewayRead(d *schema.ResourceData, meta interface{}) error {
 	input.Filter = append(input.Filter, BuildCustomFilterList(
 		d.Get("filter").(*schema.Set),
 	)...)
+
+	input.Filter = append(input.Filter, BuildTagFiltersList(
+		d.Get("tag_filter").(*schema.Set),
+	)...)
+
 	if len(input.Filter) == 0 {
 		// Don't send an empty filters list; the EC2 API won't accept it.
 		input.Filter = nil
@@ -244,10 +244,10 @@ func resourceNetworkACLRuleDelete(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceNetworkACLRuleImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	parts := strings.Split(d.Id(), NetworkACLRuleImportIDSeparator)
+	parts, err := networkACLRuleImportIDFormat.Parse(d.Id())
 
-	if len(parts) != 4 || parts[0] == "" || parts[1] == "" || parts[2] == "" || parts[3] == "" {
-		return nil, fmt.Errorf("unexpected format of ID (%[1]s), expected NETWORK_ACL_ID%[2]sRULE_NUMBER%[2]sPROTOCOL%[2]sEGRESS", d.Id(), NetworkACLRuleImportIDSeparator)
+	if err != nil {
+		return nil, err
 	}
 
 	naclID := parts[0]
@@ -39,7 +39,7 @@ func DataSourceNetworkInsightsAnalysis() *schema.Resource {
 				Computed: true,
 			},
 			"explanations": networkInsightsAnalysisExplanationsSchema,
-			"filter":       CustomFiltersSchema(),
+			"filter":       CustomFiltersSchemaForOperation("DescribeNetworkInsightsAnalyses"),
 			"filter_in_arns": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -33,7 +33,7 @@ func DataSourceNetworkInsightsPath() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
-			"filter": CustomFiltersSchema(),
+			"filter": CustomFiltersSchemaForOperation("DescribeNetworkInsightsPaths"),
 			"network_insights_path_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -94,7 +94,7 @@ func DataSourceNetworkInterface() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"filter": DataSourceFiltersSchema(),
+			"filter": DataSourceFiltersSchemaForOperation("DescribeNetworkInterfaces"),
 			"id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -147,7 +147,8 @@ func DataSourceNetworkInterface() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"tags": tftags.TagsSchemaComputed(),
+			"tag_filter": TagFiltersSchema(),
+			"tags":       tftags.TagsSchemaComputed(),
 			"vpc_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -166,6 +167,10 @@ func dataSourceNetworkInterfaceRead(d *schema.ResourceData, meta interface{}) er
 		input.Filters = BuildFiltersDataSource(v.(*schema.Set))
 	}
 
+	if v, ok := d.GetOk("tag_filter"); ok {
+		input.Filters = append(input.Filters, BuildTagFiltersList(v.(*schema.Set))...)
+	}
+
 	if v, ok := d.GetOk("id"); ok {
 		input.NetworkInterfaceIds = []*string{aws.String(v.(string))}
 	}
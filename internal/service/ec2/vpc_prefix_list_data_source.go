@@ -24,7 +24,7 @@ func DataSourcePrefixList() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
-			"filter": CustomFiltersSchema(),
+			"filter": CustomFiltersSchemaForOperation("DescribePrefixLists"),
 			"name": {
 				Type:     schema.TypeString,
 				Optional: true,
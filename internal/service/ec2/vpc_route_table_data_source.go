@@ -38,12 +38,13 @@ func DataSourceRouteTable() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"tag_filter": TagFiltersSchema(),
 			"vpc_id": {
 				Type:     schema.TypeString,
 				Optional: true,
 				Computed: true,
 			},
-			"filter": CustomFiltersSchema(),
+			"filter": CustomFiltersSchemaForOperation("DescribeRouteTables"),
 			"tags":   tftags.TagsSchemaComputed(),
 			"routes": {
 				Type:     schema.TypeList,
@@ -181,9 +182,10 @@ func dataSourceRouteTableRead(d *schema.ResourceData, meta interface{}) error {
 	rtbId, rtbOk := d.GetOk("route_table_id")
 	tags, tagsOk := d.GetOk("tags")
 	filter, filterOk := d.GetOk("filter")
+	tagFilter, tagFilterOk := d.GetOk("tag_filter")
 
-	if !rtbOk && !vpcIdOk && !subnetIdOk && !gatewayIdOk && !filterOk && !tagsOk {
-		return fmt.Errorf("one of route_table_id, vpc_id, subnet_id, gateway_id, filters, or tags must be assigned")
+	if !rtbOk && !vpcIdOk && !subnetIdOk && !gatewayIdOk && !filterOk && !tagsOk && !tagFilterOk {
+		return fmt.Errorf("one of route_table_id, vpc_id, subnet_id, gateway_id, filters, tags, or tag_filter must be assigned")
 	}
 	req.Filters = BuildAttributeFilterList(
 		map[string]string{
@@ -199,6 +201,9 @@ func dataSourceRouteTableRead(d *schema.ResourceData, meta interface{}) error {
 	req.Filters = append(req.Filters, BuildCustomFilterList(
 		filter.(*schema.Set),
 	)...)
+	req.Filters = append(req.Filters, BuildTagFiltersList(
+		tagFilter.(*schema.Set),
+	)...)
 
 	log.Printf("[DEBUG] Reading Route Table: %s", req)
 	resp, err := conn.DescribeRouteTables(req)
@@ -0,0 +1,43 @@
+package ec2
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceSecurityGroupEgressRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSecurityGroupEgressRuleCreate,
+		Read:   resourceSecurityGroupEgressRuleRead,
+		Update: resourceSecurityGroupEgressRuleUpdate,
+		Delete: resourceSecurityGroupEgressRuleDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: securityGroupRuleSchema(),
+	}
+}
+
+func resourceSecurityGroupEgressRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	return createSecurityGroupRule(d, meta, true)
+}
+
+func resourceSecurityGroupEgressRuleRead(d *schema.ResourceData, meta interface{}) error {
+	return readSecurityGroupRule(d, meta, true)
+}
+
+func resourceSecurityGroupEgressRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	if err := updateSecurityGroupRule(d, meta); err != nil {
+		return err
+	}
+
+	return resourceSecurityGroupEgressRuleRead(d, meta)
+}
+
+func resourceSecurityGroupEgressRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	return deleteSecurityGroupRule(d, meta, true)
+}
@@ -0,0 +1,383 @@
+package ec2
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceSecurityGroupIngressRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSecurityGroupIngressRuleCreate,
+		Read:   resourceSecurityGroupIngressRuleRead,
+		Update: resourceSecurityGroupIngressRuleUpdate,
+		Delete: resourceSecurityGroupIngressRuleDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: securityGroupRuleSchema(),
+	}
+}
+
+func resourceSecurityGroupIngressRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	return createSecurityGroupRule(d, meta, false)
+}
+
+func resourceSecurityGroupIngressRuleRead(d *schema.ResourceData, meta interface{}) error {
+	return readSecurityGroupRule(d, meta, false)
+}
+
+func resourceSecurityGroupIngressRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	if err := updateSecurityGroupRule(d, meta); err != nil {
+		return err
+	}
+
+	return resourceSecurityGroupIngressRuleRead(d, meta)
+}
+
+func resourceSecurityGroupIngressRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	return deleteSecurityGroupRule(d, meta, false)
+}
+
+// securityGroupRuleSchema returns the schema shared by the aws_vpc_security_group_ingress_rule
+// and aws_vpc_security_group_egress_rule resources. Each resource manages exactly one
+// security group rule (sgr-*) with a single source or destination.
+func securityGroupRuleSchema() map[string]*schema.Schema {
+	targets := []string{"cidr_ipv4", "cidr_ipv6", "prefix_list_id", "referenced_security_group_id"}
+
+	return map[string]*schema.Schema{
+		"arn": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"cidr_ipv4": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: verify.ValidIPv4CIDRNetworkAddress,
+			ExactlyOneOf: targets,
+		},
+		"cidr_ipv6": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: verify.ValidIPv6CIDRNetworkAddress,
+			ExactlyOneOf: targets,
+		},
+		"description": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validSecurityGroupRuleDescription,
+		},
+		"from_port": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntBetween(-1, 65535),
+		},
+		"ip_protocol": {
+			Type:      schema.TypeString,
+			Required:  true,
+			StateFunc: ProtocolStateFunc,
+		},
+		"prefix_list_id": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ExactlyOneOf: targets,
+		},
+		"referenced_security_group_id": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ExactlyOneOf: targets,
+		},
+		"security_group_id": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"security_group_rule_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"tags":     tftags.TagsSchema(),
+		"tags_all": tftags.TagsSchemaComputed(),
+		"to_port": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntBetween(-1, 65535),
+		},
+	}
+}
+
+func securityGroupRuleTypeName(isEgress bool) string {
+	if isEgress {
+		return "egress"
+	}
+
+	return "ingress"
+}
+
+func createSecurityGroupRule(d *schema.ResourceData, meta interface{}, isEgress bool) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	securityGroupID := d.Get("security_group_id").(string)
+	ipPermission := expandSecurityGroupRuleIPPermission(d)
+	tagSpecifications := tagSpecificationsFromKeyValueTags(tags, ec2.ResourceTypeSecurityGroupRule)
+
+	var rules []*ec2.SecurityGroupRule
+
+	if isEgress {
+		output, err := conn.AuthorizeSecurityGroupEgress(&ec2.AuthorizeSecurityGroupEgressInput{
+			GroupId:           aws.String(securityGroupID),
+			IpPermissions:     []*ec2.IpPermission{ipPermission},
+			TagSpecifications: tagSpecifications,
+		})
+
+		if err != nil {
+			return fmt.Errorf("authorizing VPC Security Group (%s) egress rule: %w", securityGroupID, err)
+		}
+
+		rules = output.SecurityGroupRules
+	} else {
+		output, err := conn.AuthorizeSecurityGroupIngress(&ec2.AuthorizeSecurityGroupIngressInput{
+			GroupId:           aws.String(securityGroupID),
+			IpPermissions:     []*ec2.IpPermission{ipPermission},
+			TagSpecifications: tagSpecifications,
+		})
+
+		if err != nil {
+			return fmt.Errorf("authorizing VPC Security Group (%s) ingress rule: %w", securityGroupID, err)
+		}
+
+		rules = output.SecurityGroupRules
+	}
+
+	if len(rules) != 1 || rules[0] == nil {
+		return fmt.Errorf("authorizing VPC Security Group (%s) %s rule: expected 1 rule, got %d", securityGroupID, securityGroupRuleTypeName(isEgress), len(rules))
+	}
+
+	d.SetId(aws.StringValue(rules[0].SecurityGroupRuleId))
+
+	return readSecurityGroupRule(d, meta, isEgress)
+}
+
+func readSecurityGroupRule(d *schema.ResourceData, meta interface{}, isEgress bool) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(propagationTimeout, func() (interface{}, error) {
+		return FindSecurityGroupRuleByID(conn, d.Id())
+	}, d.IsNewResource())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] VPC Security Group Rule %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading VPC Security Group Rule (%s): %w", d.Id(), err)
+	}
+
+	rule := outputRaw.(*ec2.SecurityGroupRule)
+
+	if aws.BoolValue(rule.IsEgress) != isEgress {
+		return fmt.Errorf("VPC Security Group Rule (%s) is an %s rule, not an %s rule", d.Id(), securityGroupRuleTypeName(aws.BoolValue(rule.IsEgress)), securityGroupRuleTypeName(isEgress))
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Service:   ec2.ServiceName,
+		Region:    meta.(*conns.AWSClient).Region,
+		AccountID: aws.StringValue(rule.GroupOwnerId),
+		Resource:  fmt.Sprintf("security-group-rule/%s", d.Id()),
+	}.String()
+	d.Set("arn", arn)
+	d.Set("cidr_ipv4", rule.CidrIpv4)
+	d.Set("cidr_ipv6", rule.CidrIpv6)
+	d.Set("description", rule.Description)
+	d.Set("ip_protocol", rule.IpProtocol)
+	d.Set("prefix_list_id", rule.PrefixListId)
+	if rule.ReferencedGroupInfo != nil {
+		d.Set("referenced_security_group_id", rule.ReferencedGroupInfo.GroupId)
+	} else {
+		d.Set("referenced_security_group_id", nil)
+	}
+	d.Set("security_group_id", rule.GroupId)
+	d.Set("security_group_rule_id", rule.SecurityGroupRuleId)
+
+	// Ports are not applicable to the "all" protocol.
+	if aws.StringValue(rule.IpProtocol) == "-1" {
+		d.Set("from_port", nil)
+		d.Set("to_port", nil)
+	} else {
+		d.Set("from_port", rule.FromPort)
+		d.Set("to_port", rule.ToPort)
+	}
+
+	tags := KeyValueTags(rule.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func updateSecurityGroupRule(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &ec2.ModifySecurityGroupRulesInput{
+			GroupId: aws.String(d.Get("security_group_id").(string)),
+			SecurityGroupRules: []*ec2.SecurityGroupRuleUpdate{{
+				SecurityGroupRule:   expandSecurityGroupRuleRequest(d),
+				SecurityGroupRuleId: aws.String(d.Id()),
+			}},
+		}
+
+		if _, err := conn.ModifySecurityGroupRules(input); err != nil {
+			return fmt.Errorf("updating VPC Security Group Rule (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("updating VPC Security Group Rule (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return nil
+}
+
+func deleteSecurityGroupRule(d *schema.ResourceData, meta interface{}, isEgress bool) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	securityGroupID := d.Get("security_group_id").(string)
+	securityGroupRuleIDs := aws.StringSlice([]string{d.Id()})
+
+	log.Printf("[INFO] Deleting VPC Security Group Rule: %s", d.Id())
+	var err error
+	if isEgress {
+		_, err = conn.RevokeSecurityGroupEgress(&ec2.RevokeSecurityGroupEgressInput{
+			GroupId:              aws.String(securityGroupID),
+			SecurityGroupRuleIds: securityGroupRuleIDs,
+		})
+	} else {
+		_, err = conn.RevokeSecurityGroupIngress(&ec2.RevokeSecurityGroupIngressInput{
+			GroupId:              aws.String(securityGroupID),
+			SecurityGroupRuleIds: securityGroupRuleIDs,
+		})
+	}
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidGroupNotFound, errCodeInvalidSecurityGroupRuleIdNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deleting VPC Security Group Rule (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandSecurityGroupRuleIPPermission(d *schema.ResourceData) *ec2.IpPermission {
+	apiObject := &ec2.IpPermission{
+		IpProtocol: aws.String(ProtocolForValue(d.Get("ip_protocol").(string))),
+	}
+
+	// InvalidParameterValue: When protocol is ALL, you cannot specify from-port.
+	if aws.StringValue(apiObject.IpProtocol) != "-1" {
+		apiObject.FromPort = aws.Int64(int64(d.Get("from_port").(int)))
+		apiObject.ToPort = aws.Int64(int64(d.Get("to_port").(int)))
+	}
+
+	var description *string
+	if v, ok := d.GetOk("description"); ok {
+		description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("cidr_ipv4"); ok {
+		apiObject.IpRanges = []*ec2.IpRange{{
+			CidrIp:      aws.String(v.(string)),
+			Description: description,
+		}}
+	}
+
+	if v, ok := d.GetOk("cidr_ipv6"); ok {
+		apiObject.Ipv6Ranges = []*ec2.Ipv6Range{{
+			CidrIpv6:    aws.String(v.(string)),
+			Description: description,
+		}}
+	}
+
+	if v, ok := d.GetOk("prefix_list_id"); ok {
+		apiObject.PrefixListIds = []*ec2.PrefixListId{{
+			Description:  description,
+			PrefixListId: aws.String(v.(string)),
+		}}
+	}
+
+	if v, ok := d.GetOk("referenced_security_group_id"); ok {
+		apiObject.UserIdGroupPairs = []*ec2.UserIdGroupPair{{
+			Description: description,
+			GroupId:     aws.String(v.(string)),
+		}}
+	}
+
+	return apiObject
+}
+
+func expandSecurityGroupRuleRequest(d *schema.ResourceData) *ec2.SecurityGroupRuleRequest {
+	apiObject := &ec2.SecurityGroupRuleRequest{
+		IpProtocol: aws.String(ProtocolForValue(d.Get("ip_protocol").(string))),
+	}
+
+	if aws.StringValue(apiObject.IpProtocol) != "-1" {
+		apiObject.FromPort = aws.Int64(int64(d.Get("from_port").(int)))
+		apiObject.ToPort = aws.Int64(int64(d.Get("to_port").(int)))
+	}
+
+	if v, ok := d.GetOk("cidr_ipv4"); ok {
+		apiObject.CidrIpv4 = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("cidr_ipv6"); ok {
+		apiObject.CidrIpv6 = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		apiObject.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("prefix_list_id"); ok {
+		apiObject.PrefixListId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("referenced_security_group_id"); ok {
+		apiObject.ReferencedGroupId = aws.String(v.(string))
+	}
+
+	return apiObject
+}
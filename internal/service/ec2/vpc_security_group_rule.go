@@ -394,10 +394,10 @@ func resourceSecurityGroupRuleImport(_ context.Context, d *schema.ResourceData,
 	// example: sg-09a093729ef9382a6_egress_tcp_8000_8000_pl-34800000
 	// example: sg-09a093729ef9382a6_ingress_all_0_65536_sg-08123412342323
 	// example: sg-09a093729ef9382a6_ingress_tcp_100_121_10.1.0.0/16_2001:db8::/48_10.2.0.0/16_2002:db8::/48
-	parts := strings.Split(d.Id(), securityGroupRuleIDSeparator)
+	parts, err := securityGroupRuleImportIDFormat.Parse(d.Id())
 
-	if len(parts) < 6 {
-		return nil, invalidIDError("too few parts")
+	if err != nil {
+		return nil, err
 	}
 
 	securityGroupID := parts[0]
@@ -405,15 +405,7 @@ func resourceSecurityGroupRuleImport(_ context.Context, d *schema.ResourceData,
 	protocol := parts[2]
 	fromPort := parts[3]
 	toPort := parts[4]
-	sources := parts[5:]
-
-	if !strings.HasPrefix(securityGroupID, "sg-") {
-		return nil, invalidIDError("invalid Security Group ID")
-	}
-
-	if ruleType != securityGroupRuleTypeIngress && ruleType != securityGroupRuleTypeEgress {
-		return nil, invalidIDError("expecting 'ingress' or 'egress'")
-	}
+	sources := strings.Split(parts[5], securityGroupRuleIDSeparator)
 
 	if _, ok := securityGroupProtocolIntegers[protocol]; !ok {
 		if _, err := strconv.Atoi(protocol); err != nil {
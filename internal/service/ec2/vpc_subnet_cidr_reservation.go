@@ -3,7 +3,6 @@ package ec2
 import (
 	"fmt"
 	"log"
-	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
@@ -22,9 +21,9 @@ func ResourceSubnetCIDRReservation() *schema.Resource {
 		Delete: resourceSubnetCIDRReservationDelete,
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-				parts := strings.Split(d.Id(), ":")
-				if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-					return nil, fmt.Errorf("unexpected format of ID (%q), expected SUBNET_ID:RESERVATION_ID", d.Id())
+				parts, err := subnetCIDRReservationImportIDFormat.Parse(d.Id())
+				if err != nil {
+					return nil, err
 				}
 				subnetID := parts[0]
 				reservationID := parts[1]
@@ -21,13 +21,14 @@ func DataSourceSubnetIDs() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
-			"filter": CustomFiltersSchema(),
+			"filter": CustomFiltersSchemaForOperation("DescribeSubnets"),
 			"ids": {
 				Type:     schema.TypeSet,
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
-			"tags": tftags.TagsSchemaComputed(),
+			"tag_filter": TagFiltersSchema(),
+			"tags":       tftags.TagsSchemaComputed(),
 			"vpc_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -63,6 +64,10 @@ func dataSourceSubnetIDsRead(d *schema.ResourceData, meta interface{}) error {
 		)...)
 	}
 
+	if v, ok := d.GetOk("tag_filter"); ok {
+		input.Filters = append(input.Filters, BuildTagFiltersList(v.(*schema.Set))...)
+	}
+
 	if len(input.Filters) == 0 {
 		input.Filters = nil
 	}
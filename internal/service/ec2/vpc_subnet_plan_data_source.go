@@ -0,0 +1,211 @@
+package ec2
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourceSubnetPlan() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceSubnetPlanRead,
+
+		Schema: map[string]*schema.Schema{
+			"availability_zone_slots": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      4,
+				ValidateFunc: validation.IntBetween(1, 26),
+			},
+			"cidr_block": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsCIDR,
+				ExactlyOneOf: []string{"cidr_block", "ipam_pool_id"},
+			},
+			"ipam_pool_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"reserved_cidr_blocks": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsCIDR,
+				},
+			},
+			"subnet": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"availability_zones": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"index": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      -1,
+							ValidateFunc: validation.IntAtLeast(-1),
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"netmask_length": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 128),
+						},
+					},
+				},
+			},
+			"subnets": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"availability_zone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cidr_block": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSubnetPlanRead(d *schema.ResourceData, meta interface{}) error {
+	var cidrBlock string
+
+	if v, ok := d.GetOk("cidr_block"); ok {
+		cidrBlock = verify.CanonicalCIDRBlock(v.(string))
+	} else {
+		conn := meta.(*conns.AWSClient).EC2Conn
+		poolID := d.Get("ipam_pool_id").(string)
+
+		v, err := findIPAMPoolProvisionedCIDR(conn, poolID)
+
+		if err != nil {
+			return fmt.Errorf("reading IPAM Pool (%s) CIDR: %w", poolID, err)
+		}
+
+		cidrBlock = verify.CanonicalCIDRBlock(v)
+	}
+
+	var reservedCIDRBlocks []string
+
+	for _, v := range flex.ExpandStringValueSet(d.Get("reserved_cidr_blocks").(*schema.Set)) {
+		reservedCIDRBlocks = append(reservedCIDRBlocks, verify.CanonicalCIDRBlock(v))
+	}
+
+	allocations, err := planSubnets(cidrBlock, reservedCIDRBlocks, d.Get("availability_zone_slots").(int), expandSubnetPlanGroups(d.Get("subnet").([]interface{})))
+
+	if err != nil {
+		return fmt.Errorf("planning subnets in %s: %w", cidrBlock, err)
+	}
+
+	var cidrBlocks []string
+	for _, v := range allocations {
+		cidrBlocks = append(cidrBlocks, v.cidrBlock)
+	}
+
+	d.SetId(fmt.Sprintf("%s-%d", cidrBlock, create.StringHashcode(strings.Join(cidrBlocks, ","))))
+	d.Set("cidr_block", cidrBlock)
+
+	if err := d.Set("subnets", flattenSubnetPlanAllocations(allocations)); err != nil {
+		return fmt.Errorf("setting subnets: %w", err)
+	}
+
+	return nil
+}
+
+// findIPAMPoolProvisionedCIDR returns the single CIDR provisioned to an IPAM pool.
+func findIPAMPoolProvisionedCIDR(conn *ec2.EC2, poolID string) (string, error) {
+	output, err := FindIPAMPoolCIDRs(conn, &ec2.GetIpamPoolCidrsInput{
+		IpamPoolId: aws.String(poolID),
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	var cidrBlocks []string
+
+	for _, v := range output {
+		if aws.StringValue(v.State) == ec2.IpamPoolCidrStateProvisioned {
+			cidrBlocks = append(cidrBlocks, aws.StringValue(v.Cidr))
+		}
+	}
+
+	switch n := len(cidrBlocks); n {
+	case 0:
+		return "", fmt.Errorf("no provisioned CIDRs")
+	case 1:
+		return cidrBlocks[0], nil
+	default:
+		return "", fmt.Errorf("%d provisioned CIDRs (%s), specify cidr_block instead", n, strings.Join(cidrBlocks, ", "))
+	}
+}
+
+func expandSubnetPlanGroups(tfList []interface{}) []subnetPlanGroup {
+	var groups []subnetPlanGroup
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		group := subnetPlanGroup{
+			availabilityZones: flex.ExpandStringValueList(tfMap["availability_zones"].([]interface{})),
+			name:              tfMap["name"].(string),
+			netmaskLength:     tfMap["netmask_length"].(int),
+		}
+
+		if v, ok := tfMap["index"].(int); ok && v >= 0 {
+			group.index = aws.Int(v)
+		}
+
+		groups = append(groups, group)
+	}
+
+	return groups
+}
+
+func flattenSubnetPlanAllocations(allocations []subnetPlanAllocation) []interface{} {
+	var tfList []interface{}
+
+	for _, v := range allocations {
+		tfList = append(tfList, map[string]interface{}{
+			"availability_zone": v.availabilityZone,
+			"cidr_block":        v.cidrBlock,
+			"name":              v.name,
+		})
+	}
+
+	return tfList
+}
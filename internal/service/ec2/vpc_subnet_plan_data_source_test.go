@@ -0,0 +1,150 @@
+package ec2_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccVPCSubnetPlanDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_vpc_subnet_plan.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSubnetPlanDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "cidr_block", "10.0.0.0/16"),
+					resource.TestCheckResourceAttr(dataSourceName, "subnets.#", "3"),
+					resource.TestCheckResourceAttr(dataSourceName, "subnets.0.name", "public"),
+					resource.TestCheckResourceAttr(dataSourceName, "subnets.0.availability_zone", "us-west-2a"),
+					resource.TestCheckResourceAttr(dataSourceName, "subnets.0.cidr_block", "10.0.0.0/24"),
+					resource.TestCheckResourceAttr(dataSourceName, "subnets.1.name", "public"),
+					resource.TestCheckResourceAttr(dataSourceName, "subnets.1.availability_zone", "us-west-2c"),
+					resource.TestCheckResourceAttr(dataSourceName, "subnets.1.cidr_block", "10.0.2.0/24"),
+					resource.TestCheckResourceAttr(dataSourceName, "subnets.2.name", "database"),
+					resource.TestCheckResourceAttr(dataSourceName, "subnets.2.availability_zone", ""),
+					resource.TestCheckResourceAttr(dataSourceName, "subnets.2.cidr_block", "10.0.8.0/26"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCSubnetPlanDataSource_noSpace(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVPCSubnetPlanDataSourceConfig_noSpace,
+				ExpectError: regexp.MustCompile(`no space left in 10.0.0.0/24 for subnet \(b\)`),
+			},
+		},
+	})
+}
+
+func TestAccVPCSubnetPlanDataSource_subnets(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSubnetPlanDataSourceConfig_subnets(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_vpc_subnet_plan.test", "subnets.#", "4"),
+					resource.TestCheckResourceAttrPair("aws_subnet.test.0", "cidr_block", "data.aws_vpc_subnet_plan.test", "subnets.0.cidr_block"),
+					resource.TestCheckResourceAttrPair("aws_subnet.test.3", "cidr_block", "data.aws_vpc_subnet_plan.test", "subnets.3.cidr_block"),
+				),
+			},
+		},
+	})
+}
+
+const testAccVPCSubnetPlanDataSourceConfig_basic = `
+data "aws_vpc_subnet_plan" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  reserved_cidr_blocks = ["10.0.4.0/22"]
+
+  subnet {
+    name               = "public"
+    netmask_length     = 24
+    availability_zones = ["us-west-2a", "us-west-2c"]
+  }
+
+  subnet {
+    name           = "database"
+    netmask_length = 26
+  }
+}
+`
+
+const testAccVPCSubnetPlanDataSourceConfig_noSpace = `
+data "aws_vpc_subnet_plan" "test" {
+  cidr_block = "10.0.0.0/24"
+
+  subnet {
+    name           = "a"
+    netmask_length = 25
+  }
+
+  subnet {
+    name           = "b"
+    netmask_length = 24
+  }
+}
+`
+
+func testAccVPCSubnetPlanDataSourceConfig_subnets(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_vpc_subnet_plan" "test" {
+  cidr_block = aws_vpc.test.cidr_block
+
+  subnet {
+    name               = "public"
+    netmask_length     = 24
+    availability_zones = slice(data.aws_availability_zones.available.zone_ids, 0, 2)
+  }
+
+  subnet {
+    name               = "private"
+    netmask_length     = 20
+    availability_zones = slice(data.aws_availability_zones.available.zone_ids, 0, 2)
+  }
+}
+
+resource "aws_subnet" "test" {
+  count = 4
+
+  vpc_id               = aws_vpc.test.id
+  cidr_block           = data.aws_vpc_subnet_plan.test.subnets[count.index].cidr_block
+  availability_zone_id = data.aws_vpc_subnet_plan.test.subnets[count.index].availability_zone
+
+  tags = {
+    Name = "%[1]s-${data.aws_vpc_subnet_plan.test.subnets[count.index].name}"
+  }
+}
+`, rName))
+}
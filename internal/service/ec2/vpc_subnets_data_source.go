@@ -20,13 +20,14 @@ func DataSourceSubnets() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
-			"filter": DataSourceFiltersSchema(),
+			"filter": DataSourceFiltersSchemaForOperation("DescribeSubnets"),
 			"ids": {
 				Type:     schema.TypeList,
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
-			"tags": tftags.TagsSchemaComputed(),
+			"tag_filter": TagFiltersSchema(),
+			"tags":       tftags.TagsSchemaComputed(),
 		},
 	}
 }
@@ -47,6 +48,10 @@ func dataSourceSubnetsRead(d *schema.ResourceData, meta interface{}) error {
 			BuildFiltersDataSource(filters.(*schema.Set))...)
 	}
 
+	if v, ok := d.GetOk("tag_filter"); ok {
+		input.Filters = append(input.Filters, BuildTagFiltersList(v.(*schema.Set))...)
+	}
+
 	if len(input.Filters) == 0 {
 		input.Filters = nil
 	}
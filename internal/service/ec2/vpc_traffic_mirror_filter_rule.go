@@ -3,7 +3,6 @@ package ec2
 import (
 	"fmt"
 	"log"
-	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
@@ -292,9 +291,9 @@ func resourceTrafficMirrorFilterRuleDelete(d *schema.ResourceData, meta interfac
 }
 
 func resourceTrafficMirrorFilterRuleImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	parts := strings.SplitN(d.Id(), ":", 2)
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		return nil, fmt.Errorf("unexpected format (%q), expected <filter-id>:<rule-id>", d.Id())
+	parts, err := trafficMirrorFilterRuleImportIDFormat.Parse(d.Id())
+	if err != nil {
+		return nil, err
 	}
 
 	d.Set("traffic_mirror_filter_id", parts[0])
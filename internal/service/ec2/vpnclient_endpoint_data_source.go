@@ -128,7 +128,7 @@ func DataSourceClientVPNEndpoint() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
-			"filter": DataSourceFiltersSchema(),
+			"filter": DataSourceFiltersSchemaForOperation("DescribeClientVpnEndpoints"),
 			"security_group_ids": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -3,7 +3,6 @@ package ec2
 import (
 	"fmt"
 	"log"
-	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
@@ -181,10 +180,10 @@ func resourceClientVPNNetworkAssociationDelete(d *schema.ResourceData, meta inte
 }
 
 func resourceClientVPNNetworkAssociationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	parts := strings.Split(d.Id(), ",")
+	parts, err := clientVPNNetworkAssociationImportIDFormat.Parse(d.Id())
 
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		return nil, fmt.Errorf("unexpected format for ID (%[1]s), expected EndpointID%[2]sAssociationID", d.Id(), ",")
+	if err != nil {
+		return nil, err
 	}
 
 	d.SetId(parts[1])
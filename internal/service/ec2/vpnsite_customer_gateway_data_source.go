@@ -39,7 +39,7 @@ func DataSourceCustomerGateway() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"filter": DataSourceFiltersSchema(),
+			"filter": DataSourceFiltersSchemaForOperation("DescribeCustomerGateways"),
 			"id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -49,7 +49,8 @@ func DataSourceCustomerGateway() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"tags": tftags.TagsSchemaComputed(),
+			"tag_filter": TagFiltersSchema(),
+			"tags":       tftags.TagsSchemaComputed(),
 			"type": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -68,6 +69,10 @@ func dataSourceCustomerGatewayRead(d *schema.ResourceData, meta interface{}) err
 		input.Filters = BuildFiltersDataSource(v.(*schema.Set))
 	}
 
+	if v, ok := d.GetOk("tag_filter"); ok {
+		input.Filters = append(input.Filters, BuildTagFiltersList(v.(*schema.Set))...)
+	}
+
 	if v, ok := d.GetOk("id"); ok {
 		input.CustomerGatewayIds = []*string{aws.String(v.(string))}
 	}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log"
 	"strconv"
 	"time"
 
@@ -1742,28 +1743,43 @@ func WaitVolumeAttachmentDeleted(conn *ec2.EC2, volumeID, instanceID, deviceName
 	return nil, err
 }
 
-func WaitVolumeModificationComplete(conn *ec2.EC2, id string, timeout time.Duration) (*ec2.VolumeModification, error) {
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{ec2.VolumeModificationStateModifying},
+func WaitVolumeModificationComplete(ctx context.Context, conn *ec2.EC2, id string, timeout time.Duration) (*ec2.VolumeModification, error) {
+	output, err := tfresource.Wait(ctx, StatusVolumeModificationState(conn, id), tfresource.WaiterOpts{
+		Description: fmt.Sprintf("EC2 Volume (%s) modification", id),
+		Pending:     []string{ec2.VolumeModificationStateModifying},
 		// The volume is useable once the state is "optimizing", but will not be at full performance.
 		// Optimization can take hours. e.g. a full 1 TiB drive takes approximately 6 hours to optimize,
 		// according to https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/monitoring-volume-modifications.html.
-		Target:     []string{ec2.VolumeModificationStateCompleted, ec2.VolumeModificationStateOptimizing},
-		Refresh:    StatusVolumeModificationState(conn, id),
-		Timeout:    timeout,
-		Delay:      30 * time.Second,
-		MinTimeout: 30 * time.Second,
-	}
+		Target:          []string{ec2.VolumeModificationStateCompleted, ec2.VolumeModificationStateOptimizing},
+		Timeout:         timeout,
+		Delay:           30 * time.Second,
+		MinPollInterval: 30 * time.Second,
+	})
 
-	outputRaw, err := stateConf.WaitForState()
-
-	if output, ok := outputRaw.(*ec2.VolumeModification); ok {
+	if output != nil {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
 
-		return output, err
+		if err == nil {
+			log.Printf("[INFO] EC2 Volume (%s) modification %s, %d%% complete", id, aws.StringValue(output.ModificationState), aws.Int64Value(output.Progress))
+		}
 	}
 
-	return nil, err
+	return output, err
+}
+
+func WaitInstanceStatusChecksPassed(ctx context.Context, conn *ec2.EC2, id string, timeout time.Duration) (*ec2.InstanceStatus, error) {
+	return tfresource.Wait(ctx, StatusInstanceStatusChecks(conn, id), tfresource.WaiterOpts{
+		Description: fmt.Sprintf("EC2 Instance (%s) status checks", id),
+		Pending: []string{
+			ec2.SummaryStatusInitializing,
+			ec2.SummaryStatusInsufficientData,
+			ec2.SummaryStatusNotApplicable,
+		},
+		Target:          []string{ec2.SummaryStatusOk},
+		Timeout:         timeout,
+		Delay:           30 * time.Second,
+		MinPollInterval: 15 * time.Second,
+	})
 }
 
 const (
@@ -200,3 +200,41 @@ func FindService(ctx context.Context, conn *ecs.ECS, input *ecs.DescribeServices
 
 	return output.Services[0], nil
 }
+
+// FindServiceStoppedTasks returns the service's tasks that have stopped recently.
+// At most 100 tasks are returned.
+func FindServiceStoppedTasks(ctx context.Context, conn *ecs.ECS, serviceName, cluster string) ([]*ecs.Task, error) {
+	listInput := &ecs.ListTasksInput{
+		Cluster:       aws.String(cluster),
+		DesiredStatus: aws.String(ecs.DesiredStatusStopped),
+		MaxResults:    aws.Int64(100),
+		ServiceName:   aws.String(serviceName),
+	}
+
+	listOutput, err := conn.ListTasksWithContext(ctx, listInput)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if listOutput == nil || len(listOutput.TaskArns) == 0 {
+		return nil, nil
+	}
+
+	input := &ecs.DescribeTasksInput{
+		Cluster: aws.String(cluster),
+		Tasks:   listOutput.TaskArns,
+	}
+
+	output, err := conn.DescribeTasksWithContext(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Tasks, nil
+}
@@ -0,0 +1,17 @@
+package ecs
+
+import (
+	"github.com/hashicorp/terraform-provider-aws/internal/importid"
+)
+
+var serviceImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "cluster-name"},
+		{Name: "service-name"},
+	},
+	Separator: "/",
+}
+
+func init() {
+	importid.Register("aws_ecs_service", serviceImportIDFormat)
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -28,10 +29,10 @@ import (
 
 func ResourceService() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceServiceCreate,
-		Read:   resourceServiceRead,
-		Update: resourceServiceUpdate,
-		Delete: resourceServiceDelete,
+		CreateWithoutTimeout: resourceServiceCreate,
+		ReadWithoutTimeout:   resourceServiceRead,
+		UpdateWithoutTimeout: resourceServiceUpdate,
+		DeleteWithoutTimeout: resourceServiceDelete,
 		Importer: &schema.ResourceImporter{
 			State: resourceServiceImport,
 		},
@@ -43,6 +44,28 @@ func ResourceService() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"alarms": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alarm_names": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"enable": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"rollback": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
 			"capacity_provider_strategy": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -306,6 +329,7 @@ func ResourceService() *schema.Resource {
 				Default:      ecs.SchedulingStrategyReplica,
 				ValidateFunc: validation.StringInSlice(ecs.SchedulingStrategy_Values(), false),
 			},
+			"service_connect_configuration": serviceConnectConfigurationSchema(),
 			"service_registries": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -386,11 +410,12 @@ func capacityProviderStrategyForceNew(d *schema.ResourceDiff) error {
 }
 
 func resourceServiceImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	if len(strings.Split(d.Id(), "/")) != 2 {
-		return []*schema.ResourceData{}, fmt.Errorf("wrong format of resource: %s, expecting 'cluster-name/service-name'", d.Id())
+	parts, err := serviceImportIDFormat.Parse(d.Id())
+	if err != nil {
+		return []*schema.ResourceData{}, err
 	}
-	cluster := strings.Split(d.Id(), "/")[0]
-	name := strings.Split(d.Id(), "/")[1]
+	cluster := parts[0]
+	name := parts[1]
 	log.Printf("[DEBUG] Importing ECS service %s from cluster %s", name, cluster)
 
 	d.SetId(name)
@@ -405,7 +430,7 @@ func resourceServiceImport(d *schema.ResourceData, meta interface{}) ([]*schema.
 	return []*schema.ResourceData{d}, nil
 }
 
-func resourceServiceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceServiceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).ECSConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
@@ -489,7 +514,7 @@ func resourceServiceCreate(d *schema.ResourceData, meta interface{}) error {
 		ps, err := expandPlacementStrategy(v.([]interface{}))
 
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		input.PlacementStrategy = ps
@@ -499,7 +524,7 @@ func resourceServiceCreate(d *schema.ResourceData, meta interface{}) error {
 		pc, err := expandPlacementConstraints(v.List())
 
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		input.PlacementConstraints = pc
@@ -532,24 +557,34 @@ func resourceServiceCreate(d *schema.ResourceData, meta interface{}) error {
 		input.Tags = Tags(tags.IgnoreAWS()) // tags field doesn't exist in all partitions
 	}
 
+	if v := expandDeploymentAlarms(d.Get("alarms").([]interface{})); v != nil {
+		if input.DeploymentConfiguration == nil {
+			input.DeploymentConfiguration = &ecs.DeploymentConfiguration{}
+		}
+
+		input.DeploymentConfiguration.Alarms = v
+	}
+
+	input.ServiceConnectConfiguration = expandServiceConnectConfiguration(d.Get("service_connect_configuration").([]interface{}))
+
 	log.Printf("[DEBUG] Creating ECS Service: %s", input)
 
-	output, err := serviceCreateWithRetry(conn, input)
+	output, err := serviceCreateWithRetry(ctx, conn, input)
 
 	// Some partitions (i.e., ISO) may not support tag-on-create
 	if input.Tags != nil && verify.ErrorISOUnsupported(conn.PartitionID, err) {
 		log.Printf("[WARN] failed creating ECS Service (%s) with tags: %s. Trying create without tags.", d.Get("name").(string), err)
 		input.Tags = nil
 
-		output, err = serviceCreateWithRetry(conn, input)
+		output, err = serviceCreateWithRetry(ctx, conn, input)
 	}
 
 	if err != nil {
-		return fmt.Errorf("error creating ECS service (%s): %w", d.Get("name").(string), err)
+		return diag.Errorf("error creating ECS service (%s): %s", d.Get("name").(string), err)
 	}
 
 	if output == nil || output.Service == nil {
-		return fmt.Errorf("error creating ECS service: empty response")
+		return diag.Errorf("error creating ECS service: empty response")
 	}
 
 	log.Printf("[DEBUG] ECS service created: %s", aws.StringValue(output.Service.ServiceArn))
@@ -558,12 +593,12 @@ func resourceServiceCreate(d *schema.ResourceData, meta interface{}) error {
 	cluster := d.Get("cluster").(string)
 
 	if d.Get("wait_for_steady_state").(bool) {
-		if _, err := waitServiceStable(conn, d.Id(), cluster, d.Timeout(schema.TimeoutCreate)); err != nil {
-			return fmt.Errorf("error waiting for ECS service (%s) to reach steady state after creation: %w", d.Id(), err)
+		if _, err := waitServiceStable(ctx, conn, d.Id(), cluster, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.Errorf("error waiting for ECS service (%s) to reach steady state after creation: %s", d.Id(), err)
 		}
 	} else {
 		if _, err := waitServiceActive(conn, d.Id(), cluster, d.Timeout(schema.TimeoutCreate)); err != nil {
-			return fmt.Errorf("error waiting for ECS service (%s) to become active after creation: %w", d.Id(), err)
+			return diag.Errorf("error waiting for ECS service (%s) to become active after creation: %s", d.Id(), err)
 		}
 	}
 
@@ -574,25 +609,25 @@ func resourceServiceCreate(d *schema.ResourceData, meta interface{}) error {
 		// If default tags only, log and continue. Otherwise, error.
 		if v, ok := d.GetOk("tags"); (!ok || len(v.(map[string]interface{})) == 0) && verify.ErrorISOUnsupported(conn.PartitionID, err) {
 			log.Printf("[WARN] failed adding tags after create for ECS Service (%s): %s", d.Id(), err)
-			return resourceServiceRead(d, meta)
+			return resourceServiceRead(ctx, d, meta)
 		}
 
 		if err != nil {
-			return fmt.Errorf("ECS tagging failed adding tags after create for Service (%s): %w", d.Id(), err)
+			return diag.Errorf("ECS tagging failed adding tags after create for Service (%s): %s", d.Id(), err)
 		}
 	}
 
-	return resourceServiceRead(d, meta)
+	return resourceServiceRead(ctx, d, meta)
 }
 
-func resourceServiceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceServiceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).ECSConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
 	cluster := d.Get("cluster").(string)
 
-	service, err := FindServiceByIDWaitForActive(context.TODO(), conn, d.Id(), cluster)
+	service, err := FindServiceByIDWaitForActive(ctx, conn, d.Id(), cluster)
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] ECS Service (%s) not found, removing from state", d.Id())
@@ -614,7 +649,7 @@ func resourceServiceRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if err != nil {
-		return fmt.Errorf("error reading ECS service (%s): %w", d.Id(), err)
+		return diag.Errorf("error reading ECS service (%s): %s", d.Id(), err)
 	}
 
 	d.SetId(aws.StringValue(service.ServiceArn))
@@ -666,15 +701,25 @@ func resourceServiceRead(d *schema.ResourceData, meta interface{}) error {
 
 		if service.DeploymentConfiguration.DeploymentCircuitBreaker != nil {
 			if err := d.Set("deployment_circuit_breaker", []interface{}{flattenDeploymentCircuitBreaker(service.DeploymentConfiguration.DeploymentCircuitBreaker)}); err != nil {
-				return fmt.Errorf("error setting deployment_circuit_break: %w", err)
+				return diag.Errorf("error setting deployment_circuit_break: %s", err)
 			}
 		} else {
 			d.Set("deployment_circuit_breaker", nil)
 		}
 	}
 
+	var alarms *ecs.DeploymentAlarms
+
+	if service.DeploymentConfiguration != nil {
+		alarms = service.DeploymentConfiguration.Alarms
+	}
+
+	if err := d.Set("alarms", flattenDeploymentAlarms(alarms)); err != nil {
+		return diag.Errorf("error setting alarms: %s", err)
+	}
+
 	if err := d.Set("deployment_controller", flattenDeploymentController(service.DeploymentController)); err != nil {
-		return fmt.Errorf("error setting deployment_controller for (%s): %w", d.Id(), err)
+		return diag.Errorf("error setting deployment_controller for (%s): %s", d.Id(), err)
 	}
 
 	if service.LoadBalancers != nil {
@@ -682,11 +727,11 @@ func resourceServiceRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if err := d.Set("capacity_provider_strategy", flattenCapacityProviderStrategy(service.CapacityProviderStrategy)); err != nil {
-		return fmt.Errorf("error setting capacity_provider_strategy: %w", err)
+		return diag.Errorf("error setting capacity_provider_strategy: %s", err)
 	}
 
 	if err := d.Set("ordered_placement_strategy", flattenPlacementStrategy(service.PlacementStrategy)); err != nil {
-		return fmt.Errorf("error setting ordered_placement_strategy: %w", err)
+		return diag.Errorf("error setting ordered_placement_strategy: %s", err)
 	}
 
 	if err := d.Set("placement_constraints", flattenServicePlacementConstraints(service.PlacementConstraints)); err != nil {
@@ -694,22 +739,26 @@ func resourceServiceRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if err := d.Set("network_configuration", flattenNetworkConfiguration(service.NetworkConfiguration)); err != nil {
-		return fmt.Errorf("error setting network_configuration for (%s): %w", d.Id(), err)
+		return diag.Errorf("error setting network_configuration for (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("service_connect_configuration", flattenServiceConnectConfiguration(primaryDeploymentServiceConnectConfiguration(service), d.Get("service_connect_configuration.0.namespace").(string))); err != nil {
+		return diag.Errorf("error setting service_connect_configuration for (%s): %s", d.Id(), err)
 	}
 
 	if err := d.Set("service_registries", flattenServiceRegistries(service.ServiceRegistries)); err != nil {
-		return fmt.Errorf("error setting service_registries for (%s): %w", d.Id(), err)
+		return diag.Errorf("error setting service_registries for (%s): %s", d.Id(), err)
 	}
 
 	tags := KeyValueTags(service.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
 	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
-		return fmt.Errorf("error setting tags: %w", err)
+		return diag.Errorf("error setting tags: %s", err)
 	}
 
 	if err := d.Set("tags_all", tags.Map()); err != nil {
-		return fmt.Errorf("error setting tags_all: %w", err)
+		return diag.Errorf("error setting tags_all: %s", err)
 	}
 
 	return nil
@@ -769,6 +818,37 @@ func flattenDeploymentCircuitBreaker(apiObject *ecs.DeploymentCircuitBreaker) ma
 	return tfMap
 }
 
+func expandDeploymentAlarms(tfList []interface{}) *ecs.DeploymentAlarms {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &ecs.DeploymentAlarms{
+		AlarmNames: flex.ExpandStringSet(tfMap["alarm_names"].(*schema.Set)),
+		Enable:     aws.Bool(tfMap["enable"].(bool)),
+		Rollback:   aws.Bool(tfMap["rollback"].(bool)),
+	}
+
+	return apiObject
+}
+
+func flattenDeploymentAlarms(apiObject *ecs.DeploymentAlarms) []interface{} {
+	// Services without deployment alarms report them as disabled.
+	if apiObject == nil || (len(apiObject.AlarmNames) == 0 && !aws.BoolValue(apiObject.Enable)) {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"alarm_names": flex.FlattenStringSet(apiObject.AlarmNames),
+		"enable":      aws.BoolValue(apiObject.Enable),
+		"rollback":    aws.BoolValue(apiObject.Rollback),
+	}
+
+	return []interface{}{tfMap}
+}
+
 func flattenNetworkConfiguration(nc *ecs.NetworkConfiguration) []interface{} {
 	if nc == nil {
 		return nil
@@ -941,7 +1021,7 @@ func flattenServiceRegistries(srs []*ecs.ServiceRegistry) []map[string]interface
 	return results
 }
 
-func resourceServiceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceServiceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).ECSConn
 
 	if d.HasChangesExcept("tags", "tags_all") {
@@ -994,7 +1074,7 @@ func resourceServiceUpdate(d *schema.ResourceData, meta interface{}) error {
 				ps, err := expandPlacementStrategy(v.([]interface{}))
 
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 
 				input.PlacementStrategy = ps
@@ -1010,7 +1090,7 @@ func resourceServiceUpdate(d *schema.ResourceData, meta interface{}) error {
 				pc, err := expandPlacementConstraints(v.List())
 
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 
 				input.PlacementConstraints = pc
@@ -1059,10 +1139,39 @@ func resourceServiceUpdate(d *schema.ResourceData, meta interface{}) error {
 			input.ServiceRegistries = expandServiceRegistries(d.Get("service_registries").([]interface{}))
 		}
 
+		// Deployment alarms are part of the deployment configuration, so are also sent whenever it is updated.
+		if d.HasChange("alarms") || input.DeploymentConfiguration != nil {
+			if input.DeploymentConfiguration == nil {
+				input.DeploymentConfiguration = &ecs.DeploymentConfiguration{}
+			}
+
+			// To remove the deployment alarms, specify disabled alarms.
+			input.DeploymentConfiguration.Alarms = &ecs.DeploymentAlarms{
+				AlarmNames: aws.StringSlice([]string{}),
+				Enable:     aws.Bool(false),
+				Rollback:   aws.Bool(false),
+			}
+
+			if v := expandDeploymentAlarms(d.Get("alarms").([]interface{})); v != nil {
+				input.DeploymentConfiguration.Alarms = v
+			}
+		}
+
+		if d.HasChange("service_connect_configuration") {
+			// To remove the Service Connect configuration, disable it.
+			input.ServiceConnectConfiguration = &ecs.ServiceConnectConfiguration{
+				Enabled: aws.Bool(false),
+			}
+
+			if v := expandServiceConnectConfiguration(d.Get("service_connect_configuration").([]interface{})); v != nil {
+				input.ServiceConnectConfiguration = v
+			}
+		}
+
 		log.Printf("[DEBUG] Updating ECS Service (%s): %s", d.Id(), input)
 		// Retry due to IAM eventual consistency
-		err := resource.Retry(propagationTimeout+serviceUpdateTimeout, func() *resource.RetryError {
-			_, err := conn.UpdateService(input)
+		err := resource.RetryContext(ctx, propagationTimeout+serviceUpdateTimeout, func() *resource.RetryError {
+			_, err := conn.UpdateServiceWithContext(ctx, input)
 
 			if err != nil {
 				if tfawserr.ErrMessageContains(err, ecs.ErrCodeInvalidParameterException, "verify that the ECS service role being passed has the proper permissions") {
@@ -1079,21 +1188,21 @@ func resourceServiceUpdate(d *schema.ResourceData, meta interface{}) error {
 		})
 
 		if tfresource.TimedOut(err) {
-			_, err = conn.UpdateService(input)
+			_, err = conn.UpdateServiceWithContext(ctx, input)
 		}
 
 		if err != nil {
-			return fmt.Errorf("error updating ECS Service (%s): %w", d.Id(), err)
+			return diag.Errorf("error updating ECS Service (%s): %s", d.Id(), err)
 		}
 
 		cluster := d.Get("cluster").(string)
 		if d.Get("wait_for_steady_state").(bool) {
-			if _, err := waitServiceStable(conn, d.Id(), cluster, d.Timeout(schema.TimeoutUpdate)); err != nil {
-				return fmt.Errorf("error waiting for ECS service (%s) to reach steady state after update: %w", d.Id(), err)
+			if _, err := waitServiceStable(ctx, conn, d.Id(), cluster, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diag.Errorf("error waiting for ECS service (%s) to reach steady state after update: %s", d.Id(), err)
 			}
 		} else {
 			if _, err := waitServiceActive(conn, d.Id(), cluster, d.Timeout(schema.TimeoutUpdate)); err != nil {
-				return fmt.Errorf("error waiting for ECS service (%s) to become active after update: %w", d.Id(), err)
+				return diag.Errorf("error waiting for ECS service (%s) to become active after update: %s", d.Id(), err)
 			}
 		}
 	}
@@ -1106,26 +1215,26 @@ func resourceServiceUpdate(d *schema.ResourceData, meta interface{}) error {
 		// Some partitions (i.e., ISO) may not support tagging, giving error
 		if verify.ErrorISOUnsupported(conn.PartitionID, err) {
 			log.Printf("[WARN] failed updating tags for ECS Service (%s): %s", d.Id(), err)
-			return resourceServiceRead(d, meta)
+			return resourceServiceRead(ctx, d, meta)
 		}
 
 		if err != nil {
-			return fmt.Errorf("failed updating tags for ECS Service (%s): %w", d.Id(), err)
+			return diag.Errorf("failed updating tags for ECS Service (%s): %s", d.Id(), err)
 		}
 	}
 
-	return resourceServiceRead(d, meta)
+	return resourceServiceRead(ctx, d, meta)
 }
 
-func resourceServiceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceServiceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).ECSConn
 
-	service, err := FindServiceNoTagsByID(context.TODO(), conn, d.Id(), d.Get("cluster").(string))
+	service, err := FindServiceNoTagsByID(ctx, conn, d.Id(), d.Get("cluster").(string))
 	if tfresource.NotFound(err) {
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("error retrieving ECS Service (%s) for deletion: %w", d.Id(), err)
+		return diag.Errorf("error retrieving ECS Service (%s) for deletion: %s", d.Id(), err)
 	}
 
 	if aws.StringValue(service.Status) == serviceStatusInactive {
@@ -1135,13 +1244,13 @@ func resourceServiceDelete(d *schema.ResourceData, meta interface{}) error {
 	// Drain the ECS service
 	if aws.StringValue(service.Status) != serviceStatusDraining && aws.StringValue(service.SchedulingStrategy) != ecs.SchedulingStrategyDaemon {
 		log.Printf("[DEBUG] Draining ECS Service (%s)", d.Id())
-		_, err = conn.UpdateService(&ecs.UpdateServiceInput{
+		_, err = conn.UpdateServiceWithContext(ctx, &ecs.UpdateServiceInput{
 			Service:      aws.String(d.Id()),
 			Cluster:      aws.String(d.Get("cluster").(string)),
 			DesiredCount: aws.Int64(0),
 		})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -1150,8 +1259,8 @@ func resourceServiceDelete(d *schema.ResourceData, meta interface{}) error {
 		Cluster: aws.String(d.Get("cluster").(string)),
 	}
 	// Wait until the ECS service is drained
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-		_, err := conn.DeleteService(&input)
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, err := conn.DeleteServiceWithContext(ctx, &input)
 
 		if err != nil {
 			if tfawserr.ErrMessageContains(err, ecs.ErrCodeInvalidParameterException, "The service cannot be stopped while deployments are active.") {
@@ -1169,15 +1278,15 @@ func resourceServiceDelete(d *schema.ResourceData, meta interface{}) error {
 	})
 
 	if tfresource.TimedOut(err) {
-		_, err = conn.DeleteService(&input)
+		_, err = conn.DeleteServiceWithContext(ctx, &input)
 	}
 
 	if err != nil {
-		return fmt.Errorf("error deleting ECS Service (%s): %w", d.Id(), err)
+		return diag.Errorf("error deleting ECS Service (%s): %s", d.Id(), err)
 	}
 
 	if err := waitServiceInactive(conn, d.Id(), d.Get("cluster").(string), d.Timeout(schema.TimeoutDelete)); err != nil {
-		return fmt.Errorf("error waiting for ECS Service (%s) to be deleted: %w", d.Id(), err)
+		return diag.Errorf("error waiting for ECS Service (%s) to be deleted: %s", d.Id(), err)
 	}
 
 	return nil
@@ -1198,11 +1307,11 @@ func resourceLoadBalancerHash(v interface{}) int {
 	return create.StringHashcode(buf.String())
 }
 
-func serviceCreateWithRetry(conn *ecs.ECS, input ecs.CreateServiceInput) (*ecs.CreateServiceOutput, error) {
+func serviceCreateWithRetry(ctx context.Context, conn *ecs.ECS, input ecs.CreateServiceInput) (*ecs.CreateServiceOutput, error) {
 	var output *ecs.CreateServiceOutput
-	err := resource.Retry(propagationTimeout+serviceCreateTimeout, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, propagationTimeout+serviceCreateTimeout, func() *resource.RetryError {
 		var err error
-		output, err = conn.CreateService(&input)
+		output, err = conn.CreateServiceWithContext(ctx, &input)
 
 		if err != nil {
 			if tfawserr.ErrCodeEquals(err, ecs.ErrCodeClusterNotFoundException) {
@@ -1228,7 +1337,7 @@ func serviceCreateWithRetry(conn *ecs.ECS, input ecs.CreateServiceInput) (*ecs.C
 	})
 
 	if tfresource.TimedOut(err) {
-		output, err = conn.CreateService(&input)
+		output, err = conn.CreateServiceWithContext(ctx, &input)
 	}
 
 	return output, err
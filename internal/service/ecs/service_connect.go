@@ -0,0 +1,281 @@
+package ecs
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+func serviceConnectConfigurationSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"enabled": {
+					Type:     schema.TypeBool,
+					Required: true,
+				},
+				"log_configuration": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"log_driver": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringInSlice(ecs.LogDriver_Values(), false),
+							},
+							"options": {
+								Type:     schema.TypeMap,
+								Optional: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"secret_option": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"name": {
+											Type:     schema.TypeString,
+											Required: true,
+										},
+										"value_from": {
+											Type:     schema.TypeString,
+											Required: true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				"namespace": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"service": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"client_alias": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"dns_name": {
+											Type:     schema.TypeString,
+											Optional: true,
+											Computed: true,
+										},
+										"port": {
+											Type:         schema.TypeInt,
+											Required:     true,
+											ValidateFunc: validation.IsPortNumber,
+										},
+									},
+								},
+							},
+							"discovery_name": {
+								Type:     schema.TypeString,
+								Optional: true,
+								Computed: true,
+							},
+							"ingress_port_override": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ValidateFunc: validation.IsPortNumber,
+							},
+							"port_name": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandServiceConnectConfiguration(tfList []interface{}) *ecs.ServiceConnectConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &ecs.ServiceConnectConfiguration{
+		Enabled: aws.Bool(tfMap["enabled"].(bool)),
+	}
+
+	if v, ok := tfMap["log_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.LogConfiguration = expandServiceConnectLogConfiguration(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["namespace"].(string); ok && v != "" {
+		apiObject.Namespace = aws.String(v)
+	}
+
+	if v, ok := tfMap["service"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Services = expandServiceConnectServices(v)
+	}
+
+	return apiObject
+}
+
+func expandServiceConnectLogConfiguration(tfMap map[string]interface{}) *ecs.LogConfiguration {
+	apiObject := &ecs.LogConfiguration{
+		LogDriver: aws.String(tfMap["log_driver"].(string)),
+	}
+
+	if v, ok := tfMap["options"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.Options = flex.ExpandStringMap(v)
+	}
+
+	if v, ok := tfMap["secret_option"].([]interface{}); ok {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiObject.SecretOptions = append(apiObject.SecretOptions, &ecs.Secret{
+				Name:      aws.String(tfMap["name"].(string)),
+				ValueFrom: aws.String(tfMap["value_from"].(string)),
+			})
+		}
+	}
+
+	return apiObject
+}
+
+func expandServiceConnectServices(tfList []interface{}) []*ecs.ServiceConnectService {
+	var apiObjects []*ecs.ServiceConnectService
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &ecs.ServiceConnectService{
+			PortName: aws.String(tfMap["port_name"].(string)),
+		}
+
+		if v, ok := tfMap["client_alias"].([]interface{}); ok {
+			for _, tfMapRaw := range v {
+				tfMap, ok := tfMapRaw.(map[string]interface{})
+
+				if !ok {
+					continue
+				}
+
+				apiObjectAlias := &ecs.ServiceConnectClientAlias{
+					Port: aws.Int64(int64(tfMap["port"].(int))),
+				}
+
+				if v, ok := tfMap["dns_name"].(string); ok && v != "" {
+					apiObjectAlias.DnsName = aws.String(v)
+				}
+
+				apiObject.ClientAliases = append(apiObject.ClientAliases, apiObjectAlias)
+			}
+		}
+
+		if v, ok := tfMap["discovery_name"].(string); ok && v != "" {
+			apiObject.DiscoveryName = aws.String(v)
+		}
+
+		if v, ok := tfMap["ingress_port_override"].(int); ok && v != 0 {
+			apiObject.IngressPortOverride = aws.Int64(int64(v))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+// primaryDeploymentServiceConnectConfiguration returns the Service Connect configuration of the service's primary deployment.
+func primaryDeploymentServiceConnectConfiguration(service *ecs.Service) *ecs.ServiceConnectConfiguration {
+	for _, v := range service.Deployments {
+		if aws.StringValue(v.Status) == serviceDeploymentStatusPrimary {
+			return v.ServiceConnectConfiguration
+		}
+	}
+
+	return nil
+}
+
+// flattenServiceConnectConfiguration flattens a deployment's Service Connect configuration.
+// namespace is the configured namespace, which is kept if it is a name and an ARN is returned.
+func flattenServiceConnectConfiguration(apiObject *ecs.ServiceConnectConfiguration, namespace string) []interface{} {
+	// A service whose Service Connect configuration has been removed reports it as disabled.
+	if apiObject == nil || (!aws.BoolValue(apiObject.Enabled) && aws.StringValue(apiObject.Namespace) == "" && len(apiObject.Services) == 0) {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"enabled":   aws.BoolValue(apiObject.Enabled),
+		"namespace": aws.StringValue(apiObject.Namespace),
+	}
+
+	// The namespace can be specified by name but is returned as an ARN.
+	if namespace != "" && !arn.IsARN(namespace) && arn.IsARN(aws.StringValue(apiObject.Namespace)) {
+		tfMap["namespace"] = namespace
+	}
+
+	if v := apiObject.LogConfiguration; v != nil {
+		tfMapLog := map[string]interface{}{
+			"log_driver": aws.StringValue(v.LogDriver),
+			"options":    aws.StringValueMap(v.Options),
+		}
+
+		var tfList []interface{}
+
+		for _, v := range v.SecretOptions {
+			tfList = append(tfList, map[string]interface{}{
+				"name":       aws.StringValue(v.Name),
+				"value_from": aws.StringValue(v.ValueFrom),
+			})
+		}
+
+		tfMapLog["secret_option"] = tfList
+		tfMap["log_configuration"] = []interface{}{tfMapLog}
+	}
+
+	var tfList []interface{}
+
+	for _, v := range apiObject.Services {
+		tfMapService := map[string]interface{}{
+			"discovery_name":        aws.StringValue(v.DiscoveryName),
+			"ingress_port_override": int(aws.Int64Value(v.IngressPortOverride)),
+			"port_name":             aws.StringValue(v.PortName),
+		}
+
+		var tfListAlias []interface{}
+
+		for _, v := range v.ClientAliases {
+			tfListAlias = append(tfListAlias, map[string]interface{}{
+				"dns_name": aws.StringValue(v.DnsName),
+				"port":     int(aws.Int64Value(v.Port)),
+			})
+		}
+
+		tfMapService["client_alias"] = tfListAlias
+		tfList = append(tfList, tfMapService)
+	}
+
+	tfMap["service"] = tfList
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,114 @@
+package ecs
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ecs"
+)
+
+// testECSConn returns an ECS client whose requests are served by a test server that records each request body
+// and responds with the specified body.
+func testECSConn(t *testing.T, response string, body *[]byte) *ecs.ECS {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*body, _ = io.ReadAll(r.Body)
+
+		w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+		io.WriteString(w, response)
+	}))
+	t.Cleanup(server.Close)
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("AKID", "SECRET", ""),
+		Endpoint:    aws.String(server.URL),
+		Region:      aws.String("us-west-2"),
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	return ecs.New(sess)
+}
+
+func TestFindServiceServiceConnectConfiguration(t *testing.T) {
+	var body []byte
+	conn := testECSConn(t, `{
+  "services": [{
+    "serviceName": "test",
+    "status": "ACTIVE",
+    "deploymentConfiguration": {
+      "alarms": {"alarmNames": ["errors"], "enable": true, "rollback": true},
+      "maximumPercent": 200
+    },
+    "deployments": [
+      {"id": "ecs-svc/2", "status": "PRIMARY", "serviceConnectConfiguration": {"enabled": true, "namespace": "arn:aws:servicediscovery:us-west-2:123456789012:namespace/ns-test", "services": [{"portName": "http", "discoveryName": "http", "clientAliases": [{"port": 8080, "dnsName": "http.test"}]}]}},
+      {"id": "ecs-svc/1", "status": "ACTIVE", "serviceConnectConfiguration": {"enabled": false}}
+    ]
+  }]
+}`, &body)
+
+	service, err := FindServiceNoTagsByID(context.Background(), conn, "test", "test")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := aws.Int64Value(service.DeploymentConfiguration.MaximumPercent), int64(200); got != expected {
+		t.Errorf("got maximum percent %d, expected %d", got, expected)
+	}
+
+	alarms := flattenDeploymentAlarms(service.DeploymentConfiguration.Alarms)
+
+	if len(alarms) != 1 {
+		t.Fatalf("got %d alarms blocks, expected 1", len(alarms))
+	}
+
+	if got, expected := alarms[0].(map[string]interface{})["rollback"], true; got != expected {
+		t.Errorf("got rollback %v, expected %v", got, expected)
+	}
+
+	serviceConnect := flattenServiceConnectConfiguration(primaryDeploymentServiceConnectConfiguration(service), "test")
+
+	if len(serviceConnect) != 1 {
+		t.Fatalf("got %d service_connect_configuration blocks, expected 1", len(serviceConnect))
+	}
+
+	tfMap := serviceConnect[0].(map[string]interface{})
+
+	if got, expected := tfMap["namespace"], "test"; got != expected {
+		t.Errorf("got namespace %v, expected %v", got, expected)
+	}
+
+	expectedServices := []interface{}{
+		map[string]interface{}{
+			"client_alias": []interface{}{
+				map[string]interface{}{"dns_name": "http.test", "port": 8080},
+			},
+			"discovery_name":        "http",
+			"ingress_port_override": 0,
+			"port_name":             "http",
+		},
+	}
+
+	if got := tfMap["service"]; !reflect.DeepEqual(got, expectedServices) {
+		t.Errorf("got services %v, expected %v", got, expectedServices)
+	}
+}
+
+func TestFlattenServiceConnectConfigurationDisabled(t *testing.T) {
+	if got := flattenServiceConnectConfiguration(&ecs.ServiceConnectConfiguration{Enabled: aws.Bool(false)}, ""); got != nil {
+		t.Errorf("got %v, expected nil", got)
+	}
+
+	if got := flattenDeploymentAlarms(&ecs.DeploymentAlarms{AlarmNames: aws.StringSlice([]string{}), Enable: aws.Bool(false)}); got != nil {
+		t.Errorf("got %v, expected nil", got)
+	}
+}
@@ -17,6 +17,8 @@ const (
 	serviceStatusPending = "tfPENDING"
 	serviceStatusStable  = "tfSTABLE"
 
+	serviceDeploymentStatusPrimary = "PRIMARY"
+
 	clusterStatusError = "ERROR"
 	clusterStatusNone  = "NONE"
 
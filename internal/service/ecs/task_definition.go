@@ -59,10 +59,13 @@ func ResourceTaskDefinition() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"container": taskDefinitionContainerSchema(),
 			"container_definitions": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"container", "container_definitions"},
 				StateFunc: func(v interface{}) string {
 					// Sort the lists of environment variables as they are serialized to state, so we won't get
 					// spurious reorderings in plans (diff is suppressed if the environment variables haven't changed,
@@ -434,10 +437,16 @@ func resourceTaskDefinitionCreate(d *schema.ResourceData, meta interface{}) erro
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
-	rawDefinitions := d.Get("container_definitions").(string)
-	definitions, err := expandContainerDefinitions(rawDefinitions)
-	if err != nil {
-		return err
+	var definitions []*ecs.ContainerDefinition
+
+	if v, ok := d.GetOk("container"); ok && len(v.([]interface{})) > 0 {
+		definitions = expandTaskDefinitionContainers(v.([]interface{}))
+	} else {
+		var err error
+		definitions, err = expandContainerDefinitions(d.Get("container_definitions").(string))
+		if err != nil {
+			return err
+		}
 	}
 
 	input := ecs.RegisterTaskDefinitionInput{
@@ -611,6 +620,13 @@ func resourceTaskDefinitionRead(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
+	// Containers are only flattened into the container block when the task definition was created from it.
+	if v, ok := d.GetOk("container"); ok && len(v.([]interface{})) > 0 {
+		if err := d.Set("container", flattenTaskDefinitionContainers(taskDefinition.ContainerDefinitions)); err != nil {
+			return fmt.Errorf("error setting container: %w", err)
+		}
+	}
+
 	d.Set("task_role_arn", taskDefinition.TaskRoleArn)
 	d.Set("execution_role_arn", taskDefinition.ExecutionRoleArn)
 	d.Set("cpu", taskDefinition.Cpu)
@@ -0,0 +1,463 @@
+package ecs
+
+import (
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+// taskDefinitionContainerSchema returns the schema of the container block, a structured alternative to
+// the container_definitions JSON. Task definitions are immutable, so every attribute forces a new revision.
+func taskDefinitionContainerSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeList,
+		Optional:     true,
+		ForceNew:     true,
+		ExactlyOneOf: []string{"container", "container_definitions"},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"command": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"cpu": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				"entry_point": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"environment": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					ForceNew: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"name": {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+							},
+							"value": {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+							},
+						},
+					},
+				},
+				"essential": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					ForceNew: true,
+					Default:  true,
+				},
+				"health_check": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"command": {
+								Type:     schema.TypeList,
+								Required: true,
+								ForceNew: true,
+								MinItems: 1,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"interval": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ForceNew:     true,
+								Default:      30,
+								ValidateFunc: validation.IntBetween(5, 300),
+							},
+							"retries": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ForceNew:     true,
+								Default:      3,
+								ValidateFunc: validation.IntBetween(1, 10),
+							},
+							"start_period": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.IntBetween(0, 300),
+							},
+							"timeout": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ForceNew:     true,
+								Default:      5,
+								ValidateFunc: validation.IntBetween(2, 120),
+							},
+						},
+					},
+				},
+				"image": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringLenBetween(1, 255),
+				},
+				"log_configuration": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"log_driver": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringInSlice(ecs.LogDriver_Values(), false),
+							},
+							"options": {
+								Type:     schema.TypeMap,
+								Optional: true,
+								ForceNew: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"secret_option": {
+								Type:     schema.TypeSet,
+								Optional: true,
+								ForceNew: true,
+								Elem:     taskDefinitionContainerSecretResource(),
+							},
+						},
+					},
+				},
+				"memory": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntAtLeast(4),
+				},
+				"memory_reservation": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntAtLeast(4),
+				},
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+					ValidateFunc: validation.All(
+						validation.StringLenBetween(1, 255),
+						validation.StringMatch(regexp.MustCompile(`^[0-9A-Za-z_-]+$`), "must contain only alphanumeric characters, hyphens and underscores"),
+					),
+				},
+				"port_mapping": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"app_protocol": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringInSlice(ecs.ApplicationProtocol_Values(), false),
+							},
+							"container_port": {
+								Type:         schema.TypeInt,
+								Required:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.IsPortNumber,
+							},
+							// In awsvpc network mode the host port is always the container port.
+							"host_port": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.IsPortNumberOrZero,
+							},
+							"name": {
+								Type:     schema.TypeString,
+								Optional: true,
+								ForceNew: true,
+							},
+							"protocol": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ForceNew:     true,
+								Default:      ecs.TransportProtocolTcp,
+								ValidateFunc: validation.StringInSlice(ecs.TransportProtocol_Values(), false),
+							},
+						},
+					},
+				},
+				"secret": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					ForceNew: true,
+					Elem:     taskDefinitionContainerSecretResource(),
+				},
+				"user": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ForceNew: true,
+				},
+				"working_directory": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ForceNew: true,
+				},
+			},
+		},
+	}
+}
+
+func taskDefinitionContainerSecretResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			// The full ARN of a Secrets Manager secret or SSM parameter, or the name of an SSM parameter in the same Region.
+			"value_from": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func expandTaskDefinitionContainers(tfList []interface{}) []*ecs.ContainerDefinition {
+	var apiObjects []*ecs.ContainerDefinition
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &ecs.ContainerDefinition{
+			Essential: aws.Bool(tfMap["essential"].(bool)),
+			Image:     aws.String(tfMap["image"].(string)),
+			Name:      aws.String(tfMap["name"].(string)),
+		}
+
+		if v, ok := tfMap["command"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Command = flex.ExpandStringList(v)
+		}
+
+		if v, ok := tfMap["cpu"].(int); ok && v != 0 {
+			apiObject.Cpu = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["entry_point"].([]interface{}); ok && len(v) > 0 {
+			apiObject.EntryPoint = flex.ExpandStringList(v)
+		}
+
+		if v, ok := tfMap["environment"].(*schema.Set); ok && v.Len() > 0 {
+			for _, tfMapRaw := range v.List() {
+				tfMap := tfMapRaw.(map[string]interface{})
+
+				apiObject.Environment = append(apiObject.Environment, &ecs.KeyValuePair{
+					Name:  aws.String(tfMap["name"].(string)),
+					Value: aws.String(tfMap["value"].(string)),
+				})
+			}
+		}
+
+		if v, ok := tfMap["health_check"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.HealthCheck = &ecs.HealthCheck{
+				Command:  flex.ExpandStringList(tfMap["command"].([]interface{})),
+				Interval: aws.Int64(int64(tfMap["interval"].(int))),
+				Retries:  aws.Int64(int64(tfMap["retries"].(int))),
+				Timeout:  aws.Int64(int64(tfMap["timeout"].(int))),
+			}
+
+			if v, ok := tfMap["start_period"].(int); ok && v != 0 {
+				apiObject.HealthCheck.StartPeriod = aws.Int64(int64(v))
+			}
+		}
+
+		if v, ok := tfMap["log_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.LogConfiguration = &ecs.LogConfiguration{
+				LogDriver: aws.String(tfMap["log_driver"].(string)),
+			}
+
+			if v, ok := tfMap["options"].(map[string]interface{}); ok && len(v) > 0 {
+				apiObject.LogConfiguration.Options = flex.ExpandStringMap(v)
+			}
+
+			if v, ok := tfMap["secret_option"].(*schema.Set); ok && v.Len() > 0 {
+				apiObject.LogConfiguration.SecretOptions = expandTaskDefinitionContainerSecrets(v.List())
+			}
+		}
+
+		if v, ok := tfMap["memory"].(int); ok && v != 0 {
+			apiObject.Memory = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["memory_reservation"].(int); ok && v != 0 {
+			apiObject.MemoryReservation = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["port_mapping"].([]interface{}); ok {
+			for _, tfMapRaw := range v {
+				tfMap, ok := tfMapRaw.(map[string]interface{})
+
+				if !ok {
+					continue
+				}
+
+				portMapping := &ecs.PortMapping{
+					ContainerPort: aws.Int64(int64(tfMap["container_port"].(int))),
+					Protocol:      aws.String(tfMap["protocol"].(string)),
+				}
+
+				if v, ok := tfMap["app_protocol"].(string); ok && v != "" {
+					portMapping.AppProtocol = aws.String(v)
+				}
+
+				if v, ok := tfMap["host_port"].(int); ok && v != 0 {
+					portMapping.HostPort = aws.Int64(int64(v))
+				}
+
+				if v, ok := tfMap["name"].(string); ok && v != "" {
+					portMapping.Name = aws.String(v)
+				}
+
+				apiObject.PortMappings = append(apiObject.PortMappings, portMapping)
+			}
+		}
+
+		if v, ok := tfMap["secret"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.Secrets = expandTaskDefinitionContainerSecrets(v.List())
+		}
+
+		if v, ok := tfMap["user"].(string); ok && v != "" {
+			apiObject.User = aws.String(v)
+		}
+
+		if v, ok := tfMap["working_directory"].(string); ok && v != "" {
+			apiObject.WorkingDirectory = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandTaskDefinitionContainerSecrets(tfList []interface{}) []*ecs.Secret {
+	var apiObjects []*ecs.Secret
+
+	for _, tfMapRaw := range tfList {
+		tfMap := tfMapRaw.(map[string]interface{})
+
+		apiObjects = append(apiObjects, &ecs.Secret{
+			Name:      aws.String(tfMap["name"].(string)),
+			ValueFrom: aws.String(tfMap["value_from"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenTaskDefinitionContainers(apiObjects []*ecs.ContainerDefinition) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"command":            aws.StringValueSlice(apiObject.Command),
+			"cpu":                aws.Int64Value(apiObject.Cpu),
+			"entry_point":        aws.StringValueSlice(apiObject.EntryPoint),
+			"essential":          aws.BoolValue(apiObject.Essential),
+			"image":              aws.StringValue(apiObject.Image),
+			"memory":             aws.Int64Value(apiObject.Memory),
+			"memory_reservation": aws.Int64Value(apiObject.MemoryReservation),
+			"name":               aws.StringValue(apiObject.Name),
+			"user":               aws.StringValue(apiObject.User),
+			"working_directory":  aws.StringValue(apiObject.WorkingDirectory),
+		}
+
+		var environment []interface{}
+
+		for _, v := range apiObject.Environment {
+			environment = append(environment, map[string]interface{}{
+				"name":  aws.StringValue(v.Name),
+				"value": aws.StringValue(v.Value),
+			})
+		}
+
+		tfMap["environment"] = environment
+
+		if v := apiObject.HealthCheck; v != nil {
+			tfMap["health_check"] = []interface{}{map[string]interface{}{
+				"command":      aws.StringValueSlice(v.Command),
+				"interval":     aws.Int64Value(v.Interval),
+				"retries":      aws.Int64Value(v.Retries),
+				"start_period": aws.Int64Value(v.StartPeriod),
+				"timeout":      aws.Int64Value(v.Timeout),
+			}}
+		}
+
+		if v := apiObject.LogConfiguration; v != nil {
+			tfMap["log_configuration"] = []interface{}{map[string]interface{}{
+				"log_driver":    aws.StringValue(v.LogDriver),
+				"options":       aws.StringValueMap(v.Options),
+				"secret_option": flattenTaskDefinitionContainerSecrets(v.SecretOptions),
+			}}
+		}
+
+		var tfListPortMappings []interface{}
+
+		for _, v := range apiObject.PortMappings {
+			tfListPortMappings = append(tfListPortMappings, map[string]interface{}{
+				"app_protocol":   aws.StringValue(v.AppProtocol),
+				"container_port": aws.Int64Value(v.ContainerPort),
+				"host_port":      aws.Int64Value(v.HostPort),
+				"name":           aws.StringValue(v.Name),
+				"protocol":       aws.StringValue(v.Protocol),
+			})
+		}
+
+		tfMap["port_mapping"] = tfListPortMappings
+		tfMap["secret"] = flattenTaskDefinitionContainerSecrets(apiObject.Secrets)
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenTaskDefinitionContainerSecrets(apiObjects []*ecs.Secret) []interface{} {
+	var tfList []interface{}
+
+	for _, v := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"name":       aws.StringValue(v.Name),
+			"value_from": aws.StringValue(v.ValueFrom),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,118 @@
+package ecs
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestTaskDefinitionContainersRoundTrip(t *testing.T) {
+	raw := map[string]interface{}{
+		"family": "test",
+		"container": []interface{}{
+			map[string]interface{}{
+				"name":    "web",
+				"image":   "nginx:latest",
+				"command": []interface{}{"nginx", "-g", "daemon off;"},
+				"memory":  128,
+				"environment": []interface{}{
+					map[string]interface{}{"name": "B", "value": "2"},
+					map[string]interface{}{"name": "A", "value": "1"},
+				},
+				"health_check": []interface{}{
+					map[string]interface{}{
+						"command": []interface{}{"CMD-SHELL", "curl -f http://localhost/ || exit 1"},
+					},
+				},
+				"log_configuration": []interface{}{
+					map[string]interface{}{
+						"log_driver": ecs.LogDriverAwslogs,
+						"options":    map[string]interface{}{"awslogs-group": "test"},
+					},
+				},
+				"port_mapping": []interface{}{
+					map[string]interface{}{
+						"container_port": 80,
+						"name":           "http",
+						"app_protocol":   ecs.ApplicationProtocolHttp,
+					},
+				},
+				"secret": []interface{}{
+					map[string]interface{}{"name": "TOKEN", "value_from": "arn:aws:ssm:us-west-2:123456789012:parameter/token"},
+				},
+			},
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, ResourceTaskDefinition().Schema, raw)
+	tfList := d.Get("container").([]interface{})
+
+	apiObjects := expandTaskDefinitionContainers(tfList)
+
+	if len(apiObjects) != 1 {
+		t.Fatalf("got %d containers, expected 1", len(apiObjects))
+	}
+
+	apiObject := apiObjects[0]
+
+	if got, expected := aws.BoolValue(apiObject.Essential), true; got != expected {
+		t.Errorf("got essential %t, expected %t", got, expected)
+	}
+
+	if got, expected := aws.Int64Value(apiObject.HealthCheck.Interval), int64(30); got != expected {
+		t.Errorf("got health check interval %d, expected %d", got, expected)
+	}
+
+	if got, expected := aws.StringValue(apiObject.PortMappings[0].Protocol), ecs.TransportProtocolTcp; got != expected {
+		t.Errorf("got port mapping protocol %s, expected %s", got, expected)
+	}
+
+	if got, expected := aws.StringValue(apiObject.PortMappings[0].Name), "http"; got != expected {
+		t.Errorf("got port mapping name %s, expected %s", got, expected)
+	}
+
+	if got, expected := aws.StringValue(apiObject.PortMappings[0].AppProtocol), ecs.ApplicationProtocolHttp; got != expected {
+		t.Errorf("got port mapping app protocol %s, expected %s", got, expected)
+	}
+
+	if err := d.Set("container", flattenTaskDefinitionContainers(apiObjects)); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got := expandTaskDefinitionContainers(d.Get("container").([]interface{})); !reflect.DeepEqual(got, apiObjects) {
+		t.Errorf("got %v, expected %v", got, apiObjects)
+	}
+}
+
+func TestExpandContainerDefinitionsPortMappings(t *testing.T) {
+	definitions, err := expandContainerDefinitions(`[
+  {"name": "web", "image": "nginx", "portMappings": [{"containerPort": 80, "name": "http", "appProtocol": "http"}, {"containerPort": 443}]},
+  {"name": "sidecar", "image": "envoy"}
+]`)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(definitions) != 2 {
+		t.Fatalf("got %d container definitions, expected 2", len(definitions))
+	}
+
+	expected := []*ecs.PortMapping{
+		{
+			AppProtocol:   aws.String(ecs.ApplicationProtocolHttp),
+			ContainerPort: aws.Int64(80),
+			Name:          aws.String("http"),
+		},
+		{
+			ContainerPort: aws.Int64(443),
+		},
+	}
+
+	if got := definitions[0].PortMappings; !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %v, expected %v", got, expected)
+	}
+}
@@ -1019,6 +1019,59 @@ func TestAccECSTaskDefinition_invalidContainerDefinition(t *testing.T) {
 	})
 }
 
+func TestAccECSTaskDefinition_container(t *testing.T) {
+	var def ecs.TaskDefinition
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_task_definition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecs.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTaskDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaskDefinitionConfig_container(rName, "1.23"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskDefinitionExists(resourceName, &def),
+					resource.TestCheckResourceAttr(resourceName, "container.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "container.0.name", "web"),
+					resource.TestCheckResourceAttr(resourceName, "container.0.image", "nginx:1.23"),
+					resource.TestCheckResourceAttr(resourceName, "container.0.essential", "true"),
+					resource.TestCheckResourceAttr(resourceName, "container.0.environment.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "container.0.health_check.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "container.0.health_check.0.interval", "30"),
+					resource.TestCheckResourceAttr(resourceName, "container.0.log_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "container.0.log_configuration.0.log_driver", "awslogs"),
+					resource.TestCheckResourceAttr(resourceName, "container.0.port_mapping.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "container.0.port_mapping.0.container_port", "80"),
+					resource.TestCheckResourceAttr(resourceName, "container.0.port_mapping.0.host_port", "80"),
+					resource.TestCheckResourceAttr(resourceName, "container.0.port_mapping.0.name", "http"),
+					resource.TestCheckResourceAttr(resourceName, "container.0.port_mapping.0.app_protocol", "http"),
+					resource.TestCheckResourceAttr(resourceName, "container.0.port_mapping.0.protocol", "tcp"),
+					resource.TestCheckResourceAttrSet(resourceName, "container_definitions"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccTaskDefinitionImportStateIdFunc(resourceName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"container", "skip_destroy"},
+			},
+			{
+				Config: testAccTaskDefinitionConfig_container(rName, "1.24"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskDefinitionExists(resourceName, &def),
+					resource.TestCheckResourceAttr(resourceName, "container.0.image", "nginx:1.24"),
+					resource.TestCheckResourceAttr(resourceName, "revision", "2"),
+				),
+			},
+		},
+	})
+}
+
 func testAccTaskDefinitionConfig_proxyConfiguration(rName string, containerName string, proxyType string,
 	ignoredUid string, ignoredGid string, appPorts string, proxyIngressPort string, proxyEgressPort string,
 	egressIgnoredPorts string, egressIgnoredIPs string) string {
@@ -2660,3 +2713,57 @@ TASK_DEFINITION
 }
 `, rName)
 }
+
+func testAccTaskDefinitionConfig_container(rName, imageTag string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_log_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_ecs_task_definition" "test" {
+  family                   = %[1]q
+  network_mode             = "awsvpc"
+  requires_compatibilities = ["FARGATE"]
+  cpu                      = "256"
+  memory                   = "512"
+
+  container {
+    name   = "web"
+    image  = "nginx:%[2]s"
+    memory = 256
+
+    environment {
+      name  = "A"
+      value = "1"
+    }
+
+    environment {
+      name  = "B"
+      value = "2"
+    }
+
+    health_check {
+      command = ["CMD-SHELL", "curl -f http://localhost/ || exit 1"]
+    }
+
+    log_configuration {
+      log_driver = "awslogs"
+
+      options = {
+        "awslogs-group"         = aws_cloudwatch_log_group.test.name
+        "awslogs-region"        = data.aws_region.current.name
+        "awslogs-stream-prefix" = "web"
+      }
+    }
+
+    port_mapping {
+      container_port = 80
+      name           = "http"
+      app_protocol   = "http"
+    }
+  }
+}
+
+data "aws_region" "current" {}
+`, rName, imageTag)
+}
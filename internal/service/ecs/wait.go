@@ -2,11 +2,17 @@ package ecs
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecs"
+	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -63,7 +69,7 @@ func waitCapacityProviderUpdated(conn *ecs.ECS, arn string) (*ecs.CapacityProvid
 }
 
 // waitServiceStable waits for an ECS Service to reach the status "ACTIVE" and have all desired tasks running. Does not return tags.
-func waitServiceStable(conn *ecs.ECS, id, cluster string, timeout time.Duration) (*ecs.Service, error) { //nolint:unparam
+func waitServiceStable(ctx context.Context, conn *ecs.ECS, id, cluster string, timeout time.Duration) (*ecs.Service, error) {
 	input := &ecs.DescribeServicesInput{
 		Services: aws.StringSlice([]string{id}),
 	}
@@ -79,15 +85,106 @@ func waitServiceStable(conn *ecs.ECS, id, cluster string, timeout time.Duration)
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
 	if v, ok := outputRaw.(*ecs.Service); ok {
 		return v, err
 	}
 
+	if err != nil {
+		tfresource.SetLastError(err, findServiceDeploymentsError(ctx, conn, id, cluster))
+	}
+
 	return nil, err
 }
 
+// findServiceDeploymentsError returns an error describing an ECS Service's deployments that have not completed,
+// or nil if the service cannot be read.
+func findServiceDeploymentsError(ctx context.Context, conn *ecs.ECS, id, cluster string) error {
+	service, err := FindServiceNoTagsByID(ctx, conn, id, cluster)
+
+	if err != nil {
+		return nil
+	}
+
+	tasks, err := FindServiceStoppedTasks(ctx, conn, aws.StringValue(service.ServiceName), cluster)
+
+	if err != nil {
+		log.Printf("[WARN] reading ECS Service (%s) stopped tasks: %s", id, err)
+	}
+
+	return serviceDeploymentsError(service, tasks)
+}
+
+// serviceDeploymentsError returns an error describing the service's deployments and why each deployment's tasks stopped.
+func serviceDeploymentsError(service *ecs.Service, tasks []*ecs.Task) error {
+	var errs *multierror.Error
+
+	for _, deployment := range service.Deployments {
+		id := aws.StringValue(deployment.Id)
+		message := fmt.Sprintf("%s deployment (%s): %d of %d tasks running, %d failed",
+			aws.StringValue(deployment.Status), id, aws.Int64Value(deployment.RunningCount), aws.Int64Value(deployment.DesiredCount), aws.Int64Value(deployment.FailedTasks))
+
+		if v := aws.StringValue(deployment.RolloutState); v != "" {
+			message += fmt.Sprintf(", rollout state %s", v)
+
+			if v := aws.StringValue(deployment.RolloutStateReason); v != "" {
+				message += fmt.Sprintf(": %s", v)
+			}
+		}
+
+		errs = multierror.Append(errs, errors.New(message))
+
+		// Tasks are started by their deployment.
+		reasons := make(map[string]int)
+
+		for _, task := range tasks {
+			if aws.StringValue(task.StartedBy) != id {
+				continue
+			}
+
+			reasons[stoppedTaskReason(task)]++
+		}
+
+		for _, reason := range sortedKeys(reasons) {
+			errs = multierror.Append(errs, fmt.Errorf("%s deployment (%s): %d tasks stopped: %s", aws.StringValue(deployment.Status), id, reasons[reason], reason))
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// stoppedTaskReason returns why the task stopped, including the reasons its containers stopped.
+func stoppedTaskReason(task *ecs.Task) string {
+	reason := aws.StringValue(task.StoppedReason)
+
+	if reason == "" {
+		reason = aws.StringValue(task.StopCode)
+	}
+
+	for _, container := range task.Containers {
+		if v := aws.StringValue(container.Reason); v != "" {
+			reason += fmt.Sprintf(" (container %s: %s)", aws.StringValue(container.Name), v)
+		} else if v := aws.Int64Value(container.ExitCode); v != 0 {
+			reason += fmt.Sprintf(" (container %s: exit code %d)", aws.StringValue(container.Name), v)
+		}
+	}
+
+	return reason
+}
+
+func sortedKeys(m map[string]int) []string {
+	keys := make([]string, 0, len(m))
+
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
 // waitServiceInactive waits for an ECS Service to reach the status "INACTIVE".
 func waitServiceInactive(conn *ecs.ECS, id, cluster string, timeout time.Duration) error {
 	input := &ecs.DescribeServicesInput{
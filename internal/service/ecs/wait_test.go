@@ -0,0 +1,86 @@
+package ecs
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+)
+
+func TestServiceDeploymentsError(t *testing.T) {
+	service := &ecs.Service{
+		Deployments: []*ecs.Deployment{
+			{
+				DesiredCount:       aws.Int64(2),
+				FailedTasks:        aws.Int64(3),
+				Id:                 aws.String("ecs-svc/2"),
+				RolloutState:       aws.String(ecs.DeploymentRolloutStateInProgress),
+				RolloutStateReason: aws.String("ECS deployment ecs-svc/2 in progress."),
+				RunningCount:       aws.Int64(0),
+				Status:             aws.String("PRIMARY"),
+			},
+			{
+				DesiredCount: aws.Int64(2),
+				Id:           aws.String("ecs-svc/1"),
+				RunningCount: aws.Int64(2),
+				Status:       aws.String("ACTIVE"),
+			},
+		},
+	}
+	tasks := []*ecs.Task{
+		{
+			Containers: []*ecs.Container{{
+				ExitCode: aws.Int64(1),
+				Name:     aws.String("app"),
+			}},
+			StartedBy:     aws.String("ecs-svc/2"),
+			StoppedReason: aws.String("Essential container in task exited"),
+		},
+		{
+			Containers: []*ecs.Container{{
+				ExitCode: aws.Int64(1),
+				Name:     aws.String("app"),
+			}},
+			StartedBy:     aws.String("ecs-svc/2"),
+			StoppedReason: aws.String("Essential container in task exited"),
+		},
+		{
+			Containers: []*ecs.Container{{
+				Name:   aws.String("app"),
+				Reason: aws.String("CannotPullContainerError: pull image manifest has been retried 5 time(s)"),
+			}},
+			StartedBy:     aws.String("ecs-svc/2"),
+			StoppedReason: aws.String("Task failed to start"),
+		},
+		{
+			StartedBy:     aws.String("ecs-svc/0"),
+			StoppedReason: aws.String("Scaling activity initiated by deployment ecs-svc/0"),
+		},
+	}
+
+	err := serviceDeploymentsError(service, tasks)
+
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+
+	for _, expected := range []string{
+		"PRIMARY deployment (ecs-svc/2): 0 of 2 tasks running, 3 failed, rollout state IN_PROGRESS: ECS deployment ecs-svc/2 in progress.",
+		"PRIMARY deployment (ecs-svc/2): 2 tasks stopped: Essential container in task exited (container app: exit code 1)",
+		"PRIMARY deployment (ecs-svc/2): 1 tasks stopped: Task failed to start (container app: CannotPullContainerError: pull image manifest has been retried 5 time(s))",
+		"ACTIVE deployment (ecs-svc/1): 2 of 2 tasks running, 0 failed",
+	} {
+		if !strings.Contains(err.Error(), expected) {
+			t.Errorf("expected error to contain %q, got %q", expected, err)
+		}
+	}
+
+	if strings.Contains(err.Error(), "ecs-svc/0") {
+		t.Errorf("expected error not to describe tasks of other deployments, got %q", err)
+	}
+
+	if err := serviceDeploymentsError(&ecs.Service{}, nil); err != nil {
+		t.Errorf("expected no error, got %q", err)
+	}
+}
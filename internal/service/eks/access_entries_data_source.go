@@ -0,0 +1,53 @@
+package eks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourceAccessEntries() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAccessEntriesRead,
+
+		Schema: map[string]*schema.Schema{
+			"associated_policy_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"cluster_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validClusterName,
+			},
+			"principal_arns": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceAccessEntriesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EKSConn
+
+	clusterName := d.Get("cluster_name").(string)
+
+	principalARNs, err := FindAccessEntriesByClusterName(ctx, conn, clusterName, d.Get("associated_policy_arn").(string))
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing EKS Access Entries (%s): %w", clusterName, err))
+	}
+
+	d.SetId(clusterName)
+
+	d.Set("principal_arns", principalARNs)
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package eks_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/eks"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccEKSAccessEntriesDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceResourceName := "data.aws_eks_access_entries.test"
+	associatedDataSourceResourceName := "data.aws_eks_access_entries.associated"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, eks.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccessEntryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessEntriesDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemAttrPair(dataSourceResourceName, "principal_arns.*", "aws_eks_access_entry.test", "principal_arn"),
+					resource.TestCheckResourceAttr(associatedDataSourceResourceName, "principal_arns.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(associatedDataSourceResourceName, "principal_arns.*", "aws_eks_access_entry.test", "principal_arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAccessEntriesDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccAccessPolicyAssociationConfig_basic(rName), `
+data "aws_eks_access_entries" "test" {
+  cluster_name = aws_eks_access_entry.test.cluster_name
+}
+
+data "aws_eks_access_entries" "associated" {
+  cluster_name          = aws_eks_access_policy_association.test.cluster_name
+  associated_policy_arn = aws_eks_access_policy_association.test.policy_arn
+}
+`)
+}
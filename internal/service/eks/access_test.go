@@ -0,0 +1,118 @@
+package eks
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestExpandAccessConfigRequest(t *testing.T) {
+	apiObject := expandAccessConfigRequest([]interface{}{map[string]interface{}{
+		"authentication_mode":                         eks.AuthenticationModeApi,
+		"bootstrap_cluster_creator_admin_permissions": false,
+	}})
+
+	expected := &eks.CreateAccessConfigRequest{
+		AuthenticationMode:                      aws.String(eks.AuthenticationModeApi),
+		BootstrapClusterCreatorAdminPermissions: aws.Bool(false),
+	}
+
+	if !reflect.DeepEqual(apiObject, expected) {
+		t.Errorf("got %s, expected %s", apiObject, expected)
+	}
+
+	if apiObject := expandAccessConfigRequest(nil); apiObject != nil {
+		t.Errorf("got %s, expected nil", apiObject)
+	}
+}
+
+func TestFlattenAccessConfigResponse(t *testing.T) {
+	testCases := []struct {
+		Name                                    string
+		ApiObject                               *eks.AccessConfigResponse
+		BootstrapClusterCreatorAdminPermissions bool
+		Expected                                []interface{}
+	}{
+		{
+			Name: "nil",
+		},
+		{
+			Name: "bootstrap permissions from configuration",
+			ApiObject: &eks.AccessConfigResponse{
+				AuthenticationMode: aws.String(eks.AuthenticationModeApiAndConfigMap),
+			},
+			BootstrapClusterCreatorAdminPermissions: true,
+			Expected: []interface{}{map[string]interface{}{
+				"authentication_mode":                         eks.AuthenticationModeApiAndConfigMap,
+				"bootstrap_cluster_creator_admin_permissions": true,
+			}},
+		},
+		{
+			Name: "bootstrap permissions from API",
+			ApiObject: &eks.AccessConfigResponse{
+				AuthenticationMode:                      aws.String(eks.AuthenticationModeApi),
+				BootstrapClusterCreatorAdminPermissions: aws.Bool(false),
+			},
+			BootstrapClusterCreatorAdminPermissions: true,
+			Expected: []interface{}{map[string]interface{}{
+				"authentication_mode":                         eks.AuthenticationModeApi,
+				"bootstrap_cluster_creator_admin_permissions": false,
+			}},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := flattenAccessConfigResponse(testCase.ApiObject, testCase.BootstrapClusterCreatorAdminPermissions)
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %v, expected %v", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestExpandAccessScope(t *testing.T) {
+	apiObject := expandAccessScope([]interface{}{map[string]interface{}{
+		"namespaces": schema.NewSet(schema.HashString, []interface{}{"default"}),
+		"type":       eks.AccessScopeTypeNamespace,
+	}})
+
+	expected := &eks.AccessScope{
+		Namespaces: aws.StringSlice([]string{"default"}),
+		Type:       aws.String(eks.AccessScopeTypeNamespace),
+	}
+
+	if !reflect.DeepEqual(apiObject, expected) {
+		t.Errorf("got %s, expected %s", apiObject, expected)
+	}
+}
+
+func TestFlattenAccessScope(t *testing.T) {
+	got := flattenAccessScope(&eks.AccessScope{
+		Type: aws.String(eks.AccessScopeTypeCluster),
+	})
+
+	expected := []interface{}{map[string]interface{}{
+		"namespaces": []string{},
+		"type":       eks.AccessScopeTypeCluster,
+	}}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %v, expected %v", got, expected)
+	}
+}
+
+func TestAuthenticationModeOrder(t *testing.T) {
+	if !(authenticationModeOrder(eks.AuthenticationModeConfigMap) < authenticationModeOrder(eks.AuthenticationModeApiAndConfigMap) &&
+		authenticationModeOrder(eks.AuthenticationModeApiAndConfigMap) < authenticationModeOrder(eks.AuthenticationModeApi)) {
+		t.Error("expected authentication modes to be ordered CONFIG_MAP, API_AND_CONFIG_MAP, API")
+	}
+
+	if got := authenticationModeOrder(""); got >= authenticationModeOrder(eks.AuthenticationModeConfigMap) {
+		t.Errorf("got order %d for an unknown authentication mode, expected it before CONFIG_MAP", got)
+	}
+}
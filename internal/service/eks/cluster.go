@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/eks"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -23,10 +24,10 @@ import (
 
 func ResourceCluster() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceClusterCreate,
-		Read:   resourceClusterRead,
-		Update: resourceClusterUpdate,
-		Delete: resourceClusterDelete,
+		CreateWithoutTimeout: resourceClusterCreate,
+		ReadWithoutTimeout:   resourceClusterRead,
+		UpdateWithoutTimeout: resourceClusterUpdate,
+		DeleteWithoutTimeout: resourceClusterDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -37,6 +38,14 @@ func ResourceCluster() *schema.Resource {
 				// You cannot disable envelope encryption after enabling it. This action is irreversible.
 				return len(old.([]interface{})) == 1 && len(new.([]interface{})) == 0
 			}),
+			customdiff.ValidateChange("access_config.0.authentication_mode", func(_ context.Context, old, new, meta interface{}) error {
+				// The authentication mode can only be changed from CONFIG_MAP to API_AND_CONFIG_MAP to API.
+				if o, n := old.(string), new.(string); n != "" && authenticationModeOrder(o) > authenticationModeOrder(n) {
+					return fmt.Errorf("access_config.0.authentication_mode can't be changed from %s to %s; it can only be changed from %s to %s to %s", o, n, eks.AuthenticationModeConfigMap, eks.AuthenticationModeApiAndConfigMap, eks.AuthenticationModeApi)
+				}
+
+				return nil
+			}),
 		),
 
 		Timeouts: &schema.ResourceTimeout{
@@ -46,6 +55,28 @@ func ResourceCluster() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"access_config": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"authentication_mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(eks.AuthenticationMode_Values(), false),
+						},
+						"bootstrap_cluster_creator_admin_permissions": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+							Default:  true,
+						},
+					},
+				},
+			},
 			"arn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -266,7 +297,7 @@ func ResourceCluster() *schema.Resource {
 	}
 }
 
-func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).EKSConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
@@ -296,12 +327,16 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 		input.Tags = Tags(tags.IgnoreAWS())
 	}
 
+	if v, ok := d.GetOk("access_config"); ok {
+		input.AccessConfig = expandAccessConfigRequest(v.([]interface{}))
+	}
+
 	log.Printf("[DEBUG] Creating EKS Cluster: %s", input)
 	var output *eks.CreateClusterOutput
-	err := resource.Retry(propagationTimeout, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, propagationTimeout, func() *resource.RetryError {
 		var err error
 
-		output, err = conn.CreateCluster(input)
+		output, err = conn.CreateClusterWithContext(ctx, input)
 
 		// InvalidParameterException: roleArn, arn:aws:iam::123456789012:role/XXX, does not exist
 		if tfawserr.ErrMessageContains(err, eks.ErrCodeInvalidParameterException, "does not exist") {
@@ -335,30 +370,30 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 	})
 
 	if tfresource.TimedOut(err) {
-		output, err = conn.CreateCluster(input)
+		output, err = conn.CreateClusterWithContext(ctx, input)
 	}
 
 	if err != nil {
-		return fmt.Errorf("error creating EKS Cluster (%s): %w", name, err)
+		return diag.Errorf("error creating EKS Cluster (%s): %s", name, err)
 	}
 
 	d.SetId(aws.StringValue(output.Cluster.Name))
 
-	_, err = waitClusterCreated(conn, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = waitClusterCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
-		return fmt.Errorf("error waiting for EKS Cluster (%s) to create: %w", d.Id(), err)
+		return diag.Errorf("error waiting for EKS Cluster (%s) to create: %s", d.Id(), err)
 	}
 
-	return resourceClusterRead(d, meta)
+	return resourceClusterRead(ctx, d, meta)
 }
 
-func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
+func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).EKSConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
-	cluster, err := FindClusterByName(conn, d.Id())
+	cluster, err := FindClusterByName(ctx, conn, d.Id())
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] EKS Cluster (%s) not found, removing from state", d.Id())
@@ -367,37 +402,48 @@ func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if err != nil {
-		return fmt.Errorf("error reading EKS Cluster (%s): %w", d.Id(), err)
+		return diag.Errorf("error reading EKS Cluster (%s): %s", d.Id(), err)
+	}
+
+	// The bootstrap permissions are only used when the cluster is created.
+	bootstrapClusterCreatorAdminPermissions := d.Get("access_config.0.bootstrap_cluster_creator_admin_permissions")
+
+	if _, ok := d.GetOk("access_config"); !ok {
+		bootstrapClusterCreatorAdminPermissions = true
+	}
+
+	if err := d.Set("access_config", flattenAccessConfigResponse(cluster.AccessConfig, bootstrapClusterCreatorAdminPermissions.(bool))); err != nil {
+		return diag.Errorf("error setting access_config: %s", err)
 	}
 
 	d.Set("arn", cluster.Arn)
 
 	if err := d.Set("certificate_authority", flattenCertificate(cluster.CertificateAuthority)); err != nil {
-		return fmt.Errorf("error setting certificate_authority: %w", err)
+		return diag.Errorf("error setting certificate_authority: %s", err)
 	}
 
 	d.Set("created_at", aws.TimeValue(cluster.CreatedAt).String())
 
 	if err := d.Set("enabled_cluster_log_types", flattenEnabledLogTypes(cluster.Logging)); err != nil {
-		return fmt.Errorf("error setting enabled_cluster_log_types: %w", err)
+		return diag.Errorf("error setting enabled_cluster_log_types: %s", err)
 	}
 
 	if err := d.Set("encryption_config", flattenEncryptionConfig(cluster.EncryptionConfig)); err != nil {
-		return fmt.Errorf("error setting encryption_config: %w", err)
+		return diag.Errorf("error setting encryption_config: %s", err)
 	}
 
 	d.Set("endpoint", cluster.Endpoint)
 
 	if err := d.Set("identity", flattenIdentity(cluster.Identity)); err != nil {
-		return fmt.Errorf("error setting identity: %w", err)
+		return diag.Errorf("error setting identity: %s", err)
 	}
 
 	if err := d.Set("kubernetes_network_config", flattenNetworkConfig(cluster.KubernetesNetworkConfig)); err != nil {
-		return fmt.Errorf("error setting kubernetes_network_config: %w", err)
+		return diag.Errorf("error setting kubernetes_network_config: %s", err)
 	}
 
 	if err := d.Set("outpost_config", flattenOutpostConfig(cluster.OutpostConfig)); err != nil {
-		return fmt.Errorf("error setting outpost_config: %w", err)
+		return diag.Errorf("error setting outpost_config: %s", err)
 	}
 
 	d.Set("name", cluster.Name)
@@ -407,24 +453,24 @@ func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("version", cluster.Version)
 
 	if err := d.Set("vpc_config", flattenVPCConfigResponse(cluster.ResourcesVpcConfig)); err != nil {
-		return fmt.Errorf("error setting vpc_config: %w", err)
+		return diag.Errorf("error setting vpc_config: %s", err)
 	}
 
 	tags := KeyValueTags(cluster.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
 	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
-		return fmt.Errorf("error setting tags: %w", err)
+		return diag.Errorf("error setting tags: %s", err)
 	}
 
 	if err := d.Set("tags_all", tags.Map()); err != nil {
-		return fmt.Errorf("error setting tags_all: %w", err)
+		return diag.Errorf("error setting tags_all: %s", err)
 	}
 
 	return nil
 }
 
-func resourceClusterUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).EKSConn
 
 	// Do any version update first.
@@ -435,10 +481,10 @@ func resourceClusterUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		log.Printf("[DEBUG] Updating EKS Cluster (%s) version: %s", d.Id(), input)
-		output, err := conn.UpdateClusterVersion(input)
+		output, err := conn.UpdateClusterVersionWithContext(ctx, input)
 
 		if err != nil {
-			return fmt.Errorf("error updating EKS Cluster (%s) version: %w", d.Id(), err)
+			return diag.Errorf("error updating EKS Cluster (%s) version: %s", d.Id(), err)
 		}
 
 		updateID := aws.StringValue(output.Update.Id)
@@ -446,7 +492,7 @@ func resourceClusterUpdate(d *schema.ResourceData, meta interface{}) error {
 		_, err = waitClusterUpdateSuccessful(conn, d.Id(), updateID, d.Timeout(schema.TimeoutUpdate))
 
 		if err != nil {
-			return fmt.Errorf("error waiting for EKS Cluster (%s) version update (%s): %w", d.Id(), updateID, err)
+			return diag.Errorf("error waiting for EKS Cluster (%s) version update (%s): %s", d.Id(), updateID, err)
 		}
 	}
 
@@ -460,10 +506,10 @@ func resourceClusterUpdate(d *schema.ResourceData, meta interface{}) error {
 			}
 
 			log.Printf("[DEBUG] Associating EKS Cluster (%s) encryption config: %s", d.Id(), input)
-			output, err := conn.AssociateEncryptionConfig(input)
+			output, err := conn.AssociateEncryptionConfigWithContext(ctx, input)
 
 			if err != nil {
-				return fmt.Errorf("error associating EKS Cluster (%s) encryption config: %w", d.Id(), err)
+				return diag.Errorf("error associating EKS Cluster (%s) encryption config: %s", d.Id(), err)
 			}
 
 			updateID := aws.StringValue(output.Update.Id)
@@ -471,11 +517,35 @@ func resourceClusterUpdate(d *schema.ResourceData, meta interface{}) error {
 			_, err = waitClusterUpdateSuccessful(conn, d.Id(), updateID, d.Timeout(schema.TimeoutUpdate))
 
 			if err != nil {
-				return fmt.Errorf("error waiting for EKS Cluster (%s) encryption config association (%s): %w", d.Id(), updateID, err)
+				return diag.Errorf("error waiting for EKS Cluster (%s) encryption config association (%s): %s", d.Id(), updateID, err)
 			}
 		}
 	}
 
+	if d.HasChange("access_config.0.authentication_mode") {
+		input := &eks.UpdateClusterConfigInput{
+			AccessConfig: &eks.UpdateAccessConfigRequest{
+				AuthenticationMode: aws.String(d.Get("access_config.0.authentication_mode").(string)),
+			},
+			Name: aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating EKS Cluster (%s) authentication mode: %s", d.Id(), input)
+		output, err := conn.UpdateClusterConfigWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating EKS Cluster (%s) authentication mode: %s", d.Id(), err)
+		}
+
+		updateID := aws.StringValue(output.Update.Id)
+
+		_, err = waitClusterUpdateSuccessful(conn, d.Id(), updateID, d.Timeout(schema.TimeoutUpdate))
+
+		if err != nil {
+			return diag.Errorf("error waiting for EKS Cluster (%s) authentication mode update (%s): %s", d.Id(), updateID, err)
+		}
+	}
+
 	if d.HasChange("enabled_cluster_log_types") {
 		input := &eks.UpdateClusterConfigInput{
 			Logging: expandLoggingTypes(d.Get("enabled_cluster_log_types").(*schema.Set)),
@@ -483,10 +553,10 @@ func resourceClusterUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		log.Printf("[DEBUG] Updating EKS Cluster (%s) logging: %s", d.Id(), input)
-		output, err := conn.UpdateClusterConfig(input)
+		output, err := conn.UpdateClusterConfigWithContext(ctx, input)
 
 		if err != nil {
-			return fmt.Errorf("error updating EKS Cluster (%s) logging: %w", d.Id(), err)
+			return diag.Errorf("error updating EKS Cluster (%s) logging: %s", d.Id(), err)
 		}
 
 		updateID := aws.StringValue(output.Update.Id)
@@ -494,7 +564,7 @@ func resourceClusterUpdate(d *schema.ResourceData, meta interface{}) error {
 		_, err = waitClusterUpdateSuccessful(conn, d.Id(), updateID, d.Timeout(schema.TimeoutUpdate))
 
 		if err != nil {
-			return fmt.Errorf("error waiting for EKS Cluster (%s) logging update (%s): %w", d.Id(), updateID, err)
+			return diag.Errorf("error waiting for EKS Cluster (%s) logging update (%s): %s", d.Id(), updateID, err)
 		}
 	}
 
@@ -505,10 +575,10 @@ func resourceClusterUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		log.Printf("[DEBUG] Updating EKS Cluster (%s) VPC config: %s", d.Id(), input)
-		output, err := conn.UpdateClusterConfig(input)
+		output, err := conn.UpdateClusterConfigWithContext(ctx, input)
 
 		if err != nil {
-			return fmt.Errorf("error updating EKS Cluster (%s) VPC config: %w", d.Id(), err)
+			return diag.Errorf("error updating EKS Cluster (%s) VPC config: %s", d.Id(), err)
 		}
 
 		updateID := aws.StringValue(output.Update.Id)
@@ -516,21 +586,21 @@ func resourceClusterUpdate(d *schema.ResourceData, meta interface{}) error {
 		_, err = waitClusterUpdateSuccessful(conn, d.Id(), updateID, d.Timeout(schema.TimeoutUpdate))
 
 		if err != nil {
-			return fmt.Errorf("error waiting for EKS Cluster (%s) VPC config update (%s): %w", d.Id(), updateID, err)
+			return diag.Errorf("error waiting for EKS Cluster (%s) VPC config update (%s): %s", d.Id(), updateID, err)
 		}
 	}
 
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
-		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
-			return fmt.Errorf("error updating tags: %w", err)
+		if err := UpdateTagsWithContext(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating tags: %s", err)
 		}
 	}
 
-	return resourceClusterRead(d, meta)
+	return resourceClusterRead(ctx, d, meta)
 }
 
-func resourceClusterDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).EKSConn
 
 	log.Printf("[DEBUG] Deleting EKS Cluster: %s", d.Id())
@@ -541,10 +611,10 @@ func resourceClusterDelete(d *schema.ResourceData, meta interface{}) error {
 
 	// If a cluster is scaling up due to load a delete request will fail
 	// This is a temporary workaround until EKS supports multiple parallel mutating operations
-	err := tfresource.RetryContext(context.Background(), clusterDeleteRetryTimeout, func() *resource.RetryError {
+	err := tfresource.RetryContext(ctx, clusterDeleteRetryTimeout, func() *resource.RetryError {
 		var err error
 
-		_, err = conn.DeleteCluster(input)
+		_, err = conn.DeleteClusterWithContext(ctx, input)
 
 		if tfawserr.ErrMessageContains(err, eks.ErrCodeResourceInUseException, "in progress") {
 			log.Printf("[DEBUG] eks cluster update in progress: %v", err)
@@ -559,7 +629,7 @@ func resourceClusterDelete(d *schema.ResourceData, meta interface{}) error {
 	}, tfresource.WithDelayRand(1*time.Minute), tfresource.WithPollInterval(30*time.Second))
 
 	if tfresource.TimedOut(err) {
-		_, err = conn.DeleteCluster(input)
+		_, err = conn.DeleteClusterWithContext(ctx, input)
 	}
 
 	if tfawserr.ErrCodeEquals(err, eks.ErrCodeResourceNotFoundException) {
@@ -573,11 +643,11 @@ func resourceClusterDelete(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if err != nil {
-		return fmt.Errorf("error deleting EKS Cluster (%s): %w", d.Id(), err)
+		return diag.Errorf("error deleting EKS Cluster (%s): %s", d.Id(), err)
 	}
 
-	if _, err = waitClusterDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
-		return fmt.Errorf("error waiting for EKS Cluster (%s) to delete: %w", d.Id(), err)
+	if _, err = waitClusterDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("error waiting for EKS Cluster (%s) to delete: %s", d.Id(), err)
 	}
 
 	return nil
@@ -627,6 +697,26 @@ func expandProvider(tfList []interface{}) *eks.Provider {
 	return apiObject
 }
 
+func expandAccessConfigRequest(tfList []interface{}) *eks.CreateAccessConfigRequest {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &eks.CreateAccessConfigRequest{}
+
+	if v, ok := tfMap["authentication_mode"].(string); ok && v != "" {
+		apiObject.AuthenticationMode = aws.String(v)
+	}
+
+	if v, ok := tfMap["bootstrap_cluster_creator_admin_permissions"].(bool); ok {
+		apiObject.BootstrapClusterCreatorAdminPermissions = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
 func expandOutpostConfigRequest(l []interface{}) *eks.OutpostConfigRequest {
 	tfMap, ok := l[0].(map[string]interface{})
 
@@ -844,6 +934,23 @@ func flattenNetworkConfig(apiObject *eks.KubernetesNetworkConfigResponse) []inte
 	return []interface{}{tfMap}
 }
 
+func flattenAccessConfigResponse(apiObject *eks.AccessConfigResponse, bootstrapClusterCreatorAdminPermissions bool) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	if apiObject.BootstrapClusterCreatorAdminPermissions != nil {
+		bootstrapClusterCreatorAdminPermissions = aws.BoolValue(apiObject.BootstrapClusterCreatorAdminPermissions)
+	}
+
+	tfMap := map[string]interface{}{
+		"authentication_mode":                         aws.StringValue(apiObject.AuthenticationMode),
+		"bootstrap_cluster_creator_admin_permissions": bootstrapClusterCreatorAdminPermissions,
+	}
+
+	return []interface{}{tfMap}
+}
+
 func flattenOutpostConfig(apiObject *eks.OutpostConfigResponse) []interface{} {
 	if apiObject == nil {
 		return nil
@@ -856,3 +963,18 @@ func flattenOutpostConfig(apiObject *eks.OutpostConfigResponse) []interface{} {
 
 	return []interface{}{tfMap}
 }
+
+// authenticationModeOrder returns the position of an authentication mode in the order
+// in which a cluster's authentication mode can be changed, or -1 if it is unknown.
+func authenticationModeOrder(authenticationMode string) int {
+	switch authenticationMode {
+	case eks.AuthenticationModeConfigMap:
+		return 0
+	case eks.AuthenticationModeApiAndConfigMap:
+		return 1
+	case eks.AuthenticationModeApi:
+		return 2
+	default:
+		return -1
+	}
+}
@@ -1,6 +1,7 @@
 package eks
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -14,6 +15,18 @@ func DataSourceCluster() *schema.Resource {
 		Read: dataSourceClusterRead,
 
 		Schema: map[string]*schema.Schema{
+			"access_config": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"authentication_mode": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"arn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -173,13 +186,23 @@ func dataSourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
 	name := d.Get("name").(string)
-	cluster, err := FindClusterByName(conn, name)
+	cluster, err := FindClusterByName(context.Background(), conn, name)
 
 	if err != nil {
 		return fmt.Errorf("error reading EKS Cluster (%s): %w", name, err)
 	}
 
 	d.SetId(name)
+	if v := cluster.AccessConfig; v != nil {
+		if err := d.Set("access_config", []interface{}{map[string]interface{}{
+			"authentication_mode": aws.StringValue(v.AuthenticationMode),
+		}}); err != nil {
+			return fmt.Errorf("error setting access_config: %w", err)
+		}
+	} else {
+		d.Set("access_config", nil)
+	}
+
 	d.Set("arn", cluster.Arn)
 
 	if err := d.Set("certificate_authority", flattenCertificate(cluster.CertificateAuthority)); err != nil {
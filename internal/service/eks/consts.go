@@ -16,6 +16,22 @@ func Resources_Values() []string {
 	}
 }
 
+const (
+	AccessEntryTypeEC2Linux     = "EC2_LINUX"
+	AccessEntryTypeEC2Windows   = "EC2_WINDOWS"
+	AccessEntryTypeFargateLinux = "FARGATE_LINUX"
+	AccessEntryTypeStandard     = "STANDARD"
+)
+
+func AccessEntryType_Values() []string {
+	return []string{
+		AccessEntryTypeEC2Linux,
+		AccessEntryTypeEC2Windows,
+		AccessEntryTypeFargateLinux,
+		AccessEntryTypeStandard,
+	}
+}
+
 const (
 	propagationTimeout = 2 * time.Minute
 )
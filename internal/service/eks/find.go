@@ -9,6 +9,115 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
+func FindAccessEntryByClusterNameAndPrincipalARN(ctx context.Context, conn *eks.EKS, clusterName, principalARN string) (*eks.AccessEntry, error) {
+	input := &eks.DescribeAccessEntryInput{
+		ClusterName:  aws.String(clusterName),
+		PrincipalArn: aws.String(principalARN),
+	}
+
+	output, err := conn.DescribeAccessEntryWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, eks.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.AccessEntry == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.AccessEntry, nil
+}
+
+func FindAccessEntriesByClusterName(ctx context.Context, conn *eks.EKS, clusterName, associatedPolicyARN string) ([]string, error) {
+	input := &eks.ListAccessEntriesInput{
+		ClusterName: aws.String(clusterName),
+	}
+
+	if associatedPolicyARN != "" {
+		input.AssociatedPolicyArn = aws.String(associatedPolicyARN)
+	}
+
+	var principalARNs []string
+
+	err := conn.ListAccessEntriesPagesWithContext(ctx, input, func(page *eks.ListAccessEntriesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		principalARNs = append(principalARNs, aws.StringValueSlice(page.AccessEntries)...)
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, eks.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return principalARNs, nil
+}
+
+func FindAccessPolicyAssociationByClusterNamePrincipalARNAndPolicyARN(ctx context.Context, conn *eks.EKS, clusterName, principalARN, policyARN string) (*eks.AssociatedAccessPolicy, error) {
+	input := &eks.ListAssociatedAccessPoliciesInput{
+		ClusterName:  aws.String(clusterName),
+		PrincipalArn: aws.String(principalARN),
+	}
+
+	var associatedAccessPolicy *eks.AssociatedAccessPolicy
+
+	err := conn.ListAssociatedAccessPoliciesPagesWithContext(ctx, input, func(page *eks.ListAssociatedAccessPoliciesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.AssociatedAccessPolicies {
+			if v != nil && aws.StringValue(v.PolicyArn) == policyARN {
+				associatedAccessPolicy = v
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, eks.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if associatedAccessPolicy == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return associatedAccessPolicy, nil
+}
+
 func FindAddonByClusterNameAndAddonName(ctx context.Context, conn *eks.EKS, clusterName, addonName string) (*eks.Addon, error) {
 	input := &eks.DescribeAddonInput{
 		AddonName:   aws.String(addonName),
@@ -118,12 +227,12 @@ func FindAddonVersionByAddonNameAndKubernetesVersion(ctx context.Context, conn *
 	return version, nil
 }
 
-func FindClusterByName(conn *eks.EKS, name string) (*eks.Cluster, error) {
+func FindClusterByName(ctx context.Context, conn *eks.EKS, name string) (*eks.Cluster, error) {
 	input := &eks.DescribeClusterInput{
 		Name: aws.String(name),
 	}
 
-	output, err := conn.DescribeCluster(input)
+	output, err := conn.DescribeClusterWithContext(ctx, input)
 
 	// Sometimes the EKS API returns the ResourceNotFound error in this form:
 	// ClientException: No cluster found for name: tf-acc-test-0o1f8
@@ -3,8 +3,64 @@ package eks
 import (
 	"fmt"
 	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/importid"
 )
 
+func init() {
+	importid.Register("aws_eks_access_entry", accessEntryResourceIDFormat)
+	importid.Register("aws_eks_access_policy_association", accessPolicyAssociationResourceIDFormat)
+}
+
+const accessEntryResourceIDSeparator = ","
+
+var accessEntryResourceIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "cluster-name"},
+		{Name: "principal-arn"},
+	},
+	Separator: accessEntryResourceIDSeparator,
+}
+
+func AccessEntryCreateResourceID(clusterName, principalARN string) string {
+	return accessEntryResourceIDFormat.Create(clusterName, principalARN)
+}
+
+func AccessEntryParseResourceID(id string) (string, string, error) {
+	parts, err := accessEntryResourceIDFormat.Parse(id)
+
+	if err != nil {
+		return "", "", err
+	}
+
+	return parts[0], parts[1], nil
+}
+
+const accessPolicyAssociationResourceIDSeparator = ","
+
+var accessPolicyAssociationResourceIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "cluster-name"},
+		{Name: "principal-arn"},
+		{Name: "policy-arn"},
+	},
+	Separator: accessPolicyAssociationResourceIDSeparator,
+}
+
+func AccessPolicyAssociationCreateResourceID(clusterName, principalARN, policyARN string) string {
+	return accessPolicyAssociationResourceIDFormat.Create(clusterName, principalARN, policyARN)
+}
+
+func AccessPolicyAssociationParseResourceID(id string) (string, string, string, error) {
+	parts, err := accessPolicyAssociationResourceIDFormat.Parse(id)
+
+	if err != nil {
+		return "", "", "", err
+	}
+
+	return parts[0], parts[1], parts[2], nil
+}
+
 const addonResourceIDSeparator = ":"
 
 func AddonCreateResourceID(clusterName, addonName string) string {
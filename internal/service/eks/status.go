@@ -41,9 +41,9 @@ func statusAddonUpdate(ctx context.Context, conn *eks.EKS, clusterName, addonNam
 	}
 }
 
-func statusCluster(conn *eks.EKS, name string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		output, err := FindClusterByName(conn, name)
+func statusCluster(conn *eks.EKS, name string) func(context.Context) (*eks.Cluster, string, error) {
+	return func(ctx context.Context) (*eks.Cluster, string, error) {
+		output, err := FindClusterByName(ctx, conn, name)
 
 		if tfresource.NotFound(err) {
 			return nil, "", nil
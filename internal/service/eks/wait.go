@@ -2,6 +2,7 @@ package eks
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -77,38 +78,22 @@ func waitAddonUpdateSuccessful(ctx context.Context, conn *eks.EKS, clusterName,
 	return nil, err
 }
 
-func waitClusterCreated(conn *eks.EKS, name string, timeout time.Duration) (*eks.Cluster, error) {
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{eks.ClusterStatusPending, eks.ClusterStatusCreating},
-		Target:  []string{eks.ClusterStatusActive},
-		Refresh: statusCluster(conn, name),
-		Timeout: timeout,
-	}
-
-	outputRaw, err := stateConf.WaitForState()
-
-	if output, ok := outputRaw.(*eks.Cluster); ok {
-		return output, err
-	}
-
-	return nil, err
+func waitClusterCreated(ctx context.Context, conn *eks.EKS, name string, timeout time.Duration) (*eks.Cluster, error) {
+	return tfresource.Wait(ctx, statusCluster(conn, name), tfresource.WaiterOpts{
+		Description: fmt.Sprintf("EKS Cluster (%s) create", name),
+		Pending:     []string{eks.ClusterStatusPending, eks.ClusterStatusCreating},
+		Target:      []string{eks.ClusterStatusActive},
+		Timeout:     timeout,
+	})
 }
 
-func waitClusterDeleted(conn *eks.EKS, name string, timeout time.Duration) (*eks.Cluster, error) {
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{eks.ClusterStatusActive, eks.ClusterStatusDeleting},
-		Target:  []string{},
-		Refresh: statusCluster(conn, name),
-		Timeout: timeout,
-	}
-
-	outputRaw, err := stateConf.WaitForState()
-
-	if output, ok := outputRaw.(*eks.Cluster); ok {
-		return output, err
-	}
-
-	return nil, err
+func waitClusterDeleted(ctx context.Context, conn *eks.EKS, name string, timeout time.Duration) (*eks.Cluster, error) {
+	return tfresource.Wait(ctx, statusCluster(conn, name), tfresource.WaiterOpts{
+		Description: fmt.Sprintf("EKS Cluster (%s) delete", name),
+		Pending:     []string{eks.ClusterStatusActive, eks.ClusterStatusDeleting},
+		Target:      []string{},
+		Timeout:     timeout,
+	})
 }
 
 func waitClusterUpdateSuccessful(conn *eks.EKS, name, id string, timeout time.Duration) (*eks.Update, error) { //nolint:unparam
@@ -3,6 +3,8 @@ package emr
 import (
 	"fmt"
 	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/importid"
 )
 
 func readStudioSessionMapping(id string) (studioId, identityType, identityId string, err error) {
@@ -12,3 +14,24 @@ func readStudioSessionMapping(id string) (studioId, identityType, identityId str
 	}
 	return idParts[0], idParts[1], idParts[2], nil
 }
+
+var instanceGroupImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "cluster-id"},
+		{Name: "ig-id"},
+	},
+	Separator: "/",
+}
+
+var instanceFleetImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "cluster-id"},
+		{Name: "fleet-id"},
+	},
+	Separator: "/",
+}
+
+func init() {
+	importid.Register("aws_emr_instance_group", instanceGroupImportIDFormat)
+	importid.Register("aws_emr_instance_fleet", instanceFleetImportIDFormat)
+}
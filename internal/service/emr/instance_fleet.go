@@ -3,7 +3,6 @@ package emr
 import (
 	"fmt"
 	"log"
-	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -23,9 +22,9 @@ func ResourceInstanceFleet() *schema.Resource {
 		Delete: resourceInstanceFleetDelete,
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-				idParts := strings.Split(d.Id(), "/")
-				if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-					return nil, fmt.Errorf("Unexpected format of ID (%q), expected cluster-id/fleet-id", d.Id())
+				idParts, err := instanceFleetImportIDFormat.Parse(d.Id())
+				if err != nil {
+					return nil, err
 				}
 				clusterID := idParts[0]
 				resourceID := idParts[1]
@@ -4,7 +4,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
-	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -32,9 +31,9 @@ func ResourceInstanceGroup() *schema.Resource {
 		Delete: resourceInstanceGroupDelete,
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-				idParts := strings.Split(d.Id(), "/")
-				if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-					return nil, fmt.Errorf("Unexpected format of ID (%q), expected cluster-id/ig-id", d.Id())
+				idParts, err := instanceGroupImportIDFormat.Parse(d.Id())
+				if err != nil {
+					return nil, err
 				}
 				clusterID := idParts[0]
 				resourceID := idParts[1]
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/importid"
 )
 
 var (
@@ -11,30 +13,30 @@ var (
 	partnerEventBusPattern = regexp.MustCompile(`^aws\.partner(/[\.\-_A-Za-z0-9]+){2,}$`)
 )
 
-const permissionResourceIDSeparator = "/"
-
-func PermissionCreateResourceID(eventBusName, statementID string) string {
-	if eventBusName == "" || eventBusName == DefaultEventBusName {
-		return statementID
-	}
+var permissionResourceIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "EVENTBUSNAME", Optional: true, Default: DefaultEventBusName},
+		{Name: "STATEMENTID"},
+	},
+	Separator: "/",
+}
 
-	parts := []string{eventBusName, statementID}
-	id := strings.Join(parts, permissionResourceIDSeparator)
+func init() {
+	importid.Register("aws_cloudwatch_event_permission", permissionResourceIDFormat)
+}
 
-	return id
+func PermissionCreateResourceID(eventBusName, statementID string) string {
+	return permissionResourceIDFormat.Create(eventBusName, statementID)
 }
 
 func PermissionParseResourceID(id string) (string, string, error) {
-	parts := strings.Split(id, permissionResourceIDSeparator)
+	parts, err := permissionResourceIDFormat.Parse(id)
 
-	if len(parts) == 1 && parts[0] != "" {
-		return DefaultEventBusName, parts[0], nil
-	}
-	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
-		return parts[0], parts[1], nil
+	if err != nil {
+		return "", "", err
 	}
 
-	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected EVENTBUSNAME%[2]sSTATEMENTID or STATEMENTID", id, permissionResourceIDSeparator)
+	return parts[0], parts[1], nil
 }
 
 const ruleResourceIDSeparator = "/"
@@ -3,7 +3,6 @@ package iam
 import (
 	"fmt"
 	"log"
-	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/iam"
@@ -126,9 +125,9 @@ func resourceGroupPolicyAttachmentDelete(d *schema.ResourceData, meta interface{
 }
 
 func resourceGroupPolicyAttachmentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	idParts := strings.SplitN(d.Id(), "/", 2)
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		return nil, fmt.Errorf("unexpected format of ID (%q), expected <group-name>/<policy_arn>", d.Id())
+	idParts, err := groupPolicyAttachmentImportIDFormat.Parse(d.Id())
+	if err != nil {
+		return nil, err
 	}
 	groupName := idParts[0]
 	policyARN := idParts[1]
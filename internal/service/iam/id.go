@@ -0,0 +1,58 @@
+package iam
+
+import (
+	"github.com/hashicorp/terraform-provider-aws/internal/importid"
+)
+
+// Policy ARNs contain the separator.
+var userPolicyAttachmentImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "user-name"},
+		{Name: "policy_arn", Greedy: true},
+	},
+	Separator: "/",
+}
+
+// The group names are joined by the separator.
+var userGroupMembershipImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "user-name"},
+		{Name: "group-names", Greedy: true},
+	},
+	Separator: "/",
+}
+
+var userSSHKeyImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "UserName"},
+		{Name: "SSHPublicKeyId"},
+		{Name: "Encoding", Greedy: true},
+	},
+	Separator: ":",
+}
+
+// Policy ARNs contain the separator.
+var rolePolicyAttachmentImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "role-name"},
+		{Name: "policy_arn", Greedy: true},
+	},
+	Separator: "/",
+}
+
+// Policy ARNs contain the separator.
+var groupPolicyAttachmentImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "group-name"},
+		{Name: "policy_arn", Greedy: true},
+	},
+	Separator: "/",
+}
+
+func init() {
+	importid.Register("aws_iam_user_policy_attachment", userPolicyAttachmentImportIDFormat)
+	importid.Register("aws_iam_user_group_membership", userGroupMembershipImportIDFormat)
+	importid.Register("aws_iam_user_ssh_key", userSSHKeyImportIDFormat)
+	importid.Register("aws_iam_role_policy_attachment", rolePolicyAttachmentImportIDFormat)
+	importid.Register("aws_iam_group_policy_attachment", groupPolicyAttachmentImportIDFormat)
+}
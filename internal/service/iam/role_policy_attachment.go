@@ -3,7 +3,6 @@ package iam
 import (
 	"fmt"
 	"log"
-	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/iam"
@@ -130,9 +129,9 @@ func resourceRolePolicyAttachmentDelete(d *schema.ResourceData, meta interface{}
 }
 
 func resourceRolePolicyAttachmentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	idParts := strings.SplitN(d.Id(), "/", 2)
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		return nil, fmt.Errorf("unexpected format of ID (%q), expected <role-name>/<policy_arn>", d.Id())
+	idParts, err := rolePolicyAttachmentImportIDFormat.Parse(d.Id())
+	if err != nil {
+		return nil, err
 	}
 
 	roleName := idParts[0]
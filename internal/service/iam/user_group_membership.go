@@ -200,13 +200,13 @@ func addUserToGroups(conn *iam.IAM, user string, groups []*string) error {
 }
 
 func resourceUserGroupMembershipImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	idParts := strings.Split(d.Id(), "/")
-	if len(idParts) < 2 {
-		return nil, fmt.Errorf("unexpected format of ID (%q), expected <user-name>/<group-name1>/...", d.Id())
+	idParts, err := userGroupMembershipImportIDFormat.Parse(d.Id())
+	if err != nil {
+		return nil, err
 	}
 
 	userName := idParts[0]
-	groupList := idParts[1:]
+	groupList := strings.Split(idParts[1], userGroupMembershipImportIDFormat.Separator)
 
 	d.Set("user", userName)
 	d.Set("groups", groupList)
@@ -184,10 +184,10 @@ func resourceUserSSHKeyDelete(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceUserSSHKeyImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	idParts := strings.SplitN(d.Id(), ":", 3)
+	idParts, err := userSSHKeyImportIDFormat.Parse(d.Id())
 
-	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
-		return nil, fmt.Errorf("unexpected format of ID (%q), UserName:SSHPublicKeyId:Encoding", d.Id())
+	if err != nil {
+		return nil, err
 	}
 
 	username := idParts[0]
@@ -1,28 +1,70 @@
 package lambda
 
 import (
-	"fmt"
+	"context"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 func ResourceAlias() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAliasCreate,
-		Read:   resourceAliasRead,
-		Update: resourceAliasUpdate,
-		Delete: resourceAliasDelete,
+		CreateWithoutTimeout: resourceAliasCreate,
+		ReadWithoutTimeout:   resourceAliasRead,
+		UpdateWithoutTimeout: resourceAliasUpdate,
+		DeleteWithoutTimeout: resourceAliasDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceAliasImport,
+			StateContext: resourceAliasImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(60 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
+			"deployment_preference": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"routing_config"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alarms": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MaxItems: 100,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: verify.ValidARN,
+							},
+						},
+						"interval": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 24*60),
+						},
+						"percentage": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 99),
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(aliasDeploymentType_Values(), false),
+						},
+					},
+				},
+			},
 			"description": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -76,7 +118,7 @@ func ResourceAlias() *schema.Resource {
 
 // resourceAliasCreate maps to:
 // CreateAlias in the API / SDK
-func resourceAliasCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAliasCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).LambdaConn
 
 	functionName := d.Get("function_name").(string)
@@ -92,19 +134,19 @@ func resourceAliasCreate(d *schema.ResourceData, meta interface{}) error {
 		RoutingConfig:   expandAliasRoutingConfiguration(d.Get("routing_config").([]interface{})),
 	}
 
-	aliasConfiguration, err := conn.CreateAlias(params)
+	aliasConfiguration, err := conn.CreateAliasWithContext(ctx, params)
 	if err != nil {
-		return fmt.Errorf("Error creating Lambda alias: %s", err)
+		return diag.Errorf("Error creating Lambda alias: %s", err)
 	}
 
 	d.SetId(aws.StringValue(aliasConfiguration.AliasArn))
 
-	return resourceAliasRead(d, meta)
+	return resourceAliasRead(ctx, d, meta)
 }
 
 // resourceAliasRead maps to:
 // GetAlias in the API / SDK
-func resourceAliasRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAliasRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).LambdaConn
 
 	log.Printf("[DEBUG] Fetching Lambda alias: %s:%s", d.Get("function_name"), d.Get("name"))
@@ -114,7 +156,7 @@ func resourceAliasRead(d *schema.ResourceData, meta interface{}) error {
 		Name:         aws.String(d.Get("name").(string)),
 	}
 
-	aliasConfiguration, err := conn.GetAlias(params)
+	aliasConfiguration, err := conn.GetAliasWithContext(ctx, params)
 	if err != nil {
 		if awsErr, ok := err.(awserr.Error); ok {
 			if awsErr.Code() == "ResourceNotFoundException" && strings.Contains(awsErr.Message(), "Cannot find alias arn") {
@@ -122,7 +164,7 @@ func resourceAliasRead(d *schema.ResourceData, meta interface{}) error {
 				return nil
 			}
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set("description", aliasConfiguration.Description)
@@ -135,7 +177,7 @@ func resourceAliasRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("invoke_arn", invokeArn)
 
 	if err := d.Set("routing_config", flattenAliasRoutingConfiguration(aliasConfiguration.RoutingConfig)); err != nil {
-		return fmt.Errorf("error setting routing_config: %s", err)
+		return diag.Errorf("error setting routing_config: %s", err)
 	}
 
 	return nil
@@ -143,7 +185,7 @@ func resourceAliasRead(d *schema.ResourceData, meta interface{}) error {
 
 // resourceAliasDelete maps to:
 // DeleteAlias in the API / SDK
-func resourceAliasDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAliasDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).LambdaConn
 
 	log.Printf("[INFO] Deleting Lambda alias: %s:%s", d.Get("function_name"), d.Get("name"))
@@ -153,9 +195,9 @@ func resourceAliasDelete(d *schema.ResourceData, meta interface{}) error {
 		Name:         aws.String(d.Get("name").(string)),
 	}
 
-	_, err := conn.DeleteAlias(params)
+	_, err := conn.DeleteAliasWithContext(ctx, params)
 	if err != nil {
-		return fmt.Errorf("Error deleting Lambda alias: %s", err)
+		return diag.Errorf("Error deleting Lambda alias: %s", err)
 	}
 
 	return nil
@@ -163,11 +205,31 @@ func resourceAliasDelete(d *schema.ResourceData, meta interface{}) error {
 
 // resourceAliasUpdate maps to:
 // UpdateAlias in the API / SDK
-func resourceAliasUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAliasUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).LambdaConn
 
 	log.Printf("[DEBUG] Updating Lambda alias: %s:%s", d.Get("function_name"), d.Get("name"))
 
+	if d.HasChange("function_version") {
+		preference, err := expandAliasDeploymentPreference(d.Get("deployment_preference").([]interface{}))
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if preference != nil {
+			cloudwatchConn := meta.(*conns.AWSClient).CloudWatchConn
+			o, n := d.GetChange("function_version")
+
+			if err := shiftAliasTraffic(ctx, conn, cloudwatchConn, d.Get("function_name").(string), d.Get("name").(string), o.(string), n.(string), preference, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				// The alias's function version is unchanged.
+				d.Set("function_version", o)
+
+				return diag.Errorf("Error shifting Lambda alias traffic to version %s: %s", n, err)
+			}
+		}
+	}
+
 	params := &lambda.UpdateAliasInput{
 		Description:     aws.String(d.Get("description").(string)),
 		FunctionName:    aws.String(d.Get("function_name").(string)),
@@ -176,9 +238,9 @@ func resourceAliasUpdate(d *schema.ResourceData, meta interface{}) error {
 		RoutingConfig:   expandAliasRoutingConfiguration(d.Get("routing_config").([]interface{})),
 	}
 
-	_, err := conn.UpdateAlias(params)
+	_, err := conn.UpdateAliasWithContext(ctx, params)
 	if err != nil {
-		return fmt.Errorf("Error updating Lambda alias: %s", err)
+		return diag.Errorf("Error updating Lambda alias: %s", err)
 	}
 
 	return nil
@@ -200,10 +262,58 @@ func expandAliasRoutingConfiguration(l []interface{}) *lambda.AliasRoutingConfig
 	return aliasRoutingConfiguration
 }
 
-func resourceAliasImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	idParts := strings.Split(d.Id(), "/")
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		return nil, fmt.Errorf("Unexpected format of ID (%q), expected FUNCTION_NAME/ALIAS", d.Id())
+// findAliasedFunctionVersions returns the function versions that the function's aliases route invocations to.
+func findAliasedFunctionVersions(conn *lambda.Lambda, functionName string) (map[string]bool, error) {
+	input := &lambda.ListAliasesInput{
+		FunctionName: aws.String(functionName),
+	}
+	var aliases []*lambda.AliasConfiguration
+
+	err := conn.ListAliasesPages(input, func(page *lambda.ListAliasesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		aliases = append(aliases, page.Aliases...)
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return aliasedFunctionVersions(aliases), nil
+}
+
+// aliasedFunctionVersions returns the versions referenced by the specified aliases,
+// either as an alias's function version or as an additional version in its routing configuration.
+func aliasedFunctionVersions(aliases []*lambda.AliasConfiguration) map[string]bool {
+	versions := make(map[string]bool)
+
+	for _, alias := range aliases {
+		if alias == nil {
+			continue
+		}
+
+		if v := aws.StringValue(alias.FunctionVersion); v != "" {
+			versions[v] = true
+		}
+
+		if alias.RoutingConfig != nil {
+			for v := range alias.RoutingConfig.AdditionalVersionWeights {
+				versions[v] = true
+			}
+		}
+	}
+
+	return versions
+}
+
+func resourceAliasImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts, err := aliasImportIDFormat.Parse(d.Id())
+	if err != nil {
+		return nil, err
 	}
 
 	functionName := idParts[0]
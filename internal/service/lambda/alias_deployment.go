@@ -0,0 +1,239 @@
+package lambda
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+const (
+	// aliasDeploymentAlarmPollInterval is how often alarms are checked while traffic is shifting.
+	aliasDeploymentAlarmPollInterval = 30 * time.Second
+)
+
+// aliasDeploymentPreference describes how traffic is shifted to a new alias function version.
+type aliasDeploymentPreference struct {
+	alarmNames []string
+	interval   time.Duration
+	percentage int
+	typ        string
+}
+
+func expandAliasDeploymentPreference(tfList []interface{}) (*aliasDeploymentPreference, error) {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil, nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	preference := &aliasDeploymentPreference{
+		interval:   time.Duration(tfMap["interval"].(int)) * time.Minute,
+		percentage: tfMap["percentage"].(int),
+		typ:        tfMap["type"].(string),
+	}
+
+	for _, v := range flex.ExpandStringValueSet(tfMap["alarms"].(*schema.Set)) {
+		name, err := alarmNameFromARN(v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		preference.alarmNames = append(preference.alarmNames, name)
+	}
+
+	return preference, nil
+}
+
+// weights returns the successive weights of traffic routed to the new version, each held for the interval,
+// before all traffic is routed to it.
+func (p *aliasDeploymentPreference) weights() []float64 {
+	if p.typ == aliasDeploymentTypeCanary {
+		return []float64{float64(p.percentage) / 100}
+	}
+
+	var weights []float64
+
+	for percentage := p.percentage; percentage < 100; percentage += p.percentage {
+		weights = append(weights, float64(percentage)/100)
+	}
+
+	return weights
+}
+
+// duration returns the time taken to shift all traffic.
+func (p *aliasDeploymentPreference) duration() time.Duration {
+	return time.Duration(len(p.weights())) * p.interval
+}
+
+// alarmNameFromARN returns the name of the CloudWatch alarm with the specified ARN,
+// arn:${Partition}:cloudwatch:${Region}:${Account}:alarm:${AlarmName}.
+func alarmNameFromARN(s string) (string, error) {
+	v, err := arn.Parse(s)
+
+	if err != nil {
+		return "", fmt.Errorf("parsing CloudWatch alarm ARN (%s): %w", s, err)
+	}
+
+	name := strings.TrimPrefix(v.Resource, "alarm:")
+
+	if v.Service != "cloudwatch" || name == v.Resource || name == "" {
+		return "", fmt.Errorf("invalid CloudWatch alarm ARN (%s)", s)
+	}
+
+	return name, nil
+}
+
+// findAlarmsInAlarmState returns the names of the specified metric or composite alarms that are in the ALARM state.
+func findAlarmsInAlarmState(ctx context.Context, conn *cloudwatch.CloudWatch, names []string) ([]string, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	input := &cloudwatch.DescribeAlarmsInput{
+		AlarmNames: aws.StringSlice(names),
+		AlarmTypes: aws.StringSlice(cloudwatch.AlarmType_Values()),
+		StateValue: aws.String(cloudwatch.StateValueAlarm),
+	}
+	var alarms []string
+
+	err := conn.DescribeAlarmsPagesWithContext(ctx, input, func(page *cloudwatch.DescribeAlarmsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.MetricAlarms {
+			alarms = append(alarms, aws.StringValue(v.AlarmName))
+		}
+
+		for _, v := range page.CompositeAlarms {
+			alarms = append(alarms, aws.StringValue(v.AlarmName))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return alarms, nil
+}
+
+// shiftAliasTraffic gradually routes an alias's traffic from its current function version to a new version,
+// leaving the current version as the alias's function version.
+// If any of the alarms fire, an alarm can't be checked or the timeout expires once traffic has started shifting,
+// then all traffic is routed back to the current version and an error returned.
+func shiftAliasTraffic(ctx context.Context, conn *lambda.Lambda, cloudwatchConn *cloudwatch.CloudWatch, functionName, aliasName, currentVersion, newVersion string, preference *aliasDeploymentPreference, timeout time.Duration) (err error) {
+	if currentVersion == FunctionVersionLatest || newVersion == FunctionVersionLatest {
+		return fmt.Errorf("traffic can only be shifted between published versions, not %s", FunctionVersionLatest)
+	}
+
+	if d := preference.duration(); d > timeout {
+		return fmt.Errorf("shifting traffic takes %s, longer than the %s timeout", d, timeout)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	id := fmt.Sprintf("%s:%s", functionName, aliasName)
+
+	if err := checkAliasDeploymentAlarms(ctx, cloudwatchConn, preference.alarmNames); err != nil {
+		return err
+	}
+
+	shifting := false
+
+	defer func() {
+		if err == nil || !shifting {
+			return
+		}
+
+		log.Printf("[WARN] Lambda Alias (%s) rolling back to version %s: %s", id, currentVersion, err)
+
+		// The rollback isn't bound by the context, which may have expired.
+		if rollbackErr := updateAliasRouting(conn, functionName, aliasName, currentVersion, nil); rollbackErr != nil {
+			err = fmt.Errorf("rolling back to version %s after error (%s): %w", currentVersion, err, rollbackErr)
+
+			return
+		}
+
+		err = fmt.Errorf("rolled back to version %s: %w", currentVersion, err)
+	}()
+
+	for _, weight := range preference.weights() {
+		log.Printf("[INFO] Lambda Alias (%s) routing %.0f%% of traffic to version %s", id, weight*100, newVersion)
+
+		// A failed update may still have been applied.
+		shifting = true
+
+		if err := updateAliasRouting(conn, functionName, aliasName, currentVersion, map[string]float64{newVersion: weight}); err != nil {
+			return err
+		}
+
+		for deadline := time.Now().Add(preference.interval); time.Now().Before(deadline); {
+			wait := time.Until(deadline)
+
+			if wait > aliasDeploymentAlarmPollInterval {
+				wait = aliasDeploymentAlarmPollInterval
+			}
+
+			timer := time.NewTimer(wait)
+
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+
+				return fmt.Errorf("shifting traffic to version %s: %w", newVersion, ctx.Err())
+			case <-timer.C:
+			}
+
+			if err := checkAliasDeploymentAlarms(ctx, cloudwatchConn, preference.alarmNames); err != nil {
+				return err
+			}
+		}
+	}
+
+	log.Printf("[INFO] Lambda Alias (%s) routing all traffic to version %s", id, newVersion)
+
+	return nil
+}
+
+func checkAliasDeploymentAlarms(ctx context.Context, conn *cloudwatch.CloudWatch, names []string) error {
+	alarms, err := findAlarmsInAlarmState(ctx, conn, names)
+
+	if err != nil {
+		return fmt.Errorf("reading CloudWatch alarms: %w", err)
+	}
+
+	if len(alarms) > 0 {
+		return fmt.Errorf("CloudWatch alarms in ALARM state: %s", strings.Join(alarms, ", "))
+	}
+
+	return nil
+}
+
+func updateAliasRouting(conn *lambda.Lambda, functionName, aliasName, version string, weights map[string]float64) error {
+	input := &lambda.UpdateAliasInput{
+		FunctionName:    aws.String(functionName),
+		FunctionVersion: aws.String(version),
+		Name:            aws.String(aliasName),
+		RoutingConfig: &lambda.AliasRoutingConfiguration{
+			AdditionalVersionWeights: aws.Float64Map(weights),
+		},
+	}
+
+	if _, err := conn.UpdateAlias(input); err != nil {
+		return fmt.Errorf("updating Lambda Alias (%s:%s) routing configuration: %w", functionName, aliasName, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,297 @@
+package lambda
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/lambda"
+)
+
+func TestAliasDeploymentPreferenceWeights(t *testing.T) {
+	testCases := []struct {
+		TestName         string
+		Preference       aliasDeploymentPreference
+		ExpectedWeights  []float64
+		ExpectedDuration time.Duration
+	}{
+		{
+			TestName: "canary",
+			Preference: aliasDeploymentPreference{
+				interval:   5 * time.Minute,
+				percentage: 10,
+				typ:        aliasDeploymentTypeCanary,
+			},
+			ExpectedWeights:  []float64{0.1},
+			ExpectedDuration: 5 * time.Minute,
+		},
+		{
+			TestName: "linear",
+			Preference: aliasDeploymentPreference{
+				interval:   time.Minute,
+				percentage: 25,
+				typ:        aliasDeploymentTypeLinear,
+			},
+			ExpectedWeights:  []float64{0.25, 0.5, 0.75},
+			ExpectedDuration: 3 * time.Minute,
+		},
+		{
+			TestName: "linear uneven",
+			Preference: aliasDeploymentPreference{
+				interval:   2 * time.Minute,
+				percentage: 40,
+				typ:        aliasDeploymentTypeLinear,
+			},
+			ExpectedWeights:  []float64{0.4, 0.8},
+			ExpectedDuration: 4 * time.Minute,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			if got := testCase.Preference.weights(); !reflect.DeepEqual(got, testCase.ExpectedWeights) {
+				t.Errorf("got weights %v, expected %v", got, testCase.ExpectedWeights)
+			}
+
+			if got := testCase.Preference.duration(); got != testCase.ExpectedDuration {
+				t.Errorf("got duration %s, expected %s", got, testCase.ExpectedDuration)
+			}
+		})
+	}
+}
+
+func TestAlarmNameFromARN(t *testing.T) {
+	testCases := []struct {
+		ARN           string
+		Expected      string
+		ExpectedError bool
+	}{
+		{
+			ARN:      "arn:aws:cloudwatch:us-west-2:123456789012:alarm:errors",
+			Expected: "errors",
+		},
+		{
+			ARN:      "arn:aws:cloudwatch:us-west-2:123456789012:alarm:api:5xx",
+			Expected: "api:5xx",
+		},
+		{
+			ARN:           "arn:aws:sns:us-west-2:123456789012:alarm:errors",
+			ExpectedError: true,
+		},
+		{
+			ARN:           "arn:aws:cloudwatch:us-west-2:123456789012:dashboard/test",
+			ExpectedError: true,
+		},
+		{
+			ARN:           "errors",
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.ARN, func(t *testing.T) {
+			got, err := alarmNameFromARN(testCase.ARN)
+
+			if testCase.ExpectedError {
+				if err == nil {
+					t.Errorf("expected error, got %q", got)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("got %q, expected %q", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+type testAliasDeploymentServer struct {
+	mu sync.Mutex
+
+	// alarmFrom is the alarm check from which the alarm is in the ALARM state. Zero means never.
+	alarmFrom int
+	// errorFrom is the alarm check from which checking alarms fails. Zero means never.
+	errorFrom   int
+	alarmChecks int
+	updates     []lambda.UpdateAliasInput
+}
+
+func (s *testAliasDeploymentServer) conns(t *testing.T) (*lambda.Lambda, *cloudwatch.CloudWatch) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		body, _ := io.ReadAll(r.Body)
+
+		if r.Method == http.MethodPut {
+			var input lambda.UpdateAliasInput
+			json.Unmarshal(body, &input)
+			s.updates = append(s.updates, input)
+
+			w.Header().Set("Content-Type", "application/json")
+			io.WriteString(w, `{"Name": "live"}`)
+
+			return
+		}
+
+		s.alarmChecks++
+
+		if s.errorFrom > 0 && s.alarmChecks >= s.errorFrom {
+			w.Header().Set("Content-Type", "text/xml")
+			w.WriteHeader(http.StatusBadRequest)
+			io.WriteString(w, `<ErrorResponse><Error><Type>Sender</Type><Code>InvalidParameterValue</Code><Message>test</Message></Error></ErrorResponse>`)
+
+			return
+		}
+
+		alarms := ""
+
+		if s.alarmFrom > 0 && s.alarmChecks >= s.alarmFrom {
+			alarms = "<member><AlarmName>errors</AlarmName><StateValue>ALARM</StateValue></member>"
+		}
+
+		w.Header().Set("Content-Type", "text/xml")
+		fmt.Fprintf(w, `<DescribeAlarmsResponse xmlns="http://monitoring.amazonaws.com/doc/2010-08-01/">
+  <DescribeAlarmsResult>
+    <MetricAlarms>%s</MetricAlarms>
+    <CompositeAlarms></CompositeAlarms>
+  </DescribeAlarmsResult>
+</DescribeAlarmsResponse>`, alarms)
+	}))
+	t.Cleanup(server.Close)
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("AKID", "SECRET", ""),
+		Endpoint:    aws.String(server.URL),
+		Region:      aws.String("us-west-2"),
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	return lambda.New(sess), cloudwatch.New(sess)
+}
+
+// routing returns the function version and additional version weights of each alias update.
+func (s *testAliasDeploymentServer) routing() []string {
+	var routing []string
+
+	for _, v := range s.updates {
+		routing = append(routing, fmt.Sprintf("%s %v", aws.StringValue(v.FunctionVersion), aws.Float64ValueMap(v.RoutingConfig.AdditionalVersionWeights)))
+	}
+
+	return routing
+}
+
+func TestShiftAliasTraffic(t *testing.T) {
+	preference := &aliasDeploymentPreference{
+		alarmNames: []string{"errors"},
+		interval:   10 * time.Millisecond,
+		percentage: 50,
+		typ:        aliasDeploymentTypeLinear,
+	}
+
+	t.Run("complete", func(t *testing.T) {
+		s := &testAliasDeploymentServer{}
+		conn, cloudwatchConn := s.conns(t)
+
+		if err := shiftAliasTraffic(context.Background(), conn, cloudwatchConn, "test", "live", "1", "2", preference, time.Minute); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if got, expected := s.routing(), []string{"1 map[2:0.5]"}; !reflect.DeepEqual(got, expected) {
+			t.Errorf("got updates %v, expected %v", got, expected)
+		}
+	})
+
+	t.Run("rollback", func(t *testing.T) {
+		s := &testAliasDeploymentServer{alarmFrom: 2}
+		conn, cloudwatchConn := s.conns(t)
+
+		if err := shiftAliasTraffic(context.Background(), conn, cloudwatchConn, "test", "live", "1", "2", preference, time.Minute); err == nil {
+			t.Fatal("expected error, got none")
+		}
+
+		if got, expected := s.routing(), []string{"1 map[2:0.5]", "1 map[]"}; !reflect.DeepEqual(got, expected) {
+			t.Errorf("got updates %v, expected %v", got, expected)
+		}
+	})
+
+	t.Run("rollback on error", func(t *testing.T) {
+		s := &testAliasDeploymentServer{errorFrom: 2}
+		conn, cloudwatchConn := s.conns(t)
+
+		if err := shiftAliasTraffic(context.Background(), conn, cloudwatchConn, "test", "live", "1", "2", preference, time.Minute); err == nil {
+			t.Fatal("expected error, got none")
+		}
+
+		if got, expected := s.routing(), []string{"1 map[2:0.5]", "1 map[]"}; !reflect.DeepEqual(got, expected) {
+			t.Errorf("got updates %v, expected %v", got, expected)
+		}
+	})
+
+	t.Run("rollback on cancel", func(t *testing.T) {
+		s := &testAliasDeploymentServer{}
+		conn, cloudwatchConn := s.conns(t)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		preference := &aliasDeploymentPreference{
+			interval:   time.Minute,
+			percentage: 50,
+			typ:        aliasDeploymentTypeLinear,
+		}
+
+		if err := shiftAliasTraffic(ctx, conn, cloudwatchConn, "test", "live", "1", "2", preference, time.Hour); err == nil {
+			t.Fatal("expected error, got none")
+		}
+
+		if got, expected := s.routing(), []string{"1 map[2:0.5]", "1 map[]"}; !reflect.DeepEqual(got, expected) {
+			t.Errorf("got updates %v, expected %v", got, expected)
+		}
+	})
+
+	t.Run("alarm before shifting", func(t *testing.T) {
+		s := &testAliasDeploymentServer{alarmFrom: 1}
+		conn, cloudwatchConn := s.conns(t)
+
+		if err := shiftAliasTraffic(context.Background(), conn, cloudwatchConn, "test", "live", "1", "2", preference, time.Minute); err == nil {
+			t.Fatal("expected error, got none")
+		}
+
+		if len(s.updates) > 0 {
+			t.Errorf("got updates %v, expected none", s.routing())
+		}
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		s := &testAliasDeploymentServer{}
+		conn, cloudwatchConn := s.conns(t)
+
+		if err := shiftAliasTraffic(context.Background(), conn, cloudwatchConn, "test", "live", "1", "2", preference, time.Millisecond); err == nil {
+			t.Fatal("expected error, got none")
+		}
+
+		if len(s.updates) > 0 || s.alarmChecks > 0 {
+			t.Error("expected no API calls")
+		}
+	})
+}
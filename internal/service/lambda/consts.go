@@ -15,3 +15,15 @@ const (
 const (
 	propagationTimeout = 5 * time.Minute
 )
+
+const (
+	aliasDeploymentTypeCanary = "Canary"
+	aliasDeploymentTypeLinear = "Linear"
+)
+
+func aliasDeploymentType_Values() []string {
+	return []string{
+		aliasDeploymentTypeCanary,
+		aliasDeploymentTypeLinear,
+	}
+}
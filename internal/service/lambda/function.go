@@ -7,6 +7,8 @@ import (
 	"log"
 	"os"
 	"regexp"
+	"sort"
+	"strconv"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -238,6 +240,11 @@ func ResourceFunction() *schema.Resource {
 				Default:      -1,
 				ValidateFunc: validation.IntAtLeast(-1),
 			},
+			"retain_previous_versions": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
 			"role": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -247,6 +254,26 @@ func ResourceFunction() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.StringInSlice(lambda.Runtime_Values(), false),
 			},
+			"runtime_management_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"runtime_version_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"update_runtime_on": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(lambda.UpdateRuntimeOn_Values(), false),
+						},
+					},
+				},
+			},
 			"s3_bucket": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -270,6 +297,24 @@ func ResourceFunction() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"snap_start": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"apply_on": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{lambda.SnapStartApplyOnPublishedVersions}, false),
+						},
+						"optimization_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"source_code_hash": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -381,7 +426,8 @@ func updateComputedAttributesOnPublish(_ context.Context, d *schema.ResourceDiff
 
 	publish := d.Get("publish").(bool)
 	publishChanged := d.HasChange("publish")
-	if publish && (configChanged || functionCodeUpdated || publishChanged) {
+	runtimeManagementConfigChanged := d.Id() != "" && d.HasChange("runtime_management_config")
+	if publish && (configChanged || functionCodeUpdated || publishChanged || runtimeManagementConfigChanged) {
 		d.SetNewComputed("version")
 		d.SetNewComputed("qualified_arn")
 		d.SetNewComputed("qualified_invoke_arn")
@@ -405,7 +451,8 @@ func hasConfigChanges(d verify.ResourceDiffer) bool {
 		d.HasChange("vpc_config.0.subnet_ids") ||
 		d.HasChange("runtime") ||
 		d.HasChange("environment") ||
-		d.HasChange("ephemeral_storage")
+		d.HasChange("ephemeral_storage") ||
+		d.HasChange("snap_start")
 }
 
 // resourceAwsLambdaFunction maps to:
@@ -562,8 +609,15 @@ func resourceFunctionCreate(d *schema.ResourceData, meta interface{}) error {
 		params.Tags = Tags(tags.IgnoreAWS())
 	}
 
+	snapStart := expandSnapStart(d.Get("snap_start").([]interface{}))
+	if aws.StringValue(snapStart.ApplyOn) != lambda.SnapStartApplyOnNone {
+		params.SnapStart = snapStart
+	}
+
+	var output *lambda.FunctionConfiguration
 	err := resource.Retry(propagationTimeout, func() *resource.RetryError { // nosem: helper-schema-resource-Retry-without-TimeoutError-check
-		_, err := conn.CreateFunction(params)
+		var err error
+		output, err = conn.CreateFunction(params)
 
 		if tfawserr.ErrMessageContains(err, lambda.ErrCodeInvalidParameterValueException, "The role defined for the function cannot be assumed by Lambda") {
 			log.Printf("[DEBUG] Received %s, retrying CreateFunction", err)
@@ -598,7 +652,7 @@ func resourceFunctionCreate(d *schema.ResourceData, meta interface{}) error {
 	})
 
 	if tfresource.TimedOut(err) {
-		_, err = conn.CreateFunction(params)
+		output, err = conn.CreateFunction(params)
 	}
 
 	if err != nil {
@@ -607,7 +661,8 @@ func resourceFunctionCreate(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		err := resource.Retry(functionExtraThrottlingTimeout, func() *resource.RetryError {
-			_, err := conn.CreateFunction(params)
+			var err error
+			output, err = conn.CreateFunction(params)
 
 			if tfawserr.ErrMessageContains(err, lambda.ErrCodeInvalidParameterValueException, "throttled by EC2") {
 				log.Printf("[DEBUG] Received %s, retrying CreateFunction", err)
@@ -622,7 +677,7 @@ func resourceFunctionCreate(d *schema.ResourceData, meta interface{}) error {
 		})
 
 		if tfresource.TimedOut(err) {
-			_, err = conn.CreateFunction(params)
+			output, err = conn.CreateFunction(params)
 		}
 
 		if err != nil {
@@ -636,6 +691,21 @@ func resourceFunctionCreate(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error waiting for Lambda Function (%s) creation: %w", d.Id(), err)
 	}
 
+	if d.Get("publish").(bool) {
+		if err := waitFunctionVersionPublished(conn, d.Id(), aws.StringValue(output.Version), aws.StringValue(snapStart.ApplyOn) == lambda.SnapStartApplyOnPublishedVersions, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return fmt.Errorf("error waiting for Lambda Function (%s) version (%s) publication: %w", d.Id(), aws.StringValue(output.Version), err)
+		}
+	}
+
+	if v, ok := d.GetOk("runtime_management_config"); ok && len(v.([]interface{})) > 0 {
+		input := expandRuntimeManagementConfig(v.([]interface{}))
+		input.FunctionName = aws.String(d.Id())
+
+		if _, err := conn.PutRuntimeManagementConfig(input); err != nil {
+			return fmt.Errorf("error setting Lambda Function (%s) runtime management configuration: %w", d.Id(), err)
+		}
+	}
+
 	if reservedConcurrentExecutions >= 0 {
 		log.Printf("[DEBUG] Setting Concurrency to %d for the Lambda Function %s", reservedConcurrentExecutions, functionName)
 
@@ -855,6 +925,10 @@ func resourceFunctionRead(d *schema.ResourceData, meta interface{}) error {
 		},
 	})
 
+	if err := d.Set("snap_start", flattenSnapStart(function.SnapStart)); err != nil {
+		return fmt.Errorf("error setting snap_start for Lambda Function (%s): %w", d.Id(), err)
+	}
+
 	// Get latest version and ARN unless qualifier is specified via data source
 	if qualifierExistance {
 		functionARN := aws.StringValue(function.FunctionArn)
@@ -891,6 +965,21 @@ func resourceFunctionRead(d *schema.ResourceData, meta interface{}) error {
 	invokeArn := functionInvokeARN(*function.FunctionArn, meta)
 	d.Set("invoke_arn", invokeArn)
 
+	// Runtime management is only supported on zip packaged lambda functions.
+	if aws.StringValue(function.PackageType) == lambda.PackageTypeZip {
+		runtimeManagementConfig, err := findRuntimeManagementConfigByName(conn, d.Id())
+
+		if err != nil {
+			return fmt.Errorf("error reading Lambda Function (%s) runtime management configuration: %w", d.Id(), err)
+		}
+
+		if err := d.Set("runtime_management_config", flattenRuntimeManagementConfig(runtimeManagementConfig)); err != nil {
+			return fmt.Errorf("error setting runtime_management_config for Lambda Function (%s): %w", d.Id(), err)
+		}
+	} else {
+		d.Set("runtime_management_config", nil)
+	}
+
 	// Currently, this functionality is only enabled in AWS Commercial partition
 	// and other partitions return ambiguous error codes (e.g. AccessDeniedException
 	// in AWS GovCloud (US)) so we cannot just ignore the error as would typically.
@@ -1107,6 +1196,10 @@ func resourceFunctionUpdate(d *schema.ResourceData, meta interface{}) error {
 			}
 		}
 	}
+	if d.HasChange("snap_start") {
+		configReq.SnapStart = expandSnapStart(d.Get("snap_start").([]interface{}))
+	}
+
 	configUpdate := hasConfigChanges(d)
 	if configUpdate {
 		log.Printf("[DEBUG] Send Update Lambda Function Configuration request: %#v", configReq)
@@ -1263,8 +1356,20 @@ func resourceFunctionUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	runtimeManagementConfigUpdate := d.HasChange("runtime_management_config")
+	if runtimeManagementConfigUpdate {
+		if v, ok := d.GetOk("runtime_management_config"); ok && len(v.([]interface{})) > 0 {
+			input := expandRuntimeManagementConfig(v.([]interface{}))
+			input.FunctionName = aws.String(d.Id())
+
+			if _, err := conn.PutRuntimeManagementConfig(input); err != nil {
+				return fmt.Errorf("error updating Lambda Function (%s) runtime management configuration: %w", d.Id(), err)
+			}
+		}
+	}
+
 	publish := d.Get("publish").(bool)
-	if publish && (codeUpdate || configUpdate || d.HasChange("publish")) {
+	if publish && (codeUpdate || configUpdate || runtimeManagementConfigUpdate || d.HasChange("publish")) {
 		versionReq := &lambda.PublishVersionInput{
 			FunctionName: aws.String(d.Id()),
 		}
@@ -1302,6 +1407,17 @@ func resourceFunctionUpdate(d *schema.ResourceData, meta interface{}) error {
 		if err != nil {
 			return fmt.Errorf("while waiting for function (%s) update: %w", d.Id(), err)
 		}
+
+		snapStartEnabled := aws.StringValue(expandSnapStart(d.Get("snap_start").([]interface{})).ApplyOn) == lambda.SnapStartApplyOnPublishedVersions
+		if err := waitFunctionVersionPublished(conn, d.Id(), aws.StringValue(output.Version), snapStartEnabled, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for Lambda Function (%s) version (%s) publication: %w", d.Id(), aws.StringValue(output.Version), err)
+		}
+	}
+
+	if v, ok := d.GetOk("retain_previous_versions"); ok && publish {
+		if err := deleteUnretainedFunctionVersions(conn, d.Id(), v.(int)); err != nil {
+			return fmt.Errorf("error deleting Lambda Function (%s) previous versions: %w", d.Id(), err)
+		}
 	}
 
 	return resourceFunctionRead(d, meta)
@@ -1336,6 +1452,86 @@ func findFunction(conn *lambda.Lambda, input *lambda.GetFunctionInput) (*lambda.
 	return output, nil
 }
 
+// deleteUnretainedFunctionVersions deletes the function's published versions that are older than
+// the latest version and the specified number of previous versions, unless an alias references them.
+func deleteUnretainedFunctionVersions(conn *lambda.Lambda, functionName string, retain int) error {
+	input := &lambda.ListVersionsByFunctionInput{
+		FunctionName: aws.String(functionName),
+	}
+	var versions []*lambda.FunctionConfiguration
+
+	err := conn.ListVersionsByFunctionPages(input, func(page *lambda.ListVersionsByFunctionOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		versions = append(versions, page.Versions...)
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("listing versions: %w", err)
+	}
+
+	aliased, err := findAliasedFunctionVersions(conn, functionName)
+
+	if err != nil {
+		return fmt.Errorf("listing aliases: %w", err)
+	}
+
+	for _, version := range unretainedFunctionVersions(versions, aliased, retain) {
+		log.Printf("[INFO] Deleting Lambda Function (%s) version: %s", functionName, version)
+		_, err := conn.DeleteFunction(&lambda.DeleteFunctionInput{
+			FunctionName: aws.String(functionName),
+			Qualifier:    aws.String(version),
+		})
+
+		if tfawserr.ErrCodeEquals(err, lambda.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("deleting version (%s): %w", version, err)
+		}
+	}
+
+	return nil
+}
+
+// unretainedFunctionVersions returns, oldest first, the published versions beyond the latest version
+// and the specified number of previous versions that are not referenced by an alias.
+func unretainedFunctionVersions(versions []*lambda.FunctionConfiguration, aliased map[string]bool, retain int) []string {
+	var published []int
+
+	for _, v := range versions {
+		if v == nil {
+			continue
+		}
+
+		// Skip $LATEST.
+		version, err := strconv.Atoi(aws.StringValue(v.Version))
+
+		if err != nil {
+			continue
+		}
+
+		published = append(published, version)
+	}
+
+	sort.Ints(published)
+
+	var unretained []string
+
+	for i := 0; i < len(published)-retain-1; i++ {
+		if version := strconv.Itoa(published[i]); !aliased[version] {
+			unretained = append(unretained, version)
+		}
+	}
+
+	return unretained
+}
+
 // loadFileContent returns contents of a file in a given path
 func loadFileContent(v string) ([]byte, error) {
 	filename, err := homedir.Expand(v)
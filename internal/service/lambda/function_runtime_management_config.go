@@ -0,0 +1,66 @@
+package lambda
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func findRuntimeManagementConfigByName(conn *lambda.Lambda, name string) (*lambda.GetRuntimeManagementConfigOutput, error) {
+	input := &lambda.GetRuntimeManagementConfigInput{
+		FunctionName: aws.String(name),
+	}
+
+	output, err := conn.GetRuntimeManagementConfig(input)
+
+	if tfawserr.ErrCodeEquals(err, lambda.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.UpdateRuntimeOn == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandRuntimeManagementConfig(tfList []interface{}) *lambda.PutRuntimeManagementConfigInput {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &lambda.PutRuntimeManagementConfigInput{}
+
+	if v, ok := tfMap["runtime_version_arn"].(string); ok && v != "" {
+		apiObject.RuntimeVersionArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["update_runtime_on"].(string); ok && v != "" {
+		apiObject.UpdateRuntimeOn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenRuntimeManagementConfig(apiObject *lambda.GetRuntimeManagementConfigOutput) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"runtime_version_arn": aws.StringValue(apiObject.RuntimeVersionArn),
+			"update_runtime_on":   aws.StringValue(apiObject.UpdateRuntimeOn),
+		},
+	}
+}
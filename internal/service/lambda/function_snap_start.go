@@ -0,0 +1,144 @@
+package lambda
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func expandSnapStart(tfList []interface{}) *lambda.SnapStart {
+	apiObject := &lambda.SnapStart{
+		ApplyOn: aws.String(lambda.SnapStartApplyOnNone),
+	}
+
+	if len(tfList) == 0 || tfList[0] == nil {
+		return apiObject
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap["apply_on"].(string); ok && v != "" {
+		apiObject.ApplyOn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenSnapStart(apiObject *lambda.SnapStartResponse) []interface{} {
+	// SnapStart is reported as "None" for every function that doesn't use it.
+	if apiObject == nil || apiObject.ApplyOn == nil || aws.StringValue(apiObject.ApplyOn) == lambda.SnapStartApplyOnNone {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"apply_on":            aws.StringValue(apiObject.ApplyOn),
+			"optimization_status": aws.StringValue(apiObject.OptimizationStatus),
+		},
+	}
+}
+
+func findFunctionConfigurationByNameAndQualifier(conn *lambda.Lambda, name, qualifier string) (*lambda.FunctionConfiguration, error) {
+	input := &lambda.GetFunctionConfigurationInput{
+		FunctionName: aws.String(name),
+		Qualifier:    aws.String(qualifier),
+	}
+
+	output, err := conn.GetFunctionConfiguration(input)
+
+	if tfawserr.ErrCodeEquals(err, lambda.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusFunctionVersionState(conn *lambda.Lambda, name, version string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findFunctionConfigurationByNameAndQualifier(conn, name, version)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		state := aws.StringValue(output.State)
+
+		if state == lambda.StateFailed {
+			return output, state, fmt.Errorf("%s: %s", aws.StringValue(output.StateReasonCode), aws.StringValue(output.StateReason))
+		}
+
+		return output, state, nil
+	}
+}
+
+func statusFunctionVersionSnapStartOptimization(conn *lambda.Lambda, name, version string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findFunctionConfigurationByNameAndQualifier(conn, name, version)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output.SnapStart == nil {
+			return output, lambda.SnapStartOptimizationStatusOff, nil
+		}
+
+		return output, aws.StringValue(output.SnapStart.OptimizationStatus), nil
+	}
+}
+
+// waitFunctionVersionPublished waits for a published function version to become active and,
+// when SnapStart is enabled, for its snapshot to be ready for invocations.
+func waitFunctionVersionPublished(conn *lambda.Lambda, name, version string, snapStartEnabled bool, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{lambda.StatePending},
+		Target:  []string{lambda.StateActive},
+		Refresh: statusFunctionVersionState(conn, name, version),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return err
+	}
+
+	if !snapStartEnabled {
+		return nil
+	}
+
+	stateConf = &resource.StateChangeConf{
+		Pending: []string{lambda.SnapStartOptimizationStatusOff},
+		Target:  []string{lambda.SnapStartOptimizationStatusOn},
+		Refresh: statusFunctionVersionSnapStartOptimization(conn, name, version),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
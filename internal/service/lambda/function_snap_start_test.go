@@ -0,0 +1,100 @@
+package lambda
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lambda"
+)
+
+func TestExpandSnapStart(t *testing.T) {
+	testCases := []struct {
+		TestName string
+		Input    []interface{}
+		Expected string
+	}{
+		{
+			TestName: "empty",
+			Expected: lambda.SnapStartApplyOnNone,
+		},
+		{
+			TestName: "published versions",
+			Input: []interface{}{
+				map[string]interface{}{
+					"apply_on": lambda.SnapStartApplyOnPublishedVersions,
+				},
+			},
+			Expected: lambda.SnapStartApplyOnPublishedVersions,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			if got := aws.StringValue(expandSnapStart(testCase.Input).ApplyOn); got != testCase.Expected {
+				t.Errorf("got %q, expected %q", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestFlattenSnapStart(t *testing.T) {
+	testCases := []struct {
+		TestName string
+		Input    *lambda.SnapStartResponse
+		Expected []interface{}
+	}{
+		{
+			TestName: "nil",
+		},
+		{
+			TestName: "none",
+			Input: &lambda.SnapStartResponse{
+				ApplyOn:            aws.String(lambda.SnapStartApplyOnNone),
+				OptimizationStatus: aws.String(lambda.SnapStartOptimizationStatusOff),
+			},
+		},
+		{
+			TestName: "published versions",
+			Input: &lambda.SnapStartResponse{
+				ApplyOn:            aws.String(lambda.SnapStartApplyOnPublishedVersions),
+				OptimizationStatus: aws.String(lambda.SnapStartOptimizationStatusOn),
+			},
+			Expected: []interface{}{
+				map[string]interface{}{
+					"apply_on":            lambda.SnapStartApplyOnPublishedVersions,
+					"optimization_status": lambda.SnapStartOptimizationStatusOn,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			if got := flattenSnapStart(testCase.Input); !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %v, expected %v", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestExpandRuntimeManagementConfig(t *testing.T) {
+	apiObject := expandRuntimeManagementConfig([]interface{}{
+		map[string]interface{}{
+			"runtime_version_arn": "arn:aws:lambda:us-west-2::runtime:0123456789abcdef",
+			"update_runtime_on":   lambda.UpdateRuntimeOnManual,
+		},
+	})
+
+	if got, expected := aws.StringValue(apiObject.RuntimeVersionArn), "arn:aws:lambda:us-west-2::runtime:0123456789abcdef"; got != expected {
+		t.Errorf("got RuntimeVersionArn %q, expected %q", got, expected)
+	}
+
+	if got, expected := aws.StringValue(apiObject.UpdateRuntimeOn), lambda.UpdateRuntimeOnManual; got != expected {
+		t.Errorf("got UpdateRuntimeOn %q, expected %q", got, expected)
+	}
+
+	if apiObject := expandRuntimeManagementConfig(nil); apiObject != nil {
+		t.Errorf("got %v, expected nil", apiObject)
+	}
+}
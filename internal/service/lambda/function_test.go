@@ -6,6 +6,7 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"strings"
 	"testing"
 	"time"
 
@@ -1161,6 +1162,138 @@ func TestAccLambdaFunction_ephemeralStorage(t *testing.T) {
 	})
 }
 
+func TestAccLambdaFunction_snapStart(t *testing.T) {
+	var conf lambda.GetFunctionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_function.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, lambda.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionConfig_snapStart(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "snap_start.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "snap_start.0.apply_on", "PublishedVersions"),
+					resource.TestCheckResourceAttr(resourceName, "snap_start.0.optimization_status", "Off"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"filename", "publish"},
+			},
+			{
+				Config: testAccFunctionConfig_snapStartDisabled(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "snap_start.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLambdaFunction_runtimeManagementConfig(t *testing.T) {
+	var conf lambda.GetFunctionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_function.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, lambda.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionConfig_runtimeManagementConfig(rName, "FunctionUpdate"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "runtime_management_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "runtime_management_config.0.update_runtime_on", "FunctionUpdate"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"filename", "publish"},
+			},
+			{
+				Config: testAccFunctionConfig_runtimeManagementConfig(rName, "Auto"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "runtime_management_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "runtime_management_config.0.update_runtime_on", "Auto"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLambdaFunction_retainPreviousVersions(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var conf lambda.GetFunctionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_function.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, lambda.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionConfig_retainPreviousVersions(rName, "one"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "retain_previous_versions", "1"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				Config: testAccFunctionConfig_retainPreviousVersions(rName, "two"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "version", "2"),
+					testAccCheckFunctionPublishedVersions(resourceName, []string{"1", "2"}),
+				),
+			},
+			{
+				Config: testAccFunctionConfig_retainPreviousVersionsAlias(rName, "three"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "version", "3"),
+					testAccCheckFunctionPublishedVersions(resourceName, []string{"2", "3"}),
+				),
+			},
+			{
+				// Version 2 is referenced by an alias, so it is retained.
+				Config: testAccFunctionConfig_retainPreviousVersionsAlias(rName, "four"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "version", "4"),
+					testAccCheckFunctionPublishedVersions(resourceName, []string{"2", "3", "4"}),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"filename", "publish", "retain_previous_versions"},
+			},
+		},
+	})
+}
+
 func TestAccLambdaFunction_tracing(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping long-running test in short mode")
@@ -2013,6 +2146,40 @@ func testAccCheckFunctionExists(n string, v *lambda.GetFunctionOutput) resource.
 	}
 }
 
+func testAccCheckFunctionPublishedVersions(n string, expected []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn
+
+		var versions []string
+		err := conn.ListVersionsByFunctionPages(&lambda.ListVersionsByFunctionInput{
+			FunctionName: aws.String(rs.Primary.ID),
+		}, func(page *lambda.ListVersionsByFunctionOutput, lastPage bool) bool {
+			for _, v := range page.Versions {
+				if version := aws.StringValue(v.Version); version != tflambda.FunctionVersionLatest {
+					versions = append(versions, version)
+				}
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if got, want := strings.Join(versions, ","), strings.Join(expected, ","); got != want {
+			return fmt.Errorf("Lambda Function (%s) published versions: got %s, expected %s", rs.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckFunctionQualifiedInvokeARN(name string, function *lambda.GetFunctionOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		qualifiedArn := fmt.Sprintf("%s:%s", aws.StringValue(function.Configuration.FunctionArn), aws.StringValue(function.Configuration.Version))
@@ -2903,6 +3070,85 @@ resource "aws_lambda_function" "test" {
 `, rName))
 }
 
+func testAccFunctionConfig_snapStart(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLambdaBase(rName, rName, rName),
+		fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.iam_for_lambda.arn
+  handler       = "example.Handler::handleRequest"
+  runtime       = "java11"
+
+  snap_start {
+    apply_on = "PublishedVersions"
+  }
+}
+`, rName))
+}
+
+func testAccFunctionConfig_snapStartDisabled(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLambdaBase(rName, rName, rName),
+		fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.iam_for_lambda.arn
+  handler       = "example.Handler::handleRequest"
+  runtime       = "java11"
+}
+`, rName))
+}
+
+func testAccFunctionConfig_runtimeManagementConfig(rName, updateRuntimeOn string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLambdaBase(rName, rName, rName),
+		fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.iam_for_lambda.arn
+  handler       = "exports.example"
+  runtime       = "nodejs16.x"
+
+  runtime_management_config {
+    update_runtime_on = %[2]q
+  }
+}
+`, rName, updateRuntimeOn))
+}
+
+func testAccFunctionConfig_retainPreviousVersions(rName, description string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLambdaBase(rName, rName, rName),
+		fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  filename                 = "test-fixtures/lambdatest.zip"
+  function_name            = %[1]q
+  description              = %[2]q
+  publish                  = true
+  retain_previous_versions = 1
+  role                     = aws_iam_role.iam_for_lambda.arn
+  handler                  = "exports.example"
+  runtime                  = "nodejs16.x"
+}
+`, rName, description))
+}
+
+func testAccFunctionConfig_retainPreviousVersionsAlias(rName, description string) string {
+	return acctest.ConfigCompose(
+		testAccFunctionConfig_retainPreviousVersions(rName, description),
+		fmt.Sprintf(`
+resource "aws_lambda_alias" "test" {
+  name             = %[1]q
+  function_name    = aws_lambda_function.test.function_name
+  function_version = "2"
+}
+`, rName))
+}
+
 func testAccFunctionConfig_updateEphemeralStorage(rName string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigLambdaBase(rName, rName, rName),
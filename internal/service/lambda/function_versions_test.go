@@ -0,0 +1,83 @@
+package lambda
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lambda"
+)
+
+func TestAliasedFunctionVersions(t *testing.T) {
+	aliases := []*lambda.AliasConfiguration{
+		{
+			FunctionVersion: aws.String("3"),
+			Name:            aws.String("live"),
+		},
+		nil,
+		{
+			FunctionVersion: aws.String("5"),
+			Name:            aws.String("canary"),
+			RoutingConfig: &lambda.AliasRoutingConfiguration{
+				AdditionalVersionWeights: aws.Float64Map(map[string]float64{"4": 0.1}),
+			},
+		},
+		{
+			FunctionVersion: aws.String(FunctionVersionLatest),
+			Name:            aws.String("dev"),
+		},
+	}
+
+	expected := map[string]bool{
+		"3":                   true,
+		"4":                   true,
+		"5":                   true,
+		FunctionVersionLatest: true,
+	}
+
+	if got := aliasedFunctionVersions(aliases); !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %v, expected %v", got, expected)
+	}
+}
+
+func TestUnretainedFunctionVersions(t *testing.T) {
+	var versions []*lambda.FunctionConfiguration
+	for _, v := range []string{FunctionVersionLatest, "1", "2", "3", "4", "5", "6", "7", "8", "9", "10"} {
+		versions = append(versions, &lambda.FunctionConfiguration{Version: aws.String(v)})
+	}
+
+	testCases := []struct {
+		TestName string
+		Aliased  map[string]bool
+		Retain   int
+		Expected []string
+	}{
+		{
+			TestName: "retain 1",
+			Retain:   1,
+			Expected: []string{"1", "2", "3", "4", "5", "6", "7", "8"},
+		},
+		{
+			TestName: "retain 3 with aliases",
+			Aliased:  map[string]bool{"2": true, "5": true, "9": true},
+			Retain:   3,
+			Expected: []string{"1", "3", "4", "6"},
+		},
+		{
+			TestName: "retain all",
+			Retain:   9,
+		},
+		{
+			TestName: "retain more than published",
+			Retain:   20,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			if got := unretainedFunctionVersions(versions, testCase.Aliased, testCase.Retain); !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %v, expected %v", got, testCase.Expected)
+			}
+		})
+	}
+}
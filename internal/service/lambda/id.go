@@ -0,0 +1,26 @@
+package lambda
+
+import (
+	"github.com/hashicorp/terraform-provider-aws/internal/importid"
+)
+
+var permissionImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "FUNCTION_NAME"},
+		{Name: "STATEMENT_ID"},
+	},
+	Separator: "/",
+}
+
+var aliasImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "FUNCTION_NAME"},
+		{Name: "ALIAS"},
+	},
+	Separator: "/",
+}
+
+func init() {
+	importid.Register("aws_lambda_permission", permissionImportIDFormat)
+	importid.Register("aws_lambda_alias", aliasImportIDFormat)
+}
@@ -0,0 +1,240 @@
+package lambda
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+const (
+	packageResourceIDSeparator = "/"
+
+	// packageHashMetadataKey is the user-defined object metadata key under which the archive's hash is stored,
+	// so that changes to the uploaded object made outside of Terraform are detected.
+	packageHashMetadataKey = "base64sha256"
+)
+
+func ResourcePackage() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePackageCreate,
+		ReadWithoutTimeout:   resourcePackageRead,
+		UpdateWithoutTimeout: resourcePackageUpdate,
+		DeleteWithoutTimeout: resourcePackageDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: resourcePackageCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"excludes": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"output_base64sha256": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"output_size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"s3_bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"s3_key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"s3_object_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"source_dir": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourcePackageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	bucket := d.Get("s3_bucket").(string)
+	key := d.Get("s3_key").(string)
+	id := strings.Join([]string{bucket, key}, packageResourceIDSeparator)
+
+	if err := packageUpload(ctx, d, meta); err != nil {
+		return diag.Errorf("creating Lambda Package (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourcePackageRead(ctx, d, meta)
+}
+
+func resourcePackageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	output, err := conn.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(d.Get("s3_bucket").(string)),
+		Key:    aws.String(d.Get("s3_key").(string)),
+	})
+
+	if !d.IsNewResource() && (tfawserr.ErrStatusCodeEquals(err, http.StatusNotFound) || tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket)) {
+		log.Printf("[WARN] Lambda Package (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Lambda Package (%s): %s", d.Id(), err)
+	}
+
+	// Record the hash of the object as uploaded so that a replaced object is uploaded again.
+	hash := ""
+
+	for k, v := range output.Metadata {
+		if strings.EqualFold(k, packageHashMetadataKey) {
+			hash = aws.StringValue(v)
+		}
+	}
+
+	d.Set("output_base64sha256", hash)
+	d.Set("output_size", output.ContentLength)
+	d.Set("s3_object_version", output.VersionId)
+
+	return nil
+}
+
+func resourcePackageUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChanges("excludes", "output_base64sha256", "source_dir") {
+		if err := packageUpload(ctx, d, meta); err != nil {
+			return diag.Errorf("updating Lambda Package (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourcePackageRead(ctx, d, meta)
+}
+
+func resourcePackageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	log.Printf("[DEBUG] Deleting Lambda Package: %s", d.Id())
+	_, err := conn.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(d.Get("s3_bucket").(string)),
+		Key:    aws.String(d.Get("s3_key").(string)),
+	})
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Lambda Package (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// resourcePackageCustomizeDiff builds the archive during planning so that its hash is known
+// and can be used as an aws_lambda_function's source_code_hash.
+func resourcePackageCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("source_dir") || !d.NewValueKnown("excludes") {
+		for _, k := range []string{"output_base64sha256", "output_size", "s3_object_version"} {
+			if err := d.SetNewComputed(k); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	archive, err := buildPackage(io.Discard, d.Get("source_dir").(string), flex.ExpandStringValueSet(d.Get("excludes").(*schema.Set)))
+
+	if err != nil {
+		return err
+	}
+
+	if d.Id() != "" && d.Get("output_base64sha256").(string) == archive.base64SHA256 {
+		return nil
+	}
+
+	if err := d.SetNew("output_base64sha256", archive.base64SHA256); err != nil {
+		return err
+	}
+
+	if err := d.SetNew("output_size", archive.size); err != nil {
+		return err
+	}
+
+	return d.SetNewComputed("s3_object_version")
+}
+
+// packageUpload builds the archive and uploads it to S3.
+// The archive must match the one built during planning.
+func packageUpload(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	f, err := os.CreateTemp("", "terraform-provider-aws-lambda-package-*.zip")
+
+	if err != nil {
+		return err
+	}
+
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	archive, err := buildPackage(f, d.Get("source_dir").(string), flex.ExpandStringValueSet(d.Get("excludes").(*schema.Set)))
+
+	if err != nil {
+		return err
+	}
+
+	if planned := d.Get("output_base64sha256").(string); planned != "" && planned != archive.base64SHA256 {
+		return fmt.Errorf("contents of source directory (%s) changed after planning", d.Get("source_dir").(string))
+	}
+
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	uploader := s3manager.NewUploaderWithClient(conn)
+	input := &s3manager.UploadInput{
+		Body:        f,
+		Bucket:      aws.String(d.Get("s3_bucket").(string)),
+		ContentType: aws.String("application/zip"),
+		Key:         aws.String(d.Get("s3_key").(string)),
+		Metadata: map[string]*string{
+			packageHashMetadataKey: aws.String(archive.base64SHA256),
+		},
+	}
+
+	if _, err := uploader.UploadWithContext(ctx, input); err != nil {
+		return fmt.Errorf("uploading archive: %w", err)
+	}
+
+	return nil
+}
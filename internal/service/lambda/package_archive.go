@@ -0,0 +1,322 @@
+package lambda
+
+import (
+	"archive/zip"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/mitchellh/go-homedir"
+)
+
+// packageModTime is the modification time recorded for every file in a package archive so that archives
+// built from the same files are identical regardless of when or where they are built.
+// It is the earliest time that can be represented in the MS-DOS date format used by zip.
+var packageModTime = time.Date(1980, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+type packageFile struct {
+	mode fs.FileMode
+	name string
+	path string
+}
+
+type packageArchive struct {
+	base64SHA256 string
+	size         int64
+}
+
+type packageCountingWriter struct {
+	n int64
+}
+
+func (w *packageCountingWriter) Write(p []byte) (int, error) {
+	w.n += int64(len(p))
+
+	return len(p), nil
+}
+
+// buildPackage writes a zip archive of the files under `sourceDir` that don't match any of the `excludes`
+// glob patterns to w, returning the archive's size and base64-encoded SHA-256 hash.
+func buildPackage(w io.Writer, sourceDir string, excludes []string) (*packageArchive, error) {
+	files, err := packageFiles(sourceDir, excludes, "")
+
+	if err != nil {
+		return nil, err
+	}
+
+	return writePackage(w, files)
+}
+
+// writePackage writes a zip archive of `files` to w, returning the archive's size and base64-encoded SHA-256 hash.
+func writePackage(w io.Writer, files []packageFile) (*packageArchive, error) {
+	hash := sha256.New()
+	counter := &packageCountingWriter{}
+	archive := zip.NewWriter(io.MultiWriter(w, hash, counter))
+
+	for _, v := range files {
+		if err := writePackageFile(archive, v); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := archive.Close(); err != nil {
+		return nil, err
+	}
+
+	return &packageArchive{
+		base64SHA256: base64.StdEncoding.EncodeToString(hash.Sum(nil)),
+		size:         counter.n,
+	}, nil
+}
+
+// buildPackageFile builds a package archive at `outputPath`, creating any missing parent directories.
+// The archive is written to a temporary file that replaces `outputPath` once complete.
+// `outputPath` may be under `sourceDir`: the files are listed before the temporary file is created,
+// and a previously built archive at `outputPath` is never archived.
+func buildPackageFile(outputPath, sourceDir string, excludes []string) (*packageArchive, error) {
+	outputPath, err := homedir.Expand(outputPath)
+
+	if err != nil {
+		return nil, fmt.Errorf("expanding homedir in output path: %w", err)
+	}
+
+	files, err := packageFiles(sourceDir, excludes, outputPath)
+
+	if err != nil {
+		return nil, err
+	}
+
+	dir := filepath.Dir(outputPath)
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+
+	f, err := os.CreateTemp(dir, filepath.Base(outputPath)+".*.tmp")
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer os.Remove(f.Name())
+
+	archive, err := writePackage(f, files)
+
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	if err := f.Close(); err != nil {
+		return nil, err
+	}
+
+	if err := os.Chmod(f.Name(), 0644); err != nil {
+		return nil, err
+	}
+
+	if err := os.Rename(f.Name(), outputPath); err != nil {
+		return nil, err
+	}
+
+	return archive, nil
+}
+
+func writePackageFile(archive *zip.Writer, file packageFile) error {
+	header := &zip.FileHeader{
+		Method:   zip.Deflate,
+		Modified: packageModTime,
+		Name:     file.name,
+	}
+	header.SetMode(file.mode)
+
+	w, err := archive.CreateHeader(header)
+
+	if err != nil {
+		return fmt.Errorf("adding %s to archive: %w", file.name, err)
+	}
+
+	f, err := os.Open(file.path)
+
+	if err != nil {
+		return err
+	}
+
+	defer f.Close()
+
+	if _, err := io.Copy(w, f); err != nil {
+		return fmt.Errorf("adding %s to archive: %w", file.name, err)
+	}
+
+	return nil
+}
+
+// packageFiles walks the directory tree rooted at `sourceDir` in lexical order, returning the files to archive.
+// Symbolic links to files are archived as the files they refer to. Symbolic links to directories are skipped.
+// Only the executable bits of each file's permissions are preserved.
+// The file at `outputPath`, if any, is skipped.
+func packageFiles(sourceDir string, excludes []string, outputPath string) ([]packageFile, error) {
+	root, err := homedir.Expand(sourceDir)
+
+	if err != nil {
+		return nil, fmt.Errorf("expanding homedir in source directory (%s): %w", sourceDir, err)
+	}
+
+	var output fs.FileInfo
+
+	if outputPath != "" {
+		output, err = os.Stat(outputPath)
+
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return nil, err
+		}
+	}
+
+	patterns := make([]*regexp.Regexp, 0, len(excludes))
+
+	for _, v := range excludes {
+		re, err := packageExcludeRegexp(v)
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid exclude pattern (%s): %w", v, err)
+		}
+
+		patterns = append(patterns, re)
+	}
+
+	var files []packageFile
+
+	err = filepath.WalkDir(root, func(p string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if p == root {
+			return nil
+		}
+
+		rel, err := filepath.Rel(root, p)
+
+		if err != nil {
+			return err
+		}
+
+		name := filepath.ToSlash(rel)
+
+		if packageExcluded(name, patterns) {
+			if entry.IsDir() {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		info, err := os.Stat(p)
+
+		if err != nil {
+			return err
+		}
+
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		if output != nil && os.SameFile(info, output) {
+			return nil
+		}
+
+		mode := fs.FileMode(0644)
+
+		if info.Mode().Perm()&0111 != 0 {
+			mode = 0755
+		}
+
+		files = append(files, packageFile{
+			mode: mode,
+			name: name,
+			path: p,
+		})
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("reading source directory (%s): %w", sourceDir, err)
+	}
+
+	if len(files) == 0 {
+		return nil, fmt.Errorf("source directory (%s) contains no files to archive", sourceDir)
+	}
+
+	return files, nil
+}
+
+func packageExcluded(name string, patterns []*regexp.Regexp) bool {
+	for _, re := range patterns {
+		if re.MatchString(name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// packageExcludeRegexp converts a glob pattern matched against slash-separated paths relative to the
+// source directory to a regular expression.
+// `*` and `?` match any sequence of characters and any single character other than `/`, `[...]` matches
+// a character class, and `**` matches any sequence of characters including `/`, so that `**/` matches
+// zero or more directories.
+func packageExcludeRegexp(pattern string) (*regexp.Regexp, error) {
+	var sb strings.Builder
+
+	sb.WriteString("^")
+
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; c {
+		case '*':
+			if i+1 < len(pattern) && pattern[i+1] == '*' {
+				i++
+
+				if i+1 < len(pattern) && pattern[i+1] == '/' {
+					i++
+					sb.WriteString("(?:.*/)?")
+				} else {
+					sb.WriteString(".*")
+				}
+			} else {
+				sb.WriteString("[^/]*")
+			}
+		case '?':
+			sb.WriteString("[^/]")
+		case '[':
+			j := strings.IndexByte(pattern[i:], ']')
+
+			if j < 0 {
+				return nil, fmt.Errorf("unterminated character class")
+			}
+
+			class := pattern[i+1 : i+j]
+
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+
+			sb.WriteString("[" + class + "]")
+			i += j
+		default:
+			sb.WriteString(regexp.QuoteMeta(pattern[i : i+1]))
+		}
+	}
+
+	sb.WriteString("$")
+
+	return regexp.Compile(sb.String())
+}
@@ -0,0 +1,260 @@
+package lambda
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func testPackageWriteFile(t *testing.T, dir, name, content string, mode os.FileMode) {
+	t.Helper()
+
+	p := filepath.Join(dir, filepath.FromSlash(name))
+
+	if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(p, []byte(content), mode); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestBuildPackage(t *testing.T) {
+	dir := t.TempDir()
+
+	testPackageWriteFile(t, dir, "index.js", "exports.handler = async () => {};", 0644)
+	testPackageWriteFile(t, dir, "bootstrap", "#!/bin/sh", 0700)
+	testPackageWriteFile(t, dir, "lib/util.js", "module.exports = {};", 0600)
+	testPackageWriteFile(t, dir, "lib/util.test.js", "test();", 0644)
+	testPackageWriteFile(t, dir, "node_modules/a/index.js", "a", 0644)
+	testPackageWriteFile(t, dir, ".git/HEAD", "ref: refs/heads/main", 0644)
+
+	excludes := []string{".git", "**/*.test.js"}
+
+	var buf bytes.Buffer
+	archive, err := buildPackage(&buf, dir, excludes)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	hash := sha256.Sum256(buf.Bytes())
+
+	if got, expected := archive.base64SHA256, base64.StdEncoding.EncodeToString(hash[:]); got != expected {
+		t.Errorf("got hash %q, expected %q", got, expected)
+	}
+
+	if got, expected := archive.size, int64(buf.Len()); got != expected {
+		t.Errorf("got size %d, expected %d", got, expected)
+	}
+
+	r, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var names []string
+	modes := make(map[string]os.FileMode)
+
+	for _, f := range r.File {
+		names = append(names, f.Name)
+		modes[f.Name] = f.Mode()
+
+		if !f.Modified.Equal(packageModTime) {
+			t.Errorf("got %s modification time %s, expected %s", f.Name, f.Modified, packageModTime)
+		}
+	}
+
+	if expected := []string{"bootstrap", "index.js", "lib/util.js", "node_modules/a/index.js"}; !reflect.DeepEqual(names, expected) {
+		t.Errorf("got files %v, expected %v", names, expected)
+	}
+
+	if got, expected := modes["bootstrap"], os.FileMode(0755); got != expected {
+		t.Errorf("got bootstrap mode %s, expected %s", got, expected)
+	}
+
+	if got, expected := modes["lib/util.js"], os.FileMode(0644); got != expected {
+		t.Errorf("got lib/util.js mode %s, expected %s", got, expected)
+	}
+
+	f, err := r.Open("index.js")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	content, _ := io.ReadAll(f)
+	f.Close()
+
+	if got, expected := string(content), "exports.handler = async () => {};"; got != expected {
+		t.Errorf("got index.js content %q, expected %q", got, expected)
+	}
+
+	// Touching the files must not change the archive.
+	later := time.Now().Add(time.Hour)
+
+	for _, name := range []string{"index.js", "bootstrap", "lib/util.js"} {
+		if err := os.Chtimes(filepath.Join(dir, filepath.FromSlash(name)), later, later); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	rebuilt, err := buildPackage(io.Discard, dir, excludes)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if rebuilt.base64SHA256 != archive.base64SHA256 {
+		t.Errorf("got hash %q after touching files, expected %q", rebuilt.base64SHA256, archive.base64SHA256)
+	}
+
+	testPackageWriteFile(t, dir, "index.js", "exports.handler = async () => 1;", 0644)
+
+	changed, err := buildPackage(io.Discard, dir, excludes)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if changed.base64SHA256 == archive.base64SHA256 {
+		t.Error("expected hash to change after modifying a file")
+	}
+}
+
+func TestBuildPackageFile(t *testing.T) {
+	dir := t.TempDir()
+	output := filepath.Join(t.TempDir(), "dist", "package.zip")
+
+	testPackageWriteFile(t, dir, "index.js", "exports.handler = async () => {};", 0644)
+
+	archive, err := buildPackageFile(output, dir, nil)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	content, err := os.ReadFile(output)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	hash := sha256.Sum256(content)
+
+	if got, expected := archive.base64SHA256, base64.StdEncoding.EncodeToString(hash[:]); got != expected {
+		t.Errorf("got hash %q, expected %q", got, expected)
+	}
+
+	entries, err := os.ReadDir(filepath.Dir(output))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(entries) != 1 {
+		t.Errorf("got %d files in output directory, expected 1", len(entries))
+	}
+}
+
+func TestBuildPackageFileInSourceDir(t *testing.T) {
+	dir := t.TempDir()
+	output := filepath.Join(dir, "dist", "package.zip")
+
+	testPackageWriteFile(t, dir, "index.js", "exports.handler = async () => {};", 0644)
+
+	archive, err := buildPackageFile(output, dir, nil)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	rebuilt, err := buildPackageFile(output, dir, nil)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if rebuilt.base64SHA256 != archive.base64SHA256 {
+		t.Error("expected hash to be unchanged when the output path is under the source directory")
+	}
+
+	r, err := zip.OpenReader(output)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	defer r.Close()
+
+	var names []string
+
+	for _, v := range r.File {
+		names = append(names, v.Name)
+	}
+
+	if expected := []string{"index.js"}; !reflect.DeepEqual(names, expected) {
+		t.Errorf("got files %v, expected %v", names, expected)
+	}
+}
+
+func TestBuildPackageEmpty(t *testing.T) {
+	dir := t.TempDir()
+
+	testPackageWriteFile(t, dir, "README.md", "# test", 0644)
+
+	if _, err := buildPackage(io.Discard, dir, []string{"*.md"}); err == nil {
+		t.Error("expected error, got none")
+	}
+}
+
+func TestPackageExcludeRegexp(t *testing.T) {
+	testCases := []struct {
+		Pattern  string
+		Name     string
+		Expected bool
+	}{
+		{Pattern: "*.md", Name: "README.md", Expected: true},
+		{Pattern: "*.md", Name: "docs/README.md"},
+		{Pattern: "**/*.md", Name: "README.md", Expected: true},
+		{Pattern: "**/*.md", Name: "docs/api/README.md", Expected: true},
+		{Pattern: "docs/**", Name: "docs/api/README.md", Expected: true},
+		{Pattern: "docs/**", Name: "documents/a"},
+		{Pattern: "**/node_modules", Name: "node_modules", Expected: true},
+		{Pattern: "**/node_modules", Name: "lib/node_modules", Expected: true},
+		{Pattern: "**/node_modules", Name: "lib/node_modules_old"},
+		{Pattern: "test?.js", Name: "test1.js", Expected: true},
+		{Pattern: "test?.js", Name: "test10.js"},
+		{Pattern: "*.py[co]", Name: "main.pyc", Expected: true},
+		{Pattern: "*.py[!co]", Name: "main.pyc"},
+		{Pattern: "a+b.txt", Name: "a+b.txt", Expected: true},
+		{Pattern: "a+b.txt", Name: "aab.txt"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Pattern+" "+testCase.Name, func(t *testing.T) {
+			re, err := packageExcludeRegexp(testCase.Pattern)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := re.MatchString(testCase.Name); got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+
+	if _, err := packageExcludeRegexp("[abc"); err == nil {
+		t.Error("expected error for unterminated character class, got none")
+	}
+}
@@ -0,0 +1,55 @@
+package lambda
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+func DataSourcePackage() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourcePackageRead,
+
+		Schema: map[string]*schema.Schema{
+			"excludes": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"output_base64sha256": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"output_path": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"output_size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"source_dir": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourcePackageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sourceDir := d.Get("source_dir").(string)
+
+	archive, err := buildPackageFile(d.Get("output_path").(string), sourceDir, flex.ExpandStringValueSet(d.Get("excludes").(*schema.Set)))
+
+	if err != nil {
+		return diag.Errorf("building Lambda Package (%s): %s", sourceDir, err)
+	}
+
+	d.SetId(archive.base64SHA256)
+	d.Set("output_base64sha256", archive.base64SHA256)
+	d.Set("output_size", archive.size)
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package lambda_test
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccLambdaPackageDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_lambda_package.test"
+	source := t.TempDir()
+	output := filepath.Join(t.TempDir(), "lambda.zip")
+
+	testAccPackageWriteFile(t, source, "lambda.js", "exports.handler = async () => ({ statusCode: 200 });")
+	testAccPackageWriteFile(t, source, "node_modules/a/index.js", "module.exports = {};")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, lambda.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPackageDataSourceConfig_basic(source, output),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "output_base64sha256"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "output_size"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "output_base64sha256", "data.aws_lambda_package.excluded", "output_base64sha256"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", dataSourceName, "output_base64sha256"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPackageDataSourceConfig_basic(source, output string) string {
+	return fmt.Sprintf(`
+data "aws_lambda_package" "test" {
+  source_dir  = %[1]q
+  excludes    = ["node_modules"]
+  output_path = %[2]q
+}
+
+data "aws_lambda_package" "excluded" {
+  source_dir  = %[1]q
+  excludes    = ["node_modules/**"]
+  output_path = "%[2]s.excluded"
+}
+`, source, output)
+}
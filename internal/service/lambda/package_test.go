@@ -0,0 +1,167 @@
+package lambda_test
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestAccLambdaPackage_basic(t *testing.T) {
+	var conf lambda.GetFunctionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_package.test"
+	functionResourceName := "aws_lambda_function.test"
+	source := t.TempDir()
+
+	testAccPackageWriteFile(t, source, "lambda.js", "exports.handler = async () => ({ statusCode: 200 });")
+	testAccPackageWriteFile(t, source, "lambda.test.js", "test();")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, lambda.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPackageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPackageConfig_basic(rName, source),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPackageExists(resourceName),
+					testAccCheckFunctionExists(functionResourceName, &conf),
+					resource.TestCheckResourceAttrSet(resourceName, "output_base64sha256"),
+					resource.TestCheckResourceAttrSet(resourceName, "output_size"),
+					resource.TestCheckResourceAttrPair(functionResourceName, "source_code_hash", resourceName, "output_base64sha256"),
+				),
+			},
+			{
+				// Rebuilding unchanged files produces the same archive.
+				PreConfig: func() {
+					testAccPackageWriteFile(t, source, "lambda.js", "exports.handler = async () => ({ statusCode: 200 });")
+				},
+				Config:   testAccPackageConfig_basic(rName, source),
+				PlanOnly: true,
+			},
+			{
+				// Excluded files don't affect the archive.
+				PreConfig: func() {
+					testAccPackageWriteFile(t, source, "lambda.test.js", "test(); test();")
+				},
+				Config:   testAccPackageConfig_basic(rName, source),
+				PlanOnly: true,
+			},
+			{
+				PreConfig: func() {
+					testAccPackageWriteFile(t, source, "lambda.js", "exports.handler = async () => ({ statusCode: 204 });")
+				},
+				Config: testAccPackageConfig_basic(rName, source),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPackageExists(resourceName),
+					testAccCheckFunctionExists(functionResourceName, &conf),
+					resource.TestCheckResourceAttrPair(functionResourceName, "source_code_hash", resourceName, "output_base64sha256"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPackageExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lambda Package ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+
+		_, err := conn.HeadObject(&s3.HeadObjectInput{
+			Bucket: aws.String(rs.Primary.Attributes["s3_bucket"]),
+			Key:    aws.String(rs.Primary.Attributes["s3_key"]),
+		})
+
+		return err
+	}
+}
+
+func testAccCheckPackageDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lambda_package" {
+			continue
+		}
+
+		_, err := conn.HeadObject(&s3.HeadObjectInput{
+			Bucket: aws.String(rs.Primary.Attributes["s3_bucket"]),
+			Key:    aws.String(rs.Primary.Attributes["s3_key"]),
+		})
+
+		if tfawserr.ErrStatusCodeEquals(err, http.StatusNotFound) || tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Lambda Package %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccPackageWriteFile(t *testing.T, dir, name, content string) {
+	t.Helper()
+
+	p := filepath.Join(dir, filepath.FromSlash(name))
+
+	if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(p, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testAccPackageConfig_basic(rName, source string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLambdaBase(rName, rName, rName),
+		fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_lambda_package" "test" {
+  source_dir = %[2]q
+  excludes   = ["**/*.test.js"]
+  s3_bucket  = aws_s3_bucket.test.bucket
+  s3_key     = "lambda.zip"
+}
+
+resource "aws_lambda_function" "test" {
+  s3_bucket        = aws_lambda_package.test.s3_bucket
+  s3_key           = aws_lambda_package.test.s3_key
+  source_code_hash = aws_lambda_package.test.output_base64sha256
+  function_name    = %[1]q
+  role             = aws_iam_role.iam_for_lambda.arn
+  handler          = "lambda.handler"
+  runtime          = "nodejs16.x"
+}
+`, rName, source))
+}
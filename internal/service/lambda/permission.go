@@ -365,9 +365,9 @@ func GetFunctionNameFromARN(arn string) (string, error) {
 }
 
 func resourcePermissionImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	idParts := strings.Split(d.Id(), "/")
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		return nil, fmt.Errorf("Unexpected format of ID (%q), expected FUNCTION_NAME/STATEMENT_ID or FUNCTION_NAME:QUALIFIER/STATEMENT_ID", d.Id())
+	idParts, err := permissionImportIDFormat.Parse(d.Id())
+	if err != nil {
+		return nil, err
 	}
 
 	functionName := idParts[0]
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"log"
 	"regexp"
-	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -296,9 +295,9 @@ func resourceBotAliasDelete(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceBotAliasImport(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
-	parts := strings.Split(d.Id(), ":")
-	if len(parts) != 2 {
-		return nil, fmt.Errorf("invalid Lex Bot Alias resource id '%s', expected BOT_NAME:BOT_ALIAS_NAME", d.Id())
+	parts, err := botAliasImportIDFormat.Parse(d.Id())
+	if err != nil {
+		return nil, err
 	}
 
 	d.Set("bot_name", parts[0])
@@ -0,0 +1,17 @@
+package lexmodels
+
+import (
+	"github.com/hashicorp/terraform-provider-aws/internal/importid"
+)
+
+var botAliasImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "BOT_NAME"},
+		{Name: "BOT_ALIAS_NAME"},
+	},
+	Separator: ":",
+}
+
+func init() {
+	importid.Register("aws_lex_bot_alias", botAliasImportIDFormat)
+}
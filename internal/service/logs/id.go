@@ -0,0 +1,35 @@
+package logs
+
+import (
+	"github.com/hashicorp/terraform-provider-aws/internal/importid"
+)
+
+var streamImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "log-group-name"},
+		{Name: "log-stream-name"},
+	},
+	Separator: ":",
+}
+
+var metricFilterImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "log_group_name"},
+		{Name: "name"},
+	},
+	Separator: ":",
+}
+
+var subscriptionFilterImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "log-group-name"},
+		{Name: "filter-name", Greedy: true},
+	},
+	Separator: "|",
+}
+
+func init() {
+	importid.Register("aws_cloudwatch_log_stream", streamImportIDFormat)
+	importid.Register("aws_cloudwatch_log_metric_filter", metricFilterImportIDFormat)
+	importid.Register("aws_cloudwatch_log_subscription_filter", subscriptionFilterImportIDFormat)
+}
@@ -225,9 +225,9 @@ func resourceMetricFilterDelete(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceMetricFilterImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	idParts := strings.Split(d.Id(), ":")
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		return nil, fmt.Errorf("Unexpected format of ID (%q), expected <log_group_name>:<name>", d.Id())
+	idParts, err := metricFilterImportIDFormat.Parse(d.Id())
+	if err != nil {
+		return nil, err
 	}
 	logGroupName := idParts[0]
 	name := idParts[1]
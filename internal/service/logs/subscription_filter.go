@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"fmt"
 	"log"
-	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -209,9 +208,9 @@ func resourceSubscriptionFilterDelete(d *schema.ResourceData, meta interface{})
 }
 
 func resourceSubscriptionFilterImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	idParts := strings.Split(d.Id(), "|")
-	if len(idParts) < 2 {
-		return nil, fmt.Errorf("unexpected format of ID (%q), expected <log-group-name>|<filter-name>", d.Id())
+	idParts, err := subscriptionFilterImportIDFormat.Parse(d.Id())
+	if err != nil {
+		return nil, err
 	}
 
 	logGroupName := idParts[0]
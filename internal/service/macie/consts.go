@@ -0,0 +1,9 @@
+package macie
+
+// Classification types of the retired Macie Classic API.
+const (
+	s3ContinuousClassificationTypeFull = "FULL"
+
+	s3OneTimeClassificationTypeFull = "FULL"
+	s3OneTimeClassificationTypeNone = "NONE"
+)
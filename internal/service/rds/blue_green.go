@@ -0,0 +1,185 @@
+package rds
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// updateInstanceWithBlueGreenDeployment upgrades a DB instance's engine version and/or DB parameter group
+// using a Blue/Green Deployment: a green copy of the instance is created with the changes, kept in sync by
+// replication, and switched over to, after which the old (blue) instance and the deployment are deleted.
+// Either argument may be empty to leave that setting unchanged.
+// Unless skipFinalSnapshot is true, a final snapshot of the old instance is taken, named after the instance and the deployment.
+func updateInstanceWithBlueGreenDeployment(ctx context.Context, conn *rds.RDS, id, sourceARN, engineVersion, parameterGroupName string, deletionProtection, skipFinalSnapshot bool, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+
+	input := &rds.CreateBlueGreenDeploymentInput{
+		BlueGreenDeploymentName: aws.String(resource.PrefixedUniqueId("tf-")),
+		Source:                  aws.String(sourceARN),
+	}
+
+	if engineVersion != "" {
+		input.TargetEngineVersion = aws.String(engineVersion)
+	}
+
+	if parameterGroupName != "" {
+		input.TargetDBParameterGroupName = aws.String(parameterGroupName)
+	}
+
+	log.Printf("[DEBUG] Creating RDS Blue/Green Deployment for RDS DB Instance: %s", id)
+	output, err := conn.CreateBlueGreenDeploymentWithContext(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("creating RDS Blue/Green Deployment for RDS DB Instance (%s): %w", id, err)
+	}
+
+	if output.BlueGreenDeployment == nil {
+		return fmt.Errorf("creating RDS Blue/Green Deployment for RDS DB Instance (%s): empty result", id)
+	}
+
+	deploymentID := aws.StringValue(output.BlueGreenDeployment.BlueGreenDeploymentIdentifier)
+	switchedOver := false
+
+	defer func() {
+		if switchedOver {
+			return
+		}
+
+		// Remove the green environment if the switchover didn't happen, leaving the instance unchanged.
+		log.Printf("[DEBUG] Deleting RDS Blue/Green Deployment (%s) and its target", deploymentID)
+		_, err := conn.DeleteBlueGreenDeploymentWithContext(ctx, &rds.DeleteBlueGreenDeploymentInput{
+			BlueGreenDeploymentIdentifier: aws.String(deploymentID),
+			DeleteTarget:                  aws.Bool(true),
+		})
+
+		if err != nil {
+			log.Printf("[WARN] deleting RDS Blue/Green Deployment (%s): %s", deploymentID, err)
+		}
+	}()
+
+	deployment, err := waitBlueGreenDeploymentAvailable(ctx, conn, deploymentID, time.Until(deadline))
+
+	if err != nil {
+		return fmt.Errorf("waiting for RDS Blue/Green Deployment (%s) create: %w", deploymentID, err)
+	}
+
+	targetID, err := blueGreenDeploymentMemberIdentifier(aws.StringValue(deployment.Target))
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := waitDBInstanceUpdated(ctx, conn, targetID, time.Until(deadline)); err != nil {
+		return fmt.Errorf("waiting for RDS DB Instance (%s) update: %w", targetID, err)
+	}
+
+	log.Printf("[DEBUG] Switching over RDS Blue/Green Deployment (%s)", deploymentID)
+	_, err = conn.SwitchoverBlueGreenDeploymentWithContext(ctx, &rds.SwitchoverBlueGreenDeploymentInput{
+		BlueGreenDeploymentIdentifier: aws.String(deploymentID),
+	})
+
+	if err != nil {
+		return fmt.Errorf("switching over RDS Blue/Green Deployment (%s): %w", deploymentID, err)
+	}
+
+	deployment, err = waitBlueGreenDeploymentSwitchoverCompleted(ctx, conn, deploymentID, time.Until(deadline))
+
+	if err != nil {
+		return fmt.Errorf("waiting for RDS Blue/Green Deployment (%s) switchover: %w", deploymentID, err)
+	}
+
+	switchedOver = true
+
+	// After switchover the deployment's source is the old (blue) instance, renamed with an "-old1" suffix.
+	sourceID, err := blueGreenDeploymentMemberIdentifier(aws.StringValue(deployment.Source))
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting RDS Blue/Green Deployment: %s", deploymentID)
+	_, err = conn.DeleteBlueGreenDeploymentWithContext(ctx, &rds.DeleteBlueGreenDeploymentInput{
+		BlueGreenDeploymentIdentifier: aws.String(deploymentID),
+	})
+
+	if err != nil {
+		return fmt.Errorf("deleting RDS Blue/Green Deployment (%s): %w", deploymentID, err)
+	}
+
+	if _, err := waitBlueGreenDeploymentDeleted(ctx, conn, deploymentID, time.Until(deadline)); err != nil {
+		return fmt.Errorf("waiting for RDS Blue/Green Deployment (%s) delete: %w", deploymentID, err)
+	}
+
+	if sourceID == id {
+		return fmt.Errorf("RDS Blue/Green Deployment (%s) source (%s) was not renamed on switchover", deploymentID, sourceID)
+	}
+
+	if deletionProtection {
+		_, err := conn.ModifyDBInstanceWithContext(ctx, &rds.ModifyDBInstanceInput{
+			ApplyImmediately:     aws.Bool(true),
+			DBInstanceIdentifier: aws.String(sourceID),
+			DeletionProtection:   aws.Bool(false),
+		})
+
+		if err != nil {
+			return fmt.Errorf("updating RDS DB Instance (%s): %w", sourceID, err)
+		}
+
+		if _, err := waitDBInstanceUpdated(ctx, conn, sourceID, time.Until(deadline)); err != nil {
+			return fmt.Errorf("waiting for RDS DB Instance (%s) update: %w", sourceID, err)
+		}
+	}
+
+	deleteInput := &rds.DeleteDBInstanceInput{
+		DBInstanceIdentifier: aws.String(sourceID),
+		SkipFinalSnapshot:    aws.Bool(skipFinalSnapshot),
+	}
+
+	if !skipFinalSnapshot {
+		deleteInput.FinalDBSnapshotIdentifier = aws.String(blueGreenDeploymentFinalSnapshotIdentifier(id, deploymentID))
+	}
+
+	log.Printf("[DEBUG] Deleting RDS DB Instance: %s", sourceID)
+	_, err = conn.DeleteDBInstanceWithContext(ctx, deleteInput)
+
+	if err != nil {
+		return fmt.Errorf("deleting RDS DB Instance (%s): %w", sourceID, err)
+	}
+
+	if _, err := waitDBInstanceDeleted(ctx, conn, sourceID, time.Until(deadline)); err != nil {
+		return fmt.Errorf("waiting for RDS DB Instance (%s) delete: %w", sourceID, err)
+	}
+
+	return nil
+}
+
+// blueGreenDeploymentFinalSnapshotIdentifier returns the identifier of the final snapshot of the old (blue) instance
+// of a Blue/Green Deployment, e.g. "mydb-bgd-wxyz1234".
+func blueGreenDeploymentFinalSnapshotIdentifier(id, deploymentID string) string {
+	return fmt.Sprintf("%s-%s", id, deploymentID)
+}
+
+// blueGreenDeploymentMemberIdentifier returns the DB instance identifier from a Blue/Green Deployment source or target ARN.
+func blueGreenDeploymentMemberIdentifier(v string) (string, error) {
+	memberARN, err := arn.Parse(v)
+
+	if err != nil {
+		return "", fmt.Errorf("parsing RDS Blue/Green Deployment member ARN (%s): %w", v, err)
+	}
+
+	id := strings.TrimPrefix(memberARN.Resource, "db:")
+
+	if id == memberARN.Resource {
+		return "", fmt.Errorf("RDS Blue/Green Deployment member (%s) is not a DB instance", v)
+	}
+
+	return id, nil
+}
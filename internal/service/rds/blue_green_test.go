@@ -0,0 +1,130 @@
+package rds
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/rds"
+)
+
+func TestBlueGreenDeploymentMemberIdentifier(t *testing.T) {
+	testCases := []struct {
+		TestName    string
+		ARN         string
+		Expected    string
+		ExpectError bool
+	}{
+		{
+			TestName: "instance",
+			ARN:      "arn:aws:rds:us-west-2:123456789012:db:test-old1",
+			Expected: "test-old1",
+		},
+		{
+			TestName:    "cluster",
+			ARN:         "arn:aws:rds:us-west-2:123456789012:cluster:test",
+			ExpectError: true,
+		},
+		{
+			TestName:    "invalid",
+			ARN:         "test",
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got, err := blueGreenDeploymentMemberIdentifier(testCase.ARN)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatal("expected error, got none")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("got %q, expected %q", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestDescribeBlueGreenDeployments(t *testing.T) {
+	var form url.Values
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		form, _ = url.ParseQuery(string(body))
+
+		w.Header().Set("Content-Type", "text/xml")
+		io.WriteString(w, `<DescribeBlueGreenDeploymentsResponse xmlns="http://rds.amazonaws.com/doc/2014-10-31/">
+  <DescribeBlueGreenDeploymentsResult>
+    <BlueGreenDeployments>
+      <member>
+        <BlueGreenDeploymentIdentifier>bgd-1234567890abcdef</BlueGreenDeploymentIdentifier>
+        <BlueGreenDeploymentName>tf-test</BlueGreenDeploymentName>
+        <Source>arn:aws:rds:us-west-2:123456789012:db:test</Source>
+        <Target>arn:aws:rds:us-west-2:123456789012:db:test-green-abcdef</Target>
+        <Status>PROVISIONING</Status>
+        <Tasks>
+          <member>
+            <Name>CREATING_READ_REPLICA_OF_SOURCE</Name>
+            <Status>IN_PROGRESS</Status>
+          </member>
+        </Tasks>
+        <CreateTime>2022-11-29T00:00:00.000Z</CreateTime>
+      </member>
+    </BlueGreenDeployments>
+  </DescribeBlueGreenDeploymentsResult>
+</DescribeBlueGreenDeploymentsResponse>`)
+	}))
+	defer server.Close()
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("AKID", "SECRET", ""),
+		Endpoint:    aws.String(server.URL),
+		Region:      aws.String("us-west-2"),
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	output, err := findBlueGreenDeploymentByID(context.Background(), rds.New(sess), "bgd-1234567890abcdef")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := form.Get("Action"), "DescribeBlueGreenDeployments"; got != expected {
+		t.Errorf("got Action %q, expected %q", got, expected)
+	}
+
+	if got, expected := form.Get("BlueGreenDeploymentIdentifier"), "bgd-1234567890abcdef"; got != expected {
+		t.Errorf("got BlueGreenDeploymentIdentifier %q, expected %q", got, expected)
+	}
+
+	if got, expected := aws.StringValue(output.Status), BlueGreenDeploymentStatusProvisioning; got != expected {
+		t.Errorf("got Status %q, expected %q", got, expected)
+	}
+
+	if got, expected := aws.StringValue(output.Target), "arn:aws:rds:us-west-2:123456789012:db:test-green-abcdef"; got != expected {
+		t.Errorf("got Target %q, expected %q", got, expected)
+	}
+
+	if got, expected := len(output.Tasks), 1; got != expected {
+		t.Fatalf("got %d Tasks, expected %d", got, expected)
+	}
+
+	if got, expected := aws.StringValue(output.Tasks[0].Status), "IN_PROGRESS"; got != expected {
+		t.Errorf("got Task Status %q, expected %q", got, expected)
+	}
+}
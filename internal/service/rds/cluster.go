@@ -1,6 +1,7 @@
 package rds
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -235,10 +236,23 @@ func ResourceCluster() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: verify.ValidARN,
 			},
+			"manage_master_user_password": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ConflictsWith: []string{"master_password"},
+			},
 			"master_password": {
-				Type:      schema.TypeString,
-				Optional:  true,
-				Sensitive: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"manage_master_user_password"},
+			},
+			"master_user_secret": masterUserSecretSchema(),
+			"master_user_secret_kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				RequiredWith: []string{"manage_master_user_password"},
 			},
 			"master_username": {
 				Type:     schema.TypeString,
@@ -536,6 +550,16 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 			requiresModifyDbCluster = true
 		}
 
+		if d.Get("manage_master_user_password").(bool) {
+			modifyDbClusterInput.ManageMasterUserPassword = aws.Bool(true)
+
+			if v, ok := d.GetOk("master_user_secret_kms_key_id"); ok {
+				modifyDbClusterInput.MasterUserSecretKmsKeyId = aws.String(v.(string))
+			}
+
+			requiresModifyDbCluster = true
+		}
+
 		if v, ok := d.GetOk("network_type"); ok {
 			input.NetworkType = aws.String(v.(string))
 		}
@@ -582,8 +606,8 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 			return fmt.Errorf("creating RDS Cluster (restore from snapshot) (%s): %w", identifier, err)
 		}
 	} else if v, ok := d.GetOk("s3_import"); ok {
-		if _, ok := d.GetOk("master_password"); !ok {
-			return fmt.Errorf(`provider.aws: aws_db_instance: %s: "master_password": required field is not set`, d.Get("name").(string))
+		if _, ok := d.GetOk("master_password"); !ok && !d.Get("manage_master_user_password").(bool) {
+			return fmt.Errorf(`provider.aws: aws_db_instance: %s: "master_password" or "manage_master_user_password": required field is not set`, d.Get("name").(string))
 		}
 		if _, ok := d.GetOk("master_username"); !ok {
 			return fmt.Errorf(`provider.aws: aws_db_instance: %s: "master_username": required field is not set`, d.Get("name").(string))
@@ -596,7 +620,6 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 			DeletionProtection:  aws.Bool(d.Get("deletion_protection").(bool)),
 			Engine:              aws.String(d.Get("engine").(string)),
 			MasterUsername:      aws.String(d.Get("master_username").(string)),
-			MasterUserPassword:  aws.String(d.Get("master_password").(string)),
 			S3BucketName:        aws.String(tfMap["bucket_name"].(string)),
 			S3IngestionRoleArn:  aws.String(tfMap["ingestion_role"].(string)),
 			S3Prefix:            aws.String(tfMap["bucket_prefix"].(string)),
@@ -669,6 +692,18 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 			input.VpcSecurityGroupIds = flex.ExpandStringSet(v.(*schema.Set))
 		}
 
+		if v, ok := d.GetOk("master_password"); ok {
+			input.MasterUserPassword = aws.String(v.(string))
+		}
+
+		if d.Get("manage_master_user_password").(bool) {
+			input.ManageMasterUserPassword = aws.Bool(true)
+
+			if v, ok := d.GetOk("master_user_secret_kms_key_id"); ok {
+				input.MasterUserSecretKmsKeyId = aws.String(v.(string))
+			}
+		}
+
 		_, err := tfresource.RetryWhen(propagationTimeout,
 			func() (interface{}, error) {
 				return conn.RestoreDBClusterFromS3(input)
@@ -751,6 +786,16 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 			requiresModifyDbCluster = true
 		}
 
+		if d.Get("manage_master_user_password").(bool) {
+			modifyDbClusterInput.ManageMasterUserPassword = aws.Bool(true)
+
+			if v, ok := d.GetOk("master_user_secret_kms_key_id"); ok {
+				modifyDbClusterInput.MasterUserSecretKmsKeyId = aws.String(v.(string))
+			}
+
+			requiresModifyDbCluster = true
+		}
+
 		if v, ok := d.GetOk("network_type"); ok {
 			input.NetworkType = aws.String(v.(string))
 		}
@@ -927,6 +972,14 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 			input.VpcSecurityGroupIds = flex.ExpandStringSet(v.(*schema.Set))
 		}
 
+		if d.Get("manage_master_user_password").(bool) {
+			input.ManageMasterUserPassword = aws.Bool(true)
+
+			if v, ok := d.GetOk("master_user_secret_kms_key_id"); ok {
+				input.MasterUserSecretKmsKeyId = aws.String(v.(string))
+			}
+		}
+
 		_, err := tfresource.RetryWhenAWSErrMessageContains(propagationTimeout,
 			func() (interface{}, error) {
 				return conn.CreateDBCluster(input)
@@ -974,7 +1027,7 @@ func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
-	dbc, err := FindDBClusterByID(conn, d.Id())
+	dbc, err := FindDBClusterByID(context.Background(), conn, d.Id())
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] RDS Cluster (%s) not found, removing from state", d.Id())
@@ -1027,6 +1080,19 @@ func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("iops", dbc.Iops)
 	d.Set("kms_key_id", dbc.KmsKeyId)
 	d.Set("master_username", dbc.MasterUsername)
+
+	if dbc.MasterUserSecret != nil {
+		d.Set("manage_master_user_password", true)
+		d.Set("master_user_secret_kms_key_id", dbc.MasterUserSecret.KmsKeyId)
+
+		if err := d.Set("master_user_secret", flattenMasterUserSecret(dbc.MasterUserSecret)); err != nil {
+			return fmt.Errorf("setting master_user_secret: %w", err)
+		}
+	} else {
+		d.Set("manage_master_user_password", nil)
+		d.Set("master_user_secret_kms_key_id", nil)
+		d.Set("master_user_secret", nil)
+	}
 	d.Set("network_type", dbc.NetworkType)
 	d.Set("port", dbc.Port)
 	d.Set("preferred_backup_window", dbc.PreferredBackupWindow)
@@ -1174,7 +1240,17 @@ func resourceClusterUpdate(d *schema.ResourceData, meta interface{}) error {
 			input.Iops = aws.Int64(int64(d.Get("iops").(int)))
 		}
 
-		if d.HasChange("master_password") {
+		if d.HasChanges("manage_master_user_password", "master_user_secret_kms_key_id") {
+			manage := d.Get("manage_master_user_password").(bool)
+			input.ManageMasterUserPassword = aws.Bool(manage)
+
+			if v := d.Get("master_user_secret_kms_key_id").(string); manage && v != "" {
+				input.MasterUserSecretKmsKeyId = aws.String(v)
+			}
+		}
+
+		// Removing the password when moving to a managed master user password leaves the password unchanged.
+		if d.HasChange("master_password") && !d.Get("manage_master_user_password").(bool) {
 			input.MasterUserPassword = aws.String(d.Get("master_password").(string))
 		}
 
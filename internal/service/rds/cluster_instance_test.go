@@ -1,6 +1,7 @@
 package rds_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strings"
@@ -1110,7 +1111,7 @@ func testAccCheckClusterInstanceExists(n string, v *rds.DBInstance) resource.Tes
 
 		conn := acctest.Provider.Meta().(*conns.AWSClient).RDSConn
 
-		output, err := tfrds.FindDBInstanceByID(conn, rs.Primary.ID)
+		output, err := tfrds.FindDBInstanceByID(context.Background(), conn, rs.Primary.ID)
 
 		if err != nil {
 			return err
@@ -1130,7 +1131,7 @@ func testAccCheckClusterInstanceDestroy(s *terraform.State) error {
 			continue
 		}
 
-		_, err := tfrds.FindDBInstanceByID(conn, rs.Primary.ID)
+		_, err := tfrds.FindDBInstanceByID(context.Background(), conn, rs.Primary.ID)
 
 		if tfresource.NotFound(err) {
 			continue
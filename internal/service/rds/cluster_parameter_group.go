@@ -1,14 +1,18 @@
 package rds
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -22,12 +26,20 @@ const clusterParameterGroupMaxParamsBulkEdit = 20
 
 func ResourceClusterParameterGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceClusterParameterGroupCreate,
-		Read:   resourceClusterParameterGroupRead,
-		Update: resourceClusterParameterGroupUpdate,
-		Delete: resourceClusterParameterGroupDelete,
+		CreateWithoutTimeout: resourceClusterParameterGroupCreate,
+		ReadWithoutTimeout:   resourceClusterParameterGroupRead,
+		UpdateWithoutTimeout: resourceClusterParameterGroupUpdate,
+		DeleteWithoutTimeout: resourceClusterParameterGroupDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				// Set non API attributes to their Default settings in the schema
+				d.Set("reboot_attached_instances", false)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(60 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -84,15 +96,28 @@ func ResourceClusterParameterGroup() *schema.Resource {
 				},
 				Set: resourceParameterHash,
 			},
+			"pending_reboot_parameters": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"reboot_attached_instances": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			customizeDiffStaticParameterChanges(findEngineDefaultClusterParameters),
+			verify.SetTagsDiff,
+		),
 	}
 }
 
-func resourceClusterParameterGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceClusterParameterGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).RDSConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
@@ -107,9 +132,9 @@ func resourceClusterParameterGroupCreate(d *schema.ResourceData, meta interface{
 	}
 
 	log.Printf("[DEBUG] Create DB Cluster Parameter Group: %#v", createOpts)
-	output, err := conn.CreateDBClusterParameterGroup(&createOpts)
+	output, err := conn.CreateDBClusterParameterGroupWithContext(ctx, &createOpts)
 	if err != nil {
-		return fmt.Errorf("Error creating DB Cluster Parameter Group: %s", err)
+		return diag.Errorf("Error creating DB Cluster Parameter Group: %s", err)
 	}
 
 	d.SetId(aws.StringValue(createOpts.DBClusterParameterGroupName))
@@ -118,10 +143,10 @@ func resourceClusterParameterGroupCreate(d *schema.ResourceData, meta interface{
 	// Set for update
 	d.Set("arn", output.DBClusterParameterGroup.DBClusterParameterGroupArn)
 
-	return resourceClusterParameterGroupUpdate(d, meta)
+	return resourceClusterParameterGroupUpdate(ctx, d, meta)
 }
 
-func resourceClusterParameterGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceClusterParameterGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).RDSConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
@@ -130,7 +155,7 @@ func resourceClusterParameterGroupRead(d *schema.ResourceData, meta interface{})
 		DBClusterParameterGroupName: aws.String(d.Id()),
 	}
 
-	describeResp, err := conn.DescribeDBClusterParameterGroups(&describeOpts)
+	describeResp, err := conn.DescribeDBClusterParameterGroupsWithContext(ctx, &describeOpts)
 	if err != nil {
 		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "DBParameterGroupNotFound" {
 			log.Printf("[WARN] DB Cluster Parameter Group (%s) not found, error code (404)", d.Id())
@@ -138,12 +163,12 @@ func resourceClusterParameterGroupRead(d *schema.ResourceData, meta interface{})
 			return nil
 		}
 
-		return err
+		return diag.FromErr(err)
 	}
 
 	if len(describeResp.DBClusterParameterGroups) != 1 ||
 		aws.StringValue(describeResp.DBClusterParameterGroups[0].DBClusterParameterGroupName) != d.Id() {
-		return fmt.Errorf("Unable to find Cluster Parameter Group: %#v", describeResp.DBClusterParameterGroups)
+		return diag.Errorf("Unable to find Cluster Parameter Group: %#v", describeResp.DBClusterParameterGroups)
 	}
 
 	arn := aws.StringValue(describeResp.DBClusterParameterGroups[0].DBClusterParameterGroupArn)
@@ -160,20 +185,32 @@ func resourceClusterParameterGroupRead(d *schema.ResourceData, meta interface{})
 	}
 
 	var parameters []*rds.Parameter
-	err = conn.DescribeDBClusterParametersPages(&describeParametersOpts,
+	err = conn.DescribeDBClusterParametersPagesWithContext(ctx, &describeParametersOpts,
 		func(describeParametersResp *rds.DescribeDBClusterParametersOutput, lastPage bool) bool {
 			parameters = append(parameters, describeParametersResp.Parameters...)
 			return !lastPage
 		})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := d.Set("parameter", FlattenParameters(parameters)); err != nil {
-		return fmt.Errorf("error setting parameters: %s", err)
+		return diag.Errorf("error setting parameters: %s", err)
+	}
+
+	// Static parameter changes made by Terraform remain pending until the cluster members using the group are rebooted.
+	// The apply status doesn't identify the pending parameters, so changes made outside of Terraform are never reported.
+	if d.Get("pending_reboot_parameters").(*schema.Set).Len() > 0 {
+		pending, err := clusterParameterGroupPendingReboot(conn, d.Id())
+
+		if err != nil {
+			log.Printf("[WARN] reading DB Cluster Parameter Group (%s) attached instances: %s", d.Id(), err)
+		} else if !pending {
+			d.Set("pending_reboot_parameters", nil)
+		}
 	}
 
-	resp, err := conn.ListTagsForResource(&rds.ListTagsForResourceInput{
+	resp, err := conn.ListTagsForResourceWithContext(ctx, &rds.ListTagsForResourceInput{
 		ResourceName: aws.String(arn),
 	})
 	if err != nil {
@@ -184,17 +221,17 @@ func resourceClusterParameterGroupRead(d *schema.ResourceData, meta interface{})
 
 	//lintignore:AWSR002
 	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
-		return fmt.Errorf("error setting tags: %w", err)
+		return diag.Errorf("error setting tags: %s", err)
 	}
 
 	if err := d.Set("tags_all", tags.Map()); err != nil {
-		return fmt.Errorf("error setting tags_all: %w", err)
+		return diag.Errorf("error setting tags_all: %s", err)
 	}
 
 	return nil
 }
 
-func resourceClusterParameterGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceClusterParameterGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).RDSConn
 
 	if d.HasChange("parameter") {
@@ -228,9 +265,9 @@ func resourceClusterParameterGroupUpdate(d *schema.ResourceData, meta interface{
 				}
 
 				log.Printf("[DEBUG] Modify DB Cluster Parameter Group: %s", modifyOpts)
-				_, err := conn.ModifyDBClusterParameterGroup(&modifyOpts)
+				_, err := conn.ModifyDBClusterParameterGroupWithContext(ctx, &modifyOpts)
 				if err != nil {
-					return fmt.Errorf("error modifying DB Cluster Parameter Group: %s", err)
+					return diag.Errorf("error modifying DB Cluster Parameter Group: %s", err)
 				}
 			}
 		}
@@ -271,8 +308,8 @@ func resourceClusterParameterGroupUpdate(d *schema.ResourceData, meta interface{
 				}
 
 				log.Printf("[DEBUG] Reset DB Cluster Parameter Group: %s", resetOpts)
-				err := resource.Retry(3*time.Minute, func() *resource.RetryError {
-					_, err := conn.ResetDBClusterParameterGroup(&resetOpts)
+				err := resource.RetryContext(ctx, 3*time.Minute, func() *resource.RetryError {
+					_, err := conn.ResetDBClusterParameterGroupWithContext(ctx, &resetOpts)
 					if err != nil {
 						if tfawserr.ErrMessageContains(err, "InvalidDBParameterGroupState", "has pending changes") {
 							return resource.RetryableError(err)
@@ -283,11 +320,27 @@ func resourceClusterParameterGroupUpdate(d *schema.ResourceData, meta interface{
 				})
 
 				if tfresource.TimedOut(err) {
-					_, err = conn.ResetDBClusterParameterGroup(&resetOpts)
+					_, err = conn.ResetDBClusterParameterGroupWithContext(ctx, &resetOpts)
 				}
 
 				if err != nil {
-					return fmt.Errorf("error resetting DB Cluster Parameter Group: %s", err)
+					return diag.Errorf("error resetting DB Cluster Parameter Group: %s", err)
+				}
+			}
+		}
+
+		if d.Get("reboot_attached_instances").(bool) && !d.IsNewResource() {
+			names, err := findStaticParameterChanges(conn, findEngineDefaultClusterParameters, d.Get("family").(string), os, ns)
+
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			if len(names) > 0 {
+				log.Printf("[INFO] Rebooting RDS Cluster members using DB Cluster Parameter Group (%s) to apply static parameters: %s", d.Id(), strings.Join(names, ", "))
+
+				if err := rebootClusterParameterGroupDBInstances(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+					return diag.Errorf("rebooting RDS Cluster members using DB Cluster Parameter Group (%s): %s", d.Id(), err)
 				}
 			}
 		}
@@ -296,15 +349,15 @@ func resourceClusterParameterGroupUpdate(d *schema.ResourceData, meta interface{
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 
-		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
-			return fmt.Errorf("error updating RDS Cluster Parameter Group (%s) tags: %s", d.Id(), err)
+		if err := UpdateTagsWithContext(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating RDS Cluster Parameter Group (%s) tags: %s", d.Id(), err)
 		}
 	}
 
-	return resourceClusterParameterGroupRead(d, meta)
+	return resourceClusterParameterGroupRead(ctx, d, meta)
 }
 
-func resourceClusterParameterGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceClusterParameterGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"pending"},
 		Target:     []string{"destroyed"},
@@ -312,8 +365,8 @@ func resourceClusterParameterGroupDelete(d *schema.ResourceData, meta interface{
 		Timeout:    3 * time.Minute,
 		MinTimeout: 1 * time.Second,
 	}
-	_, err := stateConf.WaitForState()
-	return err
+	_, err := stateConf.WaitForStateContext(ctx)
+	return diag.FromErr(err)
 }
 
 func resourceClusterParameterGroupDeleteRefreshFunc(
@@ -340,3 +393,42 @@ func resourceClusterParameterGroupDeleteRefreshFunc(
 		return d, "destroyed", nil
 	}
 }
+
+// rebootClusterParameterGroupDBInstances reboots, one at a time, the members of the available RDS Clusters using
+// the DB cluster parameter group that are pending a reboot to apply it. Readers are rebooted before the writer.
+func rebootClusterParameterGroupDBInstances(ctx context.Context, conn *rds.RDS, name string, timeout time.Duration) error {
+	clusters, err := findDBClustersByClusterParameterGroupName(conn, name)
+
+	if err != nil {
+		return fmt.Errorf("listing RDS Clusters: %w", err)
+	}
+
+	for _, v := range clusters {
+		dbClusterID := aws.StringValue(v.DBClusterIdentifier)
+
+		if status := aws.StringValue(v.Status); status != ClusterStatusAvailable {
+			log.Printf("[WARN] Not rebooting RDS Cluster (%s) members with cluster status %q", dbClusterID, status)
+			continue
+		}
+
+		for _, member := range clusterMembersInRebootOrder(v.DBClusterMembers) {
+			dbInstanceID := aws.StringValue(member.DBInstanceIdentifier)
+
+			output, err := waitDBClusterMemberParameterGroupApplied(ctx, conn, dbClusterID, dbInstanceID, timeout)
+
+			if err != nil {
+				return fmt.Errorf("waiting for RDS Cluster (%s) member (%s) parameter apply: %w", dbClusterID, dbInstanceID, err)
+			}
+
+			if output == nil || aws.StringValue(output.DBClusterParameterGroupStatus) != parameterApplyStatusPendingReboot {
+				continue
+			}
+
+			if err := rebootDBInstance(ctx, conn, dbInstanceID, timeout); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
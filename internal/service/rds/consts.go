@@ -90,6 +90,30 @@ const (
 	InstanceAutomatedBackupStatusRetained    = "retained"
 )
 
+const (
+	BlueGreenDeploymentStatusAvailable            = "AVAILABLE"
+	BlueGreenDeploymentStatusDeleting             = "DELETING"
+	BlueGreenDeploymentStatusInvalidConfiguration = "INVALID_CONFIGURATION"
+	BlueGreenDeploymentStatusProvisioning         = "PROVISIONING"
+	BlueGreenDeploymentStatusSwitchoverCompleted  = "SWITCHOVER_COMPLETED"
+	BlueGreenDeploymentStatusSwitchoverFailed     = "SWITCHOVER_FAILED"
+	BlueGreenDeploymentStatusSwitchoverInProgress = "SWITCHOVER_IN_PROGRESS"
+)
+
+const (
+	blueGreenDeploymentTaskStatusCompleted = "COMPLETED"
+)
+
+const (
+	parameterApplyStatusApplying      = "applying"
+	parameterApplyStatusInSync        = "in-sync"
+	parameterApplyStatusPendingReboot = "pending-reboot"
+)
+
+const (
+	parameterApplyTypeStatic = "static"
+)
+
 const (
 	EventSubscriptionStatusActive    = "active"
 	EventSubscriptionStatusCreating  = "creating"
@@ -70,7 +70,7 @@ func FindDBProxyEndpoint(conn *rds.RDS, id string) (*rds.DBProxyEndpoint, error)
 }
 
 func FindDBClusterRoleByDBClusterIDAndRoleARN(conn *rds.RDS, dbClusterID, roleARN string) (*rds.DBClusterRole, error) {
-	dbCluster, err := FindDBClusterByID(conn, dbClusterID)
+	dbCluster, err := FindDBClusterByID(context.Background(), conn, dbClusterID)
 
 	if err != nil {
 		return nil, err
@@ -91,12 +91,12 @@ func FindDBClusterRoleByDBClusterIDAndRoleARN(conn *rds.RDS, dbClusterID, roleAR
 	return nil, &resource.NotFoundError{}
 }
 
-func FindDBClusterByID(conn *rds.RDS, id string) (*rds.DBCluster, error) {
+func FindDBClusterByID(ctx context.Context, conn *rds.RDS, id string) (*rds.DBCluster, error) {
 	input := &rds.DescribeDBClustersInput{
 		DBClusterIdentifier: aws.String(id),
 	}
 
-	output, err := conn.DescribeDBClusters(input)
+	output, err := conn.DescribeDBClustersWithContext(ctx, input)
 
 	if tfawserr.ErrCodeEquals(err, rds.ErrCodeDBClusterNotFoundFault) {
 		return nil, &resource.NotFoundError{
@@ -188,12 +188,12 @@ func FindDBClusterSnapshotByID(conn *rds.RDS, id string) (*rds.DBClusterSnapshot
 	return dbClusterSnapshot, nil
 }
 
-func FindDBInstanceByID(conn *rds.RDS, id string) (*rds.DBInstance, error) {
+func FindDBInstanceByID(ctx context.Context, conn *rds.RDS, id string) (*rds.DBInstance, error) {
 	input := &rds.DescribeDBInstancesInput{
 		DBInstanceIdentifier: aws.String(id),
 	}
 
-	output, err := conn.DescribeDBInstances(input)
+	output, err := conn.DescribeDBInstancesWithContext(ctx, input)
 
 	if tfawserr.ErrCodeEquals(err, rds.ErrCodeDBInstanceNotFoundFault) {
 		return nil, &resource.NotFoundError{
@@ -218,6 +218,44 @@ func FindDBInstanceByID(conn *rds.RDS, id string) (*rds.DBInstance, error) {
 	return dbInstance, nil
 }
 
+func findBlueGreenDeploymentByID(ctx context.Context, conn *rds.RDS, id string) (*rds.BlueGreenDeployment, error) {
+	input := &rds.DescribeBlueGreenDeploymentsInput{
+		BlueGreenDeploymentIdentifier: aws.String(id),
+	}
+
+	output, err := conn.DescribeBlueGreenDeploymentsWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, rds.ErrCodeBlueGreenDeploymentNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.BlueGreenDeployments) == 0 || output.BlueGreenDeployments[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.BlueGreenDeployments); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	deployment := output.BlueGreenDeployments[0]
+
+	// Eventual consistency check.
+	if aws.StringValue(deployment.BlueGreenDeploymentIdentifier) != id {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return deployment, nil
+}
+
 func FindDBProxyByName(conn *rds.RDS, name string) (*rds.DBProxy, error) {
 	input := &rds.DescribeDBProxiesInput{
 		DBProxyName: aws.String(name),
@@ -496,6 +534,120 @@ func findGlobalClusters(conn *rds.RDS, input *rds.DescribeGlobalClustersInput) (
 	return output, nil
 }
 
+func findDBClustersByClusterParameterGroupName(conn *rds.RDS, name string) ([]*rds.DBCluster, error) {
+	input := &rds.DescribeDBClustersInput{}
+	var output []*rds.DBCluster
+
+	err := conn.DescribeDBClustersPages(input, func(page *rds.DescribeDBClustersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.DBClusters {
+			if v != nil && aws.StringValue(v.DBClusterParameterGroup) == name {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func findDBInstancesByParameterGroupName(conn *rds.RDS, name string) ([]*rds.DBInstance, error) {
+	input := &rds.DescribeDBInstancesInput{}
+	var output []*rds.DBInstance
+
+	err := conn.DescribeDBInstancesPages(input, func(page *rds.DescribeDBInstancesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.DBInstances {
+			if v == nil {
+				continue
+			}
+
+			if dbParameterGroupStatus(v, name) != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func findEngineDefaultClusterParameters(conn *rds.RDS, family string) ([]*rds.Parameter, error) {
+	input := &rds.DescribeEngineDefaultClusterParametersInput{
+		DBParameterGroupFamily: aws.String(family),
+	}
+	var output []*rds.Parameter
+
+	for {
+		page, err := conn.DescribeEngineDefaultClusterParameters(input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if page == nil || page.EngineDefaults == nil {
+			break
+		}
+
+		for _, v := range page.EngineDefaults.Parameters {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		if aws.StringValue(page.EngineDefaults.Marker) == "" {
+			break
+		}
+
+		input.Marker = page.EngineDefaults.Marker
+	}
+
+	return output, nil
+}
+
+func findEngineDefaultParameters(conn *rds.RDS, family string) ([]*rds.Parameter, error) {
+	input := &rds.DescribeEngineDefaultParametersInput{
+		DBParameterGroupFamily: aws.String(family),
+	}
+	var output []*rds.Parameter
+
+	err := conn.DescribeEngineDefaultParametersPages(input, func(page *rds.DescribeEngineDefaultParametersOutput, lastPage bool) bool {
+		if page == nil || page.EngineDefaults == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.EngineDefaults.Parameters {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
 // FindReservedDBInstanceByID returns matching ReservedDBInstance.
 func FindReservedDBInstanceByID(ctx context.Context, conn *rds.RDS, id string) (*rds.ReservedDBInstance, error) {
 	input := &rds.DescribeReservedDBInstancesInput{
@@ -1,7 +1,7 @@
 package rds
 
 import (
-	"errors"
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -12,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -24,10 +25,10 @@ import (
 
 func ResourceInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceInstanceCreate,
-		Read:   resourceInstanceRead,
-		Update: resourceInstanceUpdate,
-		Delete: resourceInstanceDelete,
+		CreateWithoutTimeout: resourceInstanceCreate,
+		ReadWithoutTimeout:   resourceInstanceRead,
+		UpdateWithoutTimeout: resourceInstanceUpdate,
+		DeleteWithoutTimeout: resourceInstanceDelete,
 
 		Importer: &schema.ResourceImporter{
 			State: resourceInstanceImport,
@@ -119,6 +120,20 @@ func ResourceInstance() *schema.Resource {
 				Computed:     true,
 				ValidateFunc: verify.ValidOnceADayWindowFormat,
 			},
+			"blue_green_update": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"replicate_source_db"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
 			"ca_cert_identifier": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -281,6 +296,18 @@ func ResourceInstance() *schema.Resource {
 				},
 				ValidateFunc: verify.ValidOnceAWeekWindowFormat,
 			},
+			"manage_master_user_password": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ConflictsWith: []string{"password"},
+			},
+			"master_user_secret": masterUserSecretSchema(),
+			"master_user_secret_kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				RequiredWith: []string{"manage_master_user_password"},
+			},
 			"max_allocated_storage": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -340,9 +367,14 @@ func ResourceInstance() *schema.Resource {
 				Computed: true,
 			},
 			"password": {
-				Type:      schema.TypeString,
-				Optional:  true,
-				Sensitive: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"manage_master_user_password"},
+			},
+			"pending_reboot": {
+				Type:     schema.TypeBool,
+				Computed: true,
 			},
 			"performance_insights_enabled": {
 				Type:     schema.TypeBool,
@@ -524,13 +556,13 @@ func ResourceInstance() *schema.Resource {
 	}
 }
 
-func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).RDSConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
 	if v, ok := d.GetOk("security_group_names"); ok && v.(*schema.Set).Len() > 0 {
-		return errors.New(`with the retirement of EC2-Classic no new RDS DB Instances can be created referencing RDS DB Security Groups`)
+		return diag.Errorf(`with the retirement of EC2-Classic no new RDS DB Instances can be created referencing RDS DB Security Groups`)
 	}
 
 	// Some API calls (e.g. CreateDBInstanceReadReplica and
@@ -652,14 +684,14 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		log.Printf("[DEBUG] Creating RDS DB Instance: %s", input)
-		outputRaw, err := tfresource.RetryWhenAWSErrMessageContains(propagationTimeout,
+		outputRaw, err := tfresource.RetryWhenAWSErrMessageContainsContext(ctx, propagationTimeout,
 			func() (interface{}, error) {
-				return conn.CreateDBInstanceReadReplica(input)
+				return conn.CreateDBInstanceReadReplicaWithContext(ctx, input)
 			},
 			errCodeInvalidParameterValue, "ENHANCED_MONITORING")
 
 		if err != nil {
-			return fmt.Errorf("creating RDS DB Instance (read replica) (%s): %w", identifier, err)
+			return diag.Errorf("creating RDS DB Instance (read replica) (%s): %s", identifier, err)
 		}
 
 		output := outputRaw.(*rds.CreateDBInstanceReadReplicaOutput)
@@ -726,23 +758,23 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		if _, ok := d.GetOk("allocated_storage"); !ok {
-			return fmt.Errorf(`provider.aws: aws_db_instance: %s: "allocated_storage": required field is not set`, dbName)
+			return diag.Errorf(`provider.aws: aws_db_instance: %s: "allocated_storage": required field is not set`, dbName)
 		}
 		if _, ok := d.GetOk("engine"); !ok {
-			return fmt.Errorf(`provider.aws: aws_db_instance: %s: "engine": required field is not set`, dbName)
+			return diag.Errorf(`provider.aws: aws_db_instance: %s: "engine": required field is not set`, dbName)
 		}
-		if _, ok := d.GetOk("password"); !ok {
-			return fmt.Errorf(`provider.aws: aws_db_instance: %s: "password": required field is not set`, dbName)
+		if _, ok := d.GetOk("password"); !ok && !d.Get("manage_master_user_password").(bool) {
+			return diag.Errorf(`provider.aws: aws_db_instance: %s: "password" or "manage_master_user_password": required field is not set`, dbName)
 		}
 		if _, ok := d.GetOk("username"); !ok {
-			return fmt.Errorf(`provider.aws: aws_db_instance: %s: "username": required field is not set`, dbName)
+			return diag.Errorf(`provider.aws: aws_db_instance: %s: "username": required field is not set`, dbName)
 		}
 
 		if _, ok := d.GetOk("character_set_name"); ok {
-			return fmt.Errorf(`provider.aws: aws_db_instance: %s: "character_set_name" doesn't work with with restores"`, dbName)
+			return diag.Errorf(`provider.aws: aws_db_instance: %s: "character_set_name" doesn't work with with restores"`, dbName)
 		}
 		if _, ok := d.GetOk("timezone"); ok {
-			return fmt.Errorf(`provider.aws: aws_db_instance: %s: "timezone" doesn't work with with restores"`, dbName)
+			return diag.Errorf(`provider.aws: aws_db_instance: %s: "timezone" doesn't work with with restores"`, dbName)
 		}
 
 		tfMap := v.([]interface{})[0].(map[string]interface{})
@@ -758,7 +790,6 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 			Engine:                  aws.String(d.Get("engine").(string)),
 			EngineVersion:           aws.String(d.Get("engine_version").(string)),
 			MasterUsername:          aws.String(d.Get("username").(string)),
-			MasterUserPassword:      aws.String(d.Get("password").(string)),
 			PubliclyAccessible:      aws.Bool(d.Get("publicly_accessible").(bool)),
 			S3BucketName:            aws.String(tfMap["bucket_name"].(string)),
 			S3IngestionRoleArn:      aws.String(tfMap["ingestion_role"].(string)),
@@ -849,9 +880,21 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 			input.VpcSecurityGroupIds = flex.ExpandStringSet(v.(*schema.Set))
 		}
 
-		_, err := tfresource.RetryWhen(propagationTimeout,
+		if v, ok := d.GetOk("password"); ok {
+			input.MasterUserPassword = aws.String(v.(string))
+		}
+
+		if d.Get("manage_master_user_password").(bool) {
+			input.ManageMasterUserPassword = aws.Bool(true)
+
+			if v, ok := d.GetOk("master_user_secret_kms_key_id"); ok {
+				input.MasterUserSecretKmsKeyId = aws.String(v.(string))
+			}
+		}
+
+		_, err := tfresource.RetryWhenContext(ctx, propagationTimeout,
 			func() (interface{}, error) {
-				return conn.RestoreDBInstanceFromS3(input)
+				return conn.RestoreDBInstanceFromS3WithContext(ctx, input)
 			},
 			func(err error) (bool, error) {
 				if tfawserr.ErrMessageContains(err, errCodeInvalidParameterValue, "ENHANCED_MONITORING") {
@@ -873,7 +916,7 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 		)
 
 		if err != nil {
-			return fmt.Errorf("creating RDS DB Instance (restore from S3) (%s): %w", identifier, err)
+			return diag.Errorf("creating RDS DB Instance (restore from S3) (%s): %s", identifier, err)
 		}
 	} else if _, ok := d.GetOk("snapshot_identifier"); ok {
 		input := &rds.RestoreDBInstanceFromDBSnapshotInput{
@@ -1031,6 +1074,16 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 			requiresModifyDbInstance = true
 		}
 
+		if d.Get("manage_master_user_password").(bool) {
+			modifyDbInstanceInput.ManageMasterUserPassword = aws.Bool(true)
+
+			if v, ok := d.GetOk("master_user_secret_kms_key_id"); ok {
+				modifyDbInstanceInput.MasterUserSecretKmsKeyId = aws.String(v.(string))
+			}
+
+			requiresModifyDbInstance = true
+		}
+
 		if v, ok := d.GetOk("performance_insights_enabled"); ok {
 			modifyDbInstanceInput.EnablePerformanceInsights = aws.Bool(v.(bool))
 			requiresModifyDbInstance = true
@@ -1062,9 +1115,9 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		log.Printf("[DEBUG] Creating RDS DB Instance: %s", input)
-		_, err := tfresource.RetryWhen(propagationTimeout,
+		_, err := tfresource.RetryWhenContext(ctx, propagationTimeout,
 			func() (interface{}, error) {
-				return conn.RestoreDBInstanceFromDBSnapshot(input)
+				return conn.RestoreDBInstanceFromDBSnapshotWithContext(ctx, input)
 			},
 			func(err error) (bool, error) {
 				if tfawserr.ErrMessageContains(err, errCodeValidationError, "RDS couldn't fetch the role from instance profile") {
@@ -1087,11 +1140,11 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 			input.MultiAZ = aws.Bool(false)
 			modifyDbInstanceInput.MultiAZ = aws.Bool(true)
 			requiresModifyDbInstance = true
-			_, err = conn.RestoreDBInstanceFromDBSnapshot(input)
+			_, err = conn.RestoreDBInstanceFromDBSnapshotWithContext(ctx, input)
 		}
 
 		if err != nil {
-			return fmt.Errorf("creating RDS DB Instance (restore from snapshot) (%s): %w", identifier, err)
+			return diag.Errorf("creating RDS DB Instance (restore from snapshot) (%s): %s", identifier, err)
 		}
 	} else if v, ok := d.GetOk("restore_to_point_in_time"); ok {
 		tfMap := v.([]interface{})[0].(map[string]interface{})
@@ -1220,10 +1273,20 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 			input.VpcSecurityGroupIds = flex.ExpandStringSet(v.(*schema.Set))
 		}
 
+		if d.Get("manage_master_user_password").(bool) {
+			modifyDbInstanceInput.ManageMasterUserPassword = aws.Bool(true)
+
+			if v, ok := d.GetOk("master_user_secret_kms_key_id"); ok {
+				modifyDbInstanceInput.MasterUserSecretKmsKeyId = aws.String(v.(string))
+			}
+
+			requiresModifyDbInstance = true
+		}
+
 		log.Printf("[DEBUG] Creating RDS DB Instance: %s", input)
-		_, err := tfresource.RetryWhen(propagationTimeout,
+		_, err := tfresource.RetryWhenContext(ctx, propagationTimeout,
 			func() (interface{}, error) {
-				return conn.RestoreDBInstanceToPointInTime(input)
+				return conn.RestoreDBInstanceToPointInTimeWithContext(ctx, input)
 			},
 			func(err error) (bool, error) {
 				if tfawserr.ErrMessageContains(err, errCodeValidationError, "RDS couldn't fetch the role from instance profile") {
@@ -1235,7 +1298,7 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 		)
 
 		if err != nil {
-			return fmt.Errorf("creating RDS DB Instance (restore to point-in-time) (%s): %w", identifier, err)
+			return diag.Errorf("creating RDS DB Instance (restore to point-in-time) (%s): %s", identifier, err)
 		}
 	} else {
 		dbName := d.Get("db_name").(string)
@@ -1244,16 +1307,16 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		if _, ok := d.GetOk("allocated_storage"); !ok {
-			return fmt.Errorf(`provider.aws: aws_db_instance: %s: "allocated_storage": required field is not set`, dbName)
+			return diag.Errorf(`provider.aws: aws_db_instance: %s: "allocated_storage": required field is not set`, dbName)
 		}
 		if _, ok := d.GetOk("engine"); !ok {
-			return fmt.Errorf(`provider.aws: aws_db_instance: %s: "engine": required field is not set`, dbName)
+			return diag.Errorf(`provider.aws: aws_db_instance: %s: "engine": required field is not set`, dbName)
 		}
-		if _, ok := d.GetOk("password"); !ok {
-			return fmt.Errorf(`provider.aws: aws_db_instance: %s: "password": required field is not set`, dbName)
+		if _, ok := d.GetOk("password"); !ok && !d.Get("manage_master_user_password").(bool) {
+			return diag.Errorf(`provider.aws: aws_db_instance: %s: "password" or "manage_master_user_password": required field is not set`, dbName)
 		}
 		if _, ok := d.GetOk("username"); !ok {
-			return fmt.Errorf(`provider.aws: aws_db_instance: %s: "username": required field is not set`, dbName)
+			return diag.Errorf(`provider.aws: aws_db_instance: %s: "username": required field is not set`, dbName)
 		}
 
 		input := &rds.CreateDBInstanceInput{
@@ -1268,7 +1331,6 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 			Engine:                  aws.String(d.Get("engine").(string)),
 			EngineVersion:           aws.String(d.Get("engine_version").(string)),
 			MasterUsername:          aws.String(d.Get("username").(string)),
-			MasterUserPassword:      aws.String(d.Get("password").(string)),
 			PubliclyAccessible:      aws.Bool(d.Get("publicly_accessible").(bool)),
 			StorageEncrypted:        aws.Bool(d.Get("storage_encrypted").(bool)),
 			Tags:                    Tags(tags.IgnoreAWS()),
@@ -1394,9 +1456,21 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 			input.VpcSecurityGroupIds = flex.ExpandStringSet(v)
 		}
 
-		outputRaw, err := tfresource.RetryWhen(propagationTimeout,
+		if v, ok := d.GetOk("password"); ok {
+			input.MasterUserPassword = aws.String(v.(string))
+		}
+
+		if d.Get("manage_master_user_password").(bool) {
+			input.ManageMasterUserPassword = aws.Bool(true)
+
+			if v, ok := d.GetOk("master_user_secret_kms_key_id"); ok {
+				input.MasterUserSecretKmsKeyId = aws.String(v.(string))
+			}
+		}
+
+		outputRaw, err := tfresource.RetryWhenContext(ctx, propagationTimeout,
 			func() (interface{}, error) {
-				return conn.CreateDBInstance(input)
+				return conn.CreateDBInstanceWithContext(ctx, input)
 			},
 			func(err error) (bool, error) {
 				if tfawserr.ErrMessageContains(err, errCodeInvalidParameterValue, "ENHANCED_MONITORING") {
@@ -1411,7 +1485,7 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 		)
 
 		if err != nil {
-			return fmt.Errorf("creating RDS DB Instance (%s): %w", identifier, err)
+			return diag.Errorf("creating RDS DB Instance (%s): %s", identifier, err)
 		}
 
 		output := outputRaw.(*rds.CreateDBInstanceOutput)
@@ -1425,47 +1499,47 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(identifier)
 
-	if _, err := waitDBInstanceCreated(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
-		return fmt.Errorf("waiting for RDS DB Instance (%s) create: %w", d.Id(), err)
+	if _, err := waitDBInstanceCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for RDS DB Instance (%s) create: %s", d.Id(), err)
 	}
 
 	if requiresModifyDbInstance {
 		modifyDbInstanceInput.DBInstanceIdentifier = aws.String(d.Id())
 
-		_, err := conn.ModifyDBInstance(modifyDbInstanceInput)
+		_, err := conn.ModifyDBInstanceWithContext(ctx, modifyDbInstanceInput)
 
 		if err != nil {
-			return fmt.Errorf("updating RDS DB Instance (%s): %w", d.Id(), err)
+			return diag.Errorf("updating RDS DB Instance (%s): %s", d.Id(), err)
 		}
 
-		if _, err := waitDBInstanceUpdated(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
-			return fmt.Errorf("waiting for RDS DB Instance (%s) update: %w", d.Id(), err)
+		if _, err := waitDBInstanceUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("waiting for RDS DB Instance (%s) update: %s", d.Id(), err)
 		}
 	}
 
 	if requiresRebootDbInstance {
-		_, err := conn.RebootDBInstance(&rds.RebootDBInstanceInput{
+		_, err := conn.RebootDBInstanceWithContext(ctx, &rds.RebootDBInstanceInput{
 			DBInstanceIdentifier: aws.String(d.Id()),
 		})
 
 		if err != nil {
-			return fmt.Errorf("rebooting RDS DB Instance (%s): %w", d.Id(), err)
+			return diag.Errorf("rebooting RDS DB Instance (%s): %s", d.Id(), err)
 		}
 
-		if _, err := waitDBInstanceUpdated(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
-			return fmt.Errorf("waiting for RDS DB Instance (%s) update: %w", d.Id(), err)
+		if _, err := waitDBInstanceUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("waiting for RDS DB Instance (%s) update: %s", d.Id(), err)
 		}
 	}
 
-	return resourceInstanceRead(d, meta)
+	return resourceInstanceRead(ctx, d, meta)
 }
 
-func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).RDSConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
-	v, err := FindDBInstanceByID(conn, d.Id())
+	v, err := FindDBInstanceByID(ctx, conn, d.Id())
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] RDS DB Instance (%s) not found, removing from state", d.Id())
@@ -1474,7 +1548,7 @@ func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if err != nil {
-		return fmt.Errorf("reading RDS DB Instance (%s): %w", d.Id(), err)
+		return diag.Errorf("reading RDS DB Instance (%s): %s", d.Id(), err)
 	}
 
 	d.Set("allocated_storage", v.AllocatedStorage)
@@ -1529,6 +1603,7 @@ func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
 	if len(v.DBParameterGroups) > 0 && v.DBParameterGroups[0] != nil {
 		d.Set("parameter_group_name", v.DBParameterGroups[0].DBParameterGroupName)
 	}
+	d.Set("pending_reboot", dbInstancePendingReboot(v))
 	d.Set("performance_insights_enabled", v.PerformanceInsightsEnabled)
 	d.Set("performance_insights_kms_key_id", v.PerformanceInsightsKMSKeyId)
 	d.Set("performance_insights_retention_period", v.PerformanceInsightsRetentionPeriod)
@@ -1548,6 +1623,19 @@ func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("storage_type", v.StorageType)
 	d.Set("timezone", v.Timezone)
 	d.Set("username", v.MasterUsername)
+
+	if v.MasterUserSecret != nil {
+		d.Set("manage_master_user_password", true)
+		d.Set("master_user_secret_kms_key_id", v.MasterUserSecret.KmsKeyId)
+
+		if err := d.Set("master_user_secret", flattenMasterUserSecret(v.MasterUserSecret)); err != nil {
+			return diag.Errorf("setting master_user_secret: %s", err)
+		}
+	} else {
+		d.Set("manage_master_user_password", nil)
+		d.Set("master_user_secret_kms_key_id", nil)
+		d.Set("master_user_secret", nil)
+	}
 	var vpcSecurityGroupIDs []string
 	for _, v := range v.VpcSecurityGroups {
 		vpcSecurityGroupIDs = append(vpcSecurityGroupIDs, aws.StringValue(v.VpcSecurityGroupId))
@@ -1565,38 +1653,69 @@ func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
 
 	dbSetResourceDataEngineVersionFromInstance(d, v)
 
-	tags, err := ListTags(conn, arn)
+	tags, err := ListTagsWithContext(ctx, conn, arn)
 
 	if err != nil {
-		return fmt.Errorf("listing tags for RDS DB Instance (%s): %w", arn, err)
+		return diag.Errorf("listing tags for RDS DB Instance (%s): %s", arn, err)
 	}
 
 	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
 	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
-		return fmt.Errorf("setting tags: %w", err)
+		return diag.Errorf("setting tags: %s", err)
 	}
 
 	if err := d.Set("tags_all", tags.Map()); err != nil {
-		return fmt.Errorf("setting tags_all: %w", err)
+		return diag.Errorf("setting tags_all: %s", err)
 	}
 
 	return nil
 }
 
-func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).RDSConn
 
+	// Engine version and DB parameter group changes can be made using a Blue/Green Deployment,
+	// switching over to an upgraded copy of the instance instead of upgrading it in place.
+	blueGreenUpdate := d.Get("blue_green_update.0.enabled").(bool) && d.HasChanges("engine_version", "parameter_group_name")
+
+	if blueGreenUpdate {
+		var engineVersion, parameterGroupName string
+
+		if d.HasChange("engine_version") {
+			engineVersion = d.Get("engine_version").(string)
+		}
+
+		if d.HasChange("parameter_group_name") {
+			parameterGroupName = d.Get("parameter_group_name").(string)
+		}
+
+		// Deletion protection of the old instance is that of the instance before any update.
+		o, _ := d.GetChange("deletion_protection")
+
+		if err := updateInstanceWithBlueGreenDeployment(ctx, conn, d.Id(), d.Get("arn").(string), engineVersion, parameterGroupName, o.(bool), d.Get("skip_final_snapshot").(bool), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("updating RDS DB Instance (%s) using Blue/Green Deployment: %s", d.Id(), err)
+		}
+	}
+
 	// Having allowing_major_version_upgrade by itself should not trigger ModifyDBInstance
 	// as it results in "InvalidParameterCombination: No modifications were requested".
-	if d.HasChangesExcept(
+	exceptions := []string{
 		"allow_major_version_upgrade",
+		"blue_green_update",
 		"delete_automated_backups",
 		"final_snapshot_identifier",
 		"replicate_source_db",
 		"skip_final_snapshot",
-		"tags", "tags_all") {
+		"tags", "tags_all",
+	}
+
+	if blueGreenUpdate {
+		exceptions = append(exceptions, "engine_version", "parameter_group_name")
+	}
+
+	if d.HasChangesExcept(exceptions...) {
 		input := &rds.ModifyDBInstanceInput{
 			ApplyImmediately:     aws.Bool(d.Get("apply_immediately").(bool)),
 			DBInstanceIdentifier: aws.String(d.Id()),
@@ -1666,7 +1785,7 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 			}
 		}
 
-		if d.HasChange("engine_version") {
+		if d.HasChange("engine_version") && !blueGreenUpdate {
 			input.EngineVersion = aws.String(d.Get("engine_version").(string))
 			input.AllowMajorVersionUpgrade = aws.Bool(d.Get("allow_major_version_upgrade").(bool))
 		}
@@ -1720,11 +1839,21 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 			input.OptionGroupName = aws.String(d.Get("option_group_name").(string))
 		}
 
-		if d.HasChange("parameter_group_name") {
+		if d.HasChange("parameter_group_name") && !blueGreenUpdate {
 			input.DBParameterGroupName = aws.String(d.Get("parameter_group_name").(string))
 		}
 
-		if d.HasChange("password") {
+		if d.HasChanges("manage_master_user_password", "master_user_secret_kms_key_id") {
+			manage := d.Get("manage_master_user_password").(bool)
+			input.ManageMasterUserPassword = aws.Bool(manage)
+
+			if v := d.Get("master_user_secret_kms_key_id").(string); manage && v != "" {
+				input.MasterUserSecretKmsKeyId = aws.String(v)
+			}
+		}
+
+		// Removing the password when moving to a managed master user password leaves the password unchanged.
+		if d.HasChange("password") && !d.Get("manage_master_user_password").(bool) {
 			input.MasterUserPassword = aws.String(d.Get("password").(string))
 		}
 
@@ -1772,9 +1901,9 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 			}
 		}
 
-		_, err := tfresource.RetryWhen(d.Timeout(schema.TimeoutUpdate),
+		_, err := tfresource.RetryWhenContext(ctx, d.Timeout(schema.TimeoutUpdate),
 			func() (interface{}, error) {
-				return conn.ModifyDBInstance(input)
+				return conn.ModifyDBInstanceWithContext(ctx, input)
 			},
 			func(err error) (bool, error) {
 				// Retry for IAM eventual consistency.
@@ -1792,11 +1921,11 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		)
 
 		if err != nil {
-			return fmt.Errorf("updating RDS DB Instance (%s): %w", d.Id(), err)
+			return diag.Errorf("updating RDS DB Instance (%s): %s", d.Id(), err)
 		}
 
-		if _, err := waitDBInstanceUpdated(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
-			return fmt.Errorf("waiting for RDS DB Instance (%s) update: %w", d.Id(), err)
+		if _, err := waitDBInstanceUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("waiting for RDS DB Instance (%s) update: %s", d.Id(), err)
 		}
 	}
 
@@ -1812,30 +1941,30 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 				input.PreferredBackupWindow = aws.String(attr.(string))
 			}
 
-			_, err := conn.PromoteReadReplica(input)
+			_, err := conn.PromoteReadReplicaWithContext(ctx, input)
 
 			if err != nil {
-				return fmt.Errorf("promoting RDS DB Instance (%s): %w", d.Id(), err)
+				return diag.Errorf("promoting RDS DB Instance (%s): %s", d.Id(), err)
 			}
 
 			d.Set("replicate_source_db", "")
 		} else {
-			return fmt.Errorf("cannot elect new source database for replication")
+			return diag.Errorf("cannot elect new source database for replication")
 		}
 	}
 
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 
-		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
-			return fmt.Errorf("updating RDS DB Instance (%s) tags: %w", d.Get("arn").(string), err)
+		if err := UpdateTagsWithContext(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating RDS DB Instance (%s) tags: %s", d.Get("arn").(string), err)
 		}
 	}
 
-	return resourceInstanceRead(d, meta)
+	return resourceInstanceRead(ctx, d, meta)
 }
 
-func resourceInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).RDSConn
 
 	input := &rds.DeleteDBInstanceInput{
@@ -1851,18 +1980,18 @@ func resourceInstanceDelete(d *schema.ResourceData, meta interface{}) error {
 		if v, ok := d.GetOk("final_snapshot_identifier"); ok {
 			input.FinalDBSnapshotIdentifier = aws.String(v.(string))
 		} else {
-			return fmt.Errorf("final_snapshot_identifier is required when skip_final_snapshot is false")
+			return diag.Errorf("final_snapshot_identifier is required when skip_final_snapshot is false")
 		}
 	}
 
 	log.Printf("[DEBUG] Deleting RDS DB Instance: %s", d.Id())
-	_, err := conn.DeleteDBInstance(input)
+	_, err := conn.DeleteDBInstanceWithContext(ctx, input)
 
 	if tfawserr.ErrMessageContains(err, "InvalidParameterCombination", "disable deletion pro") {
 		if v, ok := d.GetOk("deletion_protection"); (!ok || !v.(bool)) && d.Get("apply_immediately").(bool) {
-			_, ierr := tfresource.RetryWhen(d.Timeout(schema.TimeoutUpdate),
+			_, ierr := tfresource.RetryWhenContext(ctx, d.Timeout(schema.TimeoutUpdate),
 				func() (interface{}, error) {
-					return conn.ModifyDBInstance(&rds.ModifyDBInstanceInput{
+					return conn.ModifyDBInstanceWithContext(ctx, &rds.ModifyDBInstanceInput{
 						ApplyImmediately:     aws.Bool(true),
 						DBInstanceIdentifier: aws.String(d.Id()),
 						DeletionProtection:   aws.Bool(false),
@@ -1884,14 +2013,14 @@ func resourceInstanceDelete(d *schema.ResourceData, meta interface{}) error {
 			)
 
 			if ierr != nil {
-				return fmt.Errorf("updating RDS DB Instance (%s): %w", d.Id(), err)
+				return diag.Errorf("updating RDS DB Instance (%s): %s", d.Id(), err)
 			}
 
-			if _, ierr := waitDBInstanceUpdated(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); ierr != nil {
-				return fmt.Errorf("waiting for RDS DB Instance (%s) update: %w", d.Id(), ierr)
+			if _, ierr := waitDBInstanceUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); ierr != nil {
+				return diag.Errorf("waiting for RDS DB Instance (%s) update: %s", d.Id(), ierr)
 			}
 
-			_, err = conn.DeleteDBInstance(input)
+			_, err = conn.DeleteDBInstanceWithContext(ctx, input)
 		}
 	}
 
@@ -1900,11 +2029,11 @@ func resourceInstanceDelete(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if err != nil && !tfawserr.ErrMessageContains(err, rds.ErrCodeInvalidDBInstanceStateFault, "is already being deleted") {
-		return fmt.Errorf("deleting RDS DB Instance (%s): %w", d.Id(), err)
+		return diag.Errorf("deleting RDS DB Instance (%s): %s", d.Id(), err)
 	}
 
-	if _, err := waitDBInstanceDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
-		return fmt.Errorf("waiting for RDS DB Instance (%s) delete: %w", d.Id(), err)
+	if _, err := waitDBInstanceDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for RDS DB Instance (%s) delete: %s", d.Id(), err)
 	}
 
 	return nil
@@ -1,6 +1,7 @@
 package rds
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -173,7 +174,7 @@ func InstanceRoleAssociationDecodeID(id string) (string, string, error) {
 }
 
 func DescribeDBInstanceRole(conn *rds.RDS, dbInstanceIdentifier, roleArn string) (*rds.DBInstanceRole, error) {
-	dbInstance, err := FindDBInstanceByID(conn, dbInstanceIdentifier)
+	dbInstance, err := FindDBInstanceByID(context.Background(), conn, dbInstanceIdentifier)
 	if err != nil {
 		return nil, err
 	}
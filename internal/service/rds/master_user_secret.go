@@ -0,0 +1,46 @@
+package rds
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// masterUserSecretSchema returns the schema for the Secrets Manager secret in which RDS manages
+// a DB instance's or cluster's master user password.
+func masterUserSecretSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"kms_key_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"secret_arn": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"secret_status": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func flattenMasterUserSecret(apiObject *rds.MasterUserSecret) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"kms_key_id":    aws.StringValue(apiObject.KmsKeyId),
+			"secret_arn":    aws.StringValue(apiObject.SecretArn),
+			"secret_status": aws.StringValue(apiObject.SecretStatus),
+		},
+	}
+}
@@ -0,0 +1,44 @@
+package rds
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+)
+
+func TestFlattenMasterUserSecret(t *testing.T) {
+	testCases := []struct {
+		TestName string
+		Input    *rds.MasterUserSecret
+		Expected []interface{}
+	}{
+		{
+			TestName: "nil",
+		},
+		{
+			TestName: "secret",
+			Input: &rds.MasterUserSecret{
+				KmsKeyId:     aws.String("arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"),
+				SecretArn:    aws.String("arn:aws:secretsmanager:us-west-2:123456789012:secret:rds!cluster-1234-abcdef"),
+				SecretStatus: aws.String("active"),
+			},
+			Expected: []interface{}{
+				map[string]interface{}{
+					"kms_key_id":    "arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+					"secret_arn":    "arn:aws:secretsmanager:us-west-2:123456789012:secret:rds!cluster-1234-abcdef",
+					"secret_status": "active",
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			if got := flattenMasterUserSecret(testCase.Input); !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %v, expected %v", got, testCase.Expected)
+			}
+		})
+	}
+}
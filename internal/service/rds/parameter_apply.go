@@ -0,0 +1,241 @@
+package rds
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// changedParameters returns the parameters that are added or modified in n, and the parameters removed from o (which are reset).
+func changedParameters(o, n *schema.Set) []*rds.Parameter {
+	parameters := ExpandParameters(n.Difference(o).List())
+	names := make(map[string]struct{})
+
+	for _, p := range ExpandParameters(n.List()) {
+		names[strings.ToLower(aws.StringValue(p.ParameterName))] = struct{}{}
+	}
+
+	for _, p := range ExpandParameters(o.List()) {
+		if _, ok := names[strings.ToLower(aws.StringValue(p.ParameterName))]; !ok {
+			parameters = append(parameters, p)
+		}
+	}
+
+	return parameters
+}
+
+// staticParameterNames returns the sorted names of those parameters whose engine default is static, i.e. that take effect only after a reboot.
+func staticParameterNames(defaults, parameters []*rds.Parameter) []string {
+	static := make(map[string]struct{})
+
+	for _, p := range defaults {
+		if aws.StringValue(p.ApplyType) == parameterApplyTypeStatic {
+			static[strings.ToLower(aws.StringValue(p.ParameterName))] = struct{}{}
+		}
+	}
+
+	var names []string
+	seen := make(map[string]struct{})
+
+	for _, p := range parameters {
+		name := strings.ToLower(aws.StringValue(p.ParameterName))
+
+		if _, ok := static[name]; !ok {
+			continue
+		}
+
+		if _, ok := seen[name]; ok {
+			continue
+		}
+
+		seen[name] = struct{}{}
+		names = append(names, aws.StringValue(p.ParameterName))
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// findStaticParameterChanges returns the names of the static parameters changed between o and n,
+// looking up the parameters' apply types in the engine defaults for the parameter group family.
+func findStaticParameterChanges(conn *rds.RDS, findDefaults func(*rds.RDS, string) ([]*rds.Parameter, error), family string, o, n *schema.Set) ([]string, error) {
+	parameters := changedParameters(o, n)
+
+	if len(parameters) == 0 {
+		return nil, nil
+	}
+
+	defaults, err := findDefaults(conn, family)
+
+	if err != nil {
+		return nil, fmt.Errorf("reading RDS engine default parameters (%s): %w", family, err)
+	}
+
+	return staticParameterNames(defaults, parameters), nil
+}
+
+// customizeDiffStaticParameterChanges reports in the plan, via the computed `pending_reboot_parameters` attribute,
+// the static parameters of an existing parameter group that a plan changes and that won't take effect until
+// the DB instances using the group are rebooted. The planned attribute value is the only plan-time signal.
+func customizeDiffStaticParameterChanges(findDefaults func(*rds.RDS, string) ([]*rds.Parameter, error)) schema.CustomizeDiffFunc {
+	return func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		if diff.Id() == "" || !diff.HasChange("parameter") {
+			return nil
+		}
+
+		conn := meta.(*conns.AWSClient).RDSConn
+		o, n := diff.GetChange("parameter")
+
+		names, err := findStaticParameterChanges(conn, findDefaults, diff.Get("family").(string), o.(*schema.Set), n.(*schema.Set))
+
+		if err != nil {
+			// Parameter metadata is informational only, so don't fail the plan.
+			log.Printf("[WARN] %s", err)
+			return diff.SetNewComputed("pending_reboot_parameters")
+		}
+
+		// Instances are rebooted during apply, so no parameters remain pending.
+		if diff.Get("reboot_attached_instances").(bool) {
+			names = nil
+		}
+
+		return diff.SetNew("pending_reboot_parameters", names)
+	}
+}
+
+// parameterGroupPendingReboot returns whether any DB instance using the DB parameter group hasn't yet applied it.
+func parameterGroupPendingReboot(conn *rds.RDS, name string) (bool, error) {
+	instances, err := findDBInstancesByParameterGroupName(conn, name)
+
+	if err != nil {
+		return false, err
+	}
+
+	for _, v := range instances {
+		if status := dbParameterGroupStatus(v, name); status != nil && aws.StringValue(status.ParameterApplyStatus) != parameterApplyStatusInSync {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// clusterParameterGroupPendingReboot returns whether any member of the RDS Clusters using the DB cluster parameter group
+// hasn't yet applied it.
+func clusterParameterGroupPendingReboot(conn *rds.RDS, name string) (bool, error) {
+	clusters, err := findDBClustersByClusterParameterGroupName(conn, name)
+
+	if err != nil {
+		return false, err
+	}
+
+	for _, v := range clusters {
+		for _, member := range v.DBClusterMembers {
+			if member != nil && aws.StringValue(member.DBClusterParameterGroupStatus) != parameterApplyStatusInSync {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+// dbParameterGroupStatus returns the status of the named DB parameter group on the DB instance, or nil if the instance doesn't use the group.
+func dbParameterGroupStatus(v *rds.DBInstance, name string) *rds.DBParameterGroupStatus {
+	for _, status := range v.DBParameterGroups {
+		if status != nil && aws.StringValue(status.DBParameterGroupName) == name {
+			return status
+		}
+	}
+
+	return nil
+}
+
+// dbInstancePendingReboot returns whether the DB instance must be rebooted to apply DB parameter group changes.
+func dbInstancePendingReboot(v *rds.DBInstance) bool {
+	for _, status := range v.DBParameterGroups {
+		if status != nil && aws.StringValue(status.ParameterApplyStatus) == parameterApplyStatusPendingReboot {
+			return true
+		}
+	}
+
+	return false
+}
+
+// rebootParameterGroupDBInstances reboots, one at a time, the available DB instances using the DB parameter group
+// that are pending a reboot to apply it.
+func rebootParameterGroupDBInstances(ctx context.Context, conn *rds.RDS, name string, timeout time.Duration) error {
+	instances, err := findDBInstancesByParameterGroupName(conn, name)
+
+	if err != nil {
+		return fmt.Errorf("listing RDS DB Instances: %w", err)
+	}
+
+	for _, v := range instances {
+		id := aws.StringValue(v.DBInstanceIdentifier)
+
+		if status := aws.StringValue(v.DBInstanceStatus); status != InstanceStatusAvailable {
+			log.Printf("[WARN] Not rebooting RDS DB Instance (%s) with status %q", id, status)
+			continue
+		}
+
+		status, err := waitDBInstanceParameterGroupApplied(ctx, conn, id, name, timeout)
+
+		if err != nil {
+			return fmt.Errorf("waiting for RDS DB Instance (%s) parameter apply: %w", id, err)
+		}
+
+		if status == nil || aws.StringValue(status.ParameterApplyStatus) != parameterApplyStatusPendingReboot {
+			continue
+		}
+
+		if err := rebootDBInstance(ctx, conn, id, timeout); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func rebootDBInstance(ctx context.Context, conn *rds.RDS, id string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Rebooting RDS DB Instance: %s", id)
+	_, err := conn.RebootDBInstanceWithContext(ctx, &rds.RebootDBInstanceInput{
+		DBInstanceIdentifier: aws.String(id),
+	})
+
+	if err != nil {
+		return fmt.Errorf("rebooting RDS DB Instance (%s): %w", id, err)
+	}
+
+	if _, err := waitDBInstanceUpdated(ctx, conn, id, timeout); err != nil {
+		return fmt.Errorf("waiting for RDS DB Instance (%s) reboot: %w", id, err)
+	}
+
+	return nil
+}
+
+// clusterMembersInRebootOrder returns the cluster members with the readers before the writer.
+func clusterMembersInRebootOrder(members []*rds.DBClusterMember) []*rds.DBClusterMember {
+	var ordered []*rds.DBClusterMember
+
+	for _, v := range members {
+		if v != nil {
+			ordered = append(ordered, v)
+		}
+	}
+
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return !aws.BoolValue(ordered[i].IsClusterWriter) && aws.BoolValue(ordered[j].IsClusterWriter)
+	})
+
+	return ordered
+}
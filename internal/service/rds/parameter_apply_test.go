@@ -0,0 +1,163 @@
+package rds
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestStaticParameterChanges(t *testing.T) {
+	defaults := []*rds.Parameter{
+		{
+			ApplyType:     aws.String("static"),
+			ParameterName: aws.String("innodb_buffer_pool_instances"),
+		},
+		{
+			ApplyType:     aws.String("static"),
+			ParameterName: aws.String("performance_schema"),
+		},
+		{
+			ApplyType:     aws.String("dynamic"),
+			ParameterName: aws.String("character_set_server"),
+		},
+		{
+			ApplyType:     aws.String("dynamic"),
+			ParameterName: aws.String("max_connections"),
+		},
+	}
+
+	testCases := []struct {
+		TestName string
+		Old      []interface{}
+		New      []interface{}
+		Expected []string
+	}{
+		{
+			TestName: "no changes",
+			Old: []interface{}{
+				map[string]interface{}{"name": "performance_schema", "value": "1", "apply_method": "pending-reboot"},
+			},
+			New: []interface{}{
+				map[string]interface{}{"name": "performance_schema", "value": "1", "apply_method": "pending-reboot"},
+			},
+		},
+		{
+			TestName: "dynamic changes",
+			Old: []interface{}{
+				map[string]interface{}{"name": "character_set_server", "value": "utf8", "apply_method": "immediate"},
+				map[string]interface{}{"name": "performance_schema", "value": "1", "apply_method": "pending-reboot"},
+			},
+			New: []interface{}{
+				map[string]interface{}{"name": "character_set_server", "value": "utf8mb4", "apply_method": "immediate"},
+				map[string]interface{}{"name": "max_connections", "value": "100", "apply_method": "immediate"},
+				map[string]interface{}{"name": "performance_schema", "value": "1", "apply_method": "pending-reboot"},
+			},
+		},
+		{
+			TestName: "static modified",
+			Old: []interface{}{
+				map[string]interface{}{"name": "performance_schema", "value": "0", "apply_method": "pending-reboot"},
+			},
+			New: []interface{}{
+				map[string]interface{}{"name": "PERFORMANCE_SCHEMA", "value": "1", "apply_method": "pending-reboot"},
+				map[string]interface{}{"name": "max_connections", "value": "100", "apply_method": "immediate"},
+			},
+			Expected: []string{"performance_schema"},
+		},
+		{
+			TestName: "static added and reset",
+			Old: []interface{}{
+				map[string]interface{}{"name": "performance_schema", "value": "1", "apply_method": "pending-reboot"},
+			},
+			New: []interface{}{
+				map[string]interface{}{"name": "innodb_buffer_pool_instances", "value": "4", "apply_method": "pending-reboot"},
+			},
+			Expected: []string{"innodb_buffer_pool_instances", "performance_schema"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			o := schema.NewSet(resourceParameterHash, testCase.Old)
+			n := schema.NewSet(resourceParameterHash, testCase.New)
+
+			got := staticParameterNames(defaults, changedParameters(o, n))
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %v, expected %v", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestDBInstancePendingReboot(t *testing.T) {
+	testCases := []struct {
+		TestName string
+		Statuses []string
+		Expected bool
+	}{
+		{
+			TestName: "none",
+		},
+		{
+			TestName: "in-sync",
+			Statuses: []string{"in-sync"},
+		},
+		{
+			TestName: "applying",
+			Statuses: []string{"applying"},
+		},
+		{
+			TestName: "pending-reboot",
+			Statuses: []string{"in-sync", "pending-reboot"},
+			Expected: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			v := &rds.DBInstance{}
+
+			for _, status := range testCase.Statuses {
+				v.DBParameterGroups = append(v.DBParameterGroups, &rds.DBParameterGroupStatus{
+					DBParameterGroupName: aws.String("test"),
+					ParameterApplyStatus: aws.String(status),
+				})
+			}
+
+			if got := dbInstancePendingReboot(v); got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestClusterMembersInRebootOrder(t *testing.T) {
+	members := []*rds.DBClusterMember{
+		{
+			DBInstanceIdentifier: aws.String("writer"),
+			IsClusterWriter:      aws.Bool(true),
+		},
+		nil,
+		{
+			DBInstanceIdentifier: aws.String("reader-1"),
+			IsClusterWriter:      aws.Bool(false),
+		},
+		{
+			DBInstanceIdentifier: aws.String("reader-2"),
+			IsClusterWriter:      aws.Bool(false),
+		},
+	}
+
+	var got []string
+	for _, v := range clusterMembersInRebootOrder(members) {
+		got = append(got, aws.StringValue(v.DBInstanceIdentifier))
+	}
+
+	if expected := []string{"reader-1", "reader-2", "writer"}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %v, expected %v", got, expected)
+	}
+}
@@ -2,6 +2,7 @@ package rds
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -10,6 +11,8 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -21,12 +24,20 @@ import (
 
 func ResourceParameterGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceParameterGroupCreate,
-		Read:   resourceParameterGroupRead,
-		Update: resourceParameterGroupUpdate,
-		Delete: resourceParameterGroupDelete,
+		CreateWithoutTimeout: resourceParameterGroupCreate,
+		ReadWithoutTimeout:   resourceParameterGroupRead,
+		UpdateWithoutTimeout: resourceParameterGroupUpdate,
+		DeleteWithoutTimeout: resourceParameterGroupDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				// Set non API attributes to their Default settings in the schema
+				d.Set("reboot_attached_instances", false)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(60 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -83,16 +94,29 @@ func ResourceParameterGroup() *schema.Resource {
 				},
 				Set: resourceParameterHash,
 			},
+			"pending_reboot_parameters": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"reboot_attached_instances": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			customizeDiffStaticParameterChanges(findEngineDefaultParameters),
+			verify.SetTagsDiff,
+		),
 	}
 }
 
-func resourceParameterGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceParameterGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).RDSConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
@@ -115,19 +139,19 @@ func resourceParameterGroupCreate(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	log.Printf("[DEBUG] Create DB Parameter Group: %#v", createOpts)
-	resp, err := conn.CreateDBParameterGroup(&createOpts)
+	resp, err := conn.CreateDBParameterGroupWithContext(ctx, &createOpts)
 	if err != nil {
-		return fmt.Errorf("Error creating DB Parameter Group: %s", err)
+		return diag.Errorf("Error creating DB Parameter Group: %s", err)
 	}
 
 	d.SetId(aws.StringValue(resp.DBParameterGroup.DBParameterGroupName))
 	d.Set("arn", resp.DBParameterGroup.DBParameterGroupArn)
 	log.Printf("[INFO] DB Parameter Group ID: %s", d.Id())
 
-	return resourceParameterGroupUpdate(d, meta)
+	return resourceParameterGroupUpdate(ctx, d, meta)
 }
 
-func resourceParameterGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceParameterGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).RDSConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
@@ -136,19 +160,19 @@ func resourceParameterGroupRead(d *schema.ResourceData, meta interface{}) error
 		DBParameterGroupName: aws.String(d.Id()),
 	}
 
-	describeResp, err := conn.DescribeDBParameterGroups(&describeOpts)
+	describeResp, err := conn.DescribeDBParameterGroupsWithContext(ctx, &describeOpts)
 	if err != nil {
 		if tfawserr.ErrCodeEquals(err, rds.ErrCodeDBParameterGroupNotFoundFault) {
 			log.Printf("[WARN] DB Parameter Group (%s) not found, removing from state", d.Id())
 			d.SetId("")
 			return nil
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	if len(describeResp.DBParameterGroups) != 1 ||
 		aws.StringValue(describeResp.DBParameterGroups[0].DBParameterGroupName) != d.Id() {
-		return fmt.Errorf("Unable to find Parameter Group: %#v", describeResp.DBParameterGroups)
+		return diag.Errorf("Unable to find Parameter Group: %#v", describeResp.DBParameterGroups)
 	}
 
 	d.Set("name", describeResp.DBParameterGroups[0].DBParameterGroupName)
@@ -173,13 +197,13 @@ func resourceParameterGroupRead(d *schema.ResourceData, meta interface{}) error
 	}
 
 	var parameters []*rds.Parameter
-	err = conn.DescribeDBParametersPages(&describeParametersOpts,
+	err = conn.DescribeDBParametersPagesWithContext(ctx, &describeParametersOpts,
 		func(describeParametersResp *rds.DescribeDBParametersOutput, lastPage bool) bool {
 			parameters = append(parameters, describeParametersResp.Parameters...)
 			return !lastPage
 		})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var userParams []*rds.Parameter
@@ -224,27 +248,39 @@ func resourceParameterGroupRead(d *schema.ResourceData, meta interface{}) error
 
 	err = d.Set("parameter", FlattenParameters(userParams))
 	if err != nil {
-		return fmt.Errorf("error setting 'parameter' in state: %#v", err)
+		return diag.Errorf("error setting 'parameter' in state: %#v", err)
+	}
+
+	// Static parameter changes made by Terraform remain pending until the DB instances using the group are rebooted.
+	// The apply status doesn't identify the pending parameters, so changes made outside of Terraform are never reported.
+	if d.Get("pending_reboot_parameters").(*schema.Set).Len() > 0 {
+		pending, err := parameterGroupPendingReboot(conn, d.Id())
+
+		if err != nil {
+			log.Printf("[WARN] reading DB Parameter Group (%s) attached instances: %s", d.Id(), err)
+		} else if !pending {
+			d.Set("pending_reboot_parameters", nil)
+		}
 	}
 
 	arn := aws.StringValue(describeResp.DBParameterGroups[0].DBParameterGroupArn)
 	d.Set("arn", arn)
 
-	tags, err := ListTags(conn, d.Get("arn").(string))
+	tags, err := ListTagsWithContext(ctx, conn, d.Get("arn").(string))
 
 	if err != nil {
-		return fmt.Errorf("error listing tags for RDS DB Parameter Group (%s): %s", d.Get("arn").(string), err)
+		return diag.Errorf("error listing tags for RDS DB Parameter Group (%s): %s", d.Get("arn").(string), err)
 	}
 
 	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
 	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
-		return fmt.Errorf("error setting tags: %w", err)
+		return diag.Errorf("error setting tags: %s", err)
 	}
 
 	if err := d.Set("tags_all", tags.Map()); err != nil {
-		return fmt.Errorf("error setting tags_all: %w", err)
+		return diag.Errorf("error setting tags_all: %s", err)
 	}
 
 	return nil
@@ -252,7 +288,7 @@ func resourceParameterGroupRead(d *schema.ResourceData, meta interface{}) error
 
 const maxParamModifyChunk = 20
 
-func resourceParameterGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceParameterGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).RDSConn
 
 	if d.HasChange("parameter") {
@@ -284,9 +320,9 @@ func resourceParameterGroupUpdate(d *schema.ResourceData, meta interface{}) erro
 				}
 
 				log.Printf("[DEBUG] Modify DB Parameter Group: %s", modifyOpts)
-				_, err := conn.ModifyDBParameterGroup(&modifyOpts)
+				_, err := conn.ModifyDBParameterGroupWithContext(ctx, &modifyOpts)
 				if err != nil {
-					return fmt.Errorf("Error modifying DB Parameter Group: %s", err)
+					return diag.Errorf("Error modifying DB Parameter Group: %s", err)
 				}
 			}
 		}
@@ -327,9 +363,25 @@ func resourceParameterGroupUpdate(d *schema.ResourceData, meta interface{}) erro
 				}
 
 				log.Printf("[DEBUG] Reset DB Parameter Group: %s", resetOpts)
-				_, err := conn.ResetDBParameterGroup(&resetOpts)
+				_, err := conn.ResetDBParameterGroupWithContext(ctx, &resetOpts)
 				if err != nil {
-					return fmt.Errorf("Error resetting DB Parameter Group: %s", err)
+					return diag.Errorf("Error resetting DB Parameter Group: %s", err)
+				}
+			}
+		}
+
+		if d.Get("reboot_attached_instances").(bool) && !d.IsNewResource() {
+			names, err := findStaticParameterChanges(conn, findEngineDefaultParameters, d.Get("family").(string), os, ns)
+
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			if len(names) > 0 {
+				log.Printf("[INFO] Rebooting RDS DB Instances using DB Parameter Group (%s) to apply static parameters: %s", d.Id(), strings.Join(names, ", "))
+
+				if err := rebootParameterGroupDBInstances(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+					return diag.Errorf("rebooting RDS DB Instances using DB Parameter Group (%s): %s", d.Id(), err)
 				}
 			}
 		}
@@ -338,21 +390,21 @@ func resourceParameterGroupUpdate(d *schema.ResourceData, meta interface{}) erro
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 
-		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
-			return fmt.Errorf("error updating RDS DB Parameter Group (%s) tags: %s", d.Get("arn").(string), err)
+		if err := UpdateTagsWithContext(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating RDS DB Parameter Group (%s) tags: %s", d.Get("arn").(string), err)
 		}
 	}
 
-	return resourceParameterGroupRead(d, meta)
+	return resourceParameterGroupRead(ctx, d, meta)
 }
 
-func resourceParameterGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceParameterGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).RDSConn
 	deleteOpts := rds.DeleteDBParameterGroupInput{
 		DBParameterGroupName: aws.String(d.Id()),
 	}
-	err := resource.Retry(3*time.Minute, func() *resource.RetryError {
-		_, err := conn.DeleteDBParameterGroup(&deleteOpts)
+	err := resource.RetryContext(ctx, 3*time.Minute, func() *resource.RetryError {
+		_, err := conn.DeleteDBParameterGroupWithContext(ctx, &deleteOpts)
 		if err != nil {
 			if tfawserr.ErrCodeEquals(err, "DBParameterGroupNotFoundFault") || tfawserr.ErrCodeEquals(err, "InvalidDBParameterGroupState") {
 				return resource.RetryableError(err)
@@ -362,10 +414,10 @@ func resourceParameterGroupDelete(d *schema.ResourceData, meta interface{}) erro
 		return nil
 	})
 	if tfresource.TimedOut(err) {
-		_, err = conn.DeleteDBParameterGroup(&deleteOpts)
+		_, err = conn.DeleteDBParameterGroupWithContext(ctx, &deleteOpts)
 	}
 	if err != nil {
-		return fmt.Errorf("Error deleting DB parameter group: %s", err)
+		return diag.Errorf("Error deleting DB parameter group: %s", err)
 	}
 	return nil
 }
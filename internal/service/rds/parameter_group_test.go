@@ -752,6 +752,88 @@ func TestAccRDSParameterGroup_caseParameters(t *testing.T) {
 	})
 }
 
+func TestAccRDSParameterGroup_pendingRebootParameters(t *testing.T) {
+	var v rds.DBParameterGroup
+	resourceName := "aws_db_parameter_group.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParameterGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParameterGroupConfig_pendingRebootParameters(rName, "0"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterGroupExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "pending_reboot_parameters.#", "0"),
+				),
+			},
+			{
+				Config: testAccParameterGroupConfig_pendingRebootParameters(rName, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterGroupExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "pending_reboot_parameters.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "pending_reboot_parameters.*", "performance_schema"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRDSParameterGroup_rebootAttachedInstances(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v rds.DBParameterGroup
+	var dbInstance1, dbInstance2 rds.DBInstance
+	resourceName := "aws_db_parameter_group.test"
+	instanceResourceName := "aws_db_instance.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParameterGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParameterGroupConfig_rebootAttachedInstances(rName, "0"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterGroupExists(resourceName, &v),
+					testAccCheckInstanceExists(instanceResourceName, &dbInstance1),
+					resource.TestCheckResourceAttr(resourceName, "reboot_attached_instances", "true"),
+					resource.TestCheckResourceAttr(instanceResourceName, "pending_reboot", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"reboot_attached_instances",
+				},
+			},
+			{
+				Config: testAccParameterGroupConfig_rebootAttachedInstances(rName, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterGroupExists(resourceName, &v),
+					testAccCheckInstanceExists(instanceResourceName, &dbInstance2),
+					testAccCheckInstanceNotRecreated(&dbInstance1, &dbInstance2),
+					testAccCheckInstanceParameterApplyStatus(&dbInstance2, "in-sync"),
+					resource.TestCheckResourceAttr(resourceName, "pending_reboot_parameters.#", "0"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameter.*", map[string]string{
+						"name":  "performance_schema",
+						"value": "1",
+					}),
+				),
+			},
+		},
+	})
+}
+
 func TestDBParameterModifyChunk(t *testing.T) {
 	cases := []struct {
 		Name              string
@@ -1030,6 +1112,18 @@ func testAccCheckParameterGroupDestroy(s *terraform.State) error {
 	return nil
 }
 
+func testAccCheckInstanceParameterApplyStatus(v *rds.DBInstance, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, status := range v.DBParameterGroups {
+			if got := aws.StringValue(status.ParameterApplyStatus); got != expected {
+				return fmt.Errorf("RDS DB Instance (%s) DB Parameter Group (%s) apply status is %q, expected %q", aws.StringValue(v.DBInstanceIdentifier), aws.StringValue(status.DBParameterGroupName), got, expected)
+			}
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckParameterGroupAttributes(v *rds.DBParameterGroup, name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if *v.DBParameterGroupName != name {
@@ -1139,6 +1233,49 @@ resource "aws_db_parameter_group" "test" {
 `, rName)
 }
 
+func testAccParameterGroupConfig_pendingRebootParameters(rName, value string) string {
+	return fmt.Sprintf(`
+resource "aws_db_parameter_group" "test" {
+  name   = %[1]q
+  family = "mysql8.0"
+
+  parameter {
+    name         = "performance_schema"
+    value        = %[2]q
+    apply_method = "pending-reboot"
+  }
+}
+`, rName, value)
+}
+
+func testAccParameterGroupConfig_rebootAttachedInstances(rName, value string) string {
+	return acctest.ConfigCompose(testAccInstanceConfig_orderableClassMySQL(), fmt.Sprintf(`
+resource "aws_db_parameter_group" "test" {
+  name                      = %[1]q
+  family                    = data.aws_rds_engine_version.default.parameter_group_family
+  reboot_attached_instances = true
+
+  parameter {
+    name         = "performance_schema"
+    value        = %[2]q
+    apply_method = "pending-reboot"
+  }
+}
+
+resource "aws_db_instance" "test" {
+  identifier           = %[1]q
+  allocated_storage    = 10
+  engine               = data.aws_rds_orderable_db_instance.test.engine
+  engine_version       = data.aws_rds_orderable_db_instance.test.engine_version
+  instance_class       = data.aws_rds_orderable_db_instance.test.instance_class
+  parameter_group_name = aws_db_parameter_group.test.name
+  password             = "avoid-plaintext-passwords"
+  username             = "tfacctest"
+  skip_final_snapshot  = true
+}
+`, rName, value))
+}
+
 func testAccParameterGroupConfig_caseWithMixedParameters(rName string) string {
 	return acctest.ConfigCompose(testAccInstanceConfig_orderableClassMySQL(), fmt.Sprintf(`
 resource "aws_db_parameter_group" "test" {
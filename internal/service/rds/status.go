@@ -51,9 +51,9 @@ func statusDBProxyEndpoint(conn *rds.RDS, id string) resource.StateRefreshFunc {
 	}
 }
 
-func statusDBCluster(conn *rds.RDS, id string) resource.StateRefreshFunc {
+func statusDBCluster(ctx context.Context, conn *rds.RDS, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		output, err := FindDBClusterByID(conn, id)
+		output, err := FindDBClusterByID(ctx, conn, id)
 
 		if tfresource.NotFound(err) {
 			return nil, "", nil
@@ -83,9 +83,9 @@ func statusDBClusterRole(conn *rds.RDS, dbClusterID, roleARN string) resource.St
 	}
 }
 
-func statusDBInstance(conn *rds.RDS, id string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		output, err := FindDBInstanceByID(conn, id)
+func statusDBInstance(conn *rds.RDS, id string) func(context.Context) (*rds.DBInstance, string, error) {
+	return func(ctx context.Context) (*rds.DBInstance, string, error) {
+		output, err := FindDBInstanceByID(ctx, conn, id)
 
 		if tfresource.NotFound(err) {
 			return nil, "", nil
@@ -99,6 +99,66 @@ func statusDBInstance(conn *rds.RDS, id string) resource.StateRefreshFunc {
 	}
 }
 
+func statusDBInstanceParameterGroup(conn *rds.RDS, id, name string) func(context.Context) (*rds.DBParameterGroupStatus, string, error) {
+	return func(ctx context.Context) (*rds.DBParameterGroupStatus, string, error) {
+		output, err := FindDBInstanceByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		status := dbParameterGroupStatus(output, name)
+
+		if status == nil {
+			return nil, "", nil
+		}
+
+		return status, aws.StringValue(status.ParameterApplyStatus), nil
+	}
+}
+
+func statusDBClusterMemberParameterGroup(conn *rds.RDS, dbClusterID, dbInstanceID string) func(context.Context) (*rds.DBClusterMember, string, error) {
+	return func(ctx context.Context) (*rds.DBClusterMember, string, error) {
+		output, err := FindDBClusterByID(ctx, conn, dbClusterID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		for _, v := range output.DBClusterMembers {
+			if v != nil && aws.StringValue(v.DBInstanceIdentifier) == dbInstanceID {
+				return v, aws.StringValue(v.DBClusterParameterGroupStatus), nil
+			}
+		}
+
+		return nil, "", nil
+	}
+}
+
+func statusBlueGreenDeployment(conn *rds.RDS, id string) func(context.Context) (*rds.BlueGreenDeployment, string, error) {
+	return func(ctx context.Context) (*rds.BlueGreenDeployment, string, error) {
+		output, err := findBlueGreenDeploymentByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
 func statusDBClusterActivityStream(conn *rds.RDS, dbClusterArn string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindDBClusterWithActivityStream(conn, dbClusterArn)
@@ -137,9 +197,9 @@ func statusDBInstanceAutomatedBackup(conn *rds.RDS, arn string) resource.StateRe
 
 // statusDBInstanceHasAutomatedBackup returns whether or not a database instance has a specified automated backup.
 // The connection must be valid for the database instance's Region.
-func statusDBInstanceHasAutomatedBackup(conn *rds.RDS, dbInstanceID, dbInstanceAutomatedBackupsARN string) resource.StateRefreshFunc {
+func statusDBInstanceHasAutomatedBackup(ctx context.Context, conn *rds.RDS, dbInstanceID, dbInstanceAutomatedBackupsARN string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		output, err := FindDBInstanceByID(conn, dbInstanceID)
+		output, err := FindDBInstanceByID(ctx, conn, dbInstanceID)
 
 		if tfresource.NotFound(err) {
 			return nil, "", nil
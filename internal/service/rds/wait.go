@@ -2,13 +2,17 @@ package rds
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -128,7 +132,7 @@ func waitDBClusterCreated(conn *rds.RDS, id string, timeout time.Duration) (*rds
 			ClusterStatusResettingMasterCredentials,
 		},
 		Target:     []string{ClusterStatusAvailable},
-		Refresh:    statusDBCluster(conn, id),
+		Refresh:    statusDBCluster(context.Background(), conn, id),
 		Timeout:    timeout,
 		MinTimeout: 10 * time.Second,
 		Delay:      30 * time.Second,
@@ -152,7 +156,7 @@ func waitDBClusterDeleted(conn *rds.RDS, id string, timeout time.Duration) (*rds
 			ClusterStatusModifying,
 		},
 		Target:     []string{},
-		Refresh:    statusDBCluster(conn, id),
+		Refresh:    statusDBCluster(context.Background(), conn, id),
 		Timeout:    timeout,
 		MinTimeout: 10 * time.Second,
 		Delay:      30 * time.Second,
@@ -178,7 +182,7 @@ func waitDBClusterUpdated(conn *rds.RDS, id string, timeout time.Duration) (*rds
 			ClusterStatusUpgrading,
 		},
 		Target:     []string{ClusterStatusAvailable},
-		Refresh:    statusDBCluster(conn, id),
+		Refresh:    statusDBCluster(context.Background(), conn, id),
 		Timeout:    timeout,
 		MinTimeout: 10 * time.Second,
 		Delay:      30 * time.Second,
@@ -227,8 +231,74 @@ func waitDBClusterRoleAssociationDeleted(conn *rds.RDS, dbClusterID, roleARN str
 	return nil, err
 }
 
-func waitDBInstanceCreated(conn *rds.RDS, id string, timeout time.Duration) (*rds.DBInstance, error) {
-	stateConf := &resource.StateChangeConf{
+func waitBlueGreenDeploymentAvailable(ctx context.Context, conn *rds.RDS, id string, timeout time.Duration) (*rds.BlueGreenDeployment, error) {
+	output, err := tfresource.Wait(ctx, statusBlueGreenDeployment(conn, id), tfresource.WaiterOpts{
+		Description:     fmt.Sprintf("RDS Blue/Green Deployment (%s) create", id),
+		Pending:         []string{BlueGreenDeploymentStatusProvisioning},
+		Target:          []string{BlueGreenDeploymentStatusAvailable},
+		Timeout:         timeout,
+		MinPollInterval: 10 * time.Second,
+		Delay:           1 * time.Minute,
+	})
+
+	setBlueGreenDeploymentLastError(output, err)
+
+	return output, err
+}
+
+func waitBlueGreenDeploymentSwitchoverCompleted(ctx context.Context, conn *rds.RDS, id string, timeout time.Duration) (*rds.BlueGreenDeployment, error) {
+	output, err := tfresource.Wait(ctx, statusBlueGreenDeployment(conn, id), tfresource.WaiterOpts{
+		Description:     fmt.Sprintf("RDS Blue/Green Deployment (%s) switchover", id),
+		Pending:         []string{BlueGreenDeploymentStatusAvailable, BlueGreenDeploymentStatusSwitchoverInProgress},
+		Target:          []string{BlueGreenDeploymentStatusSwitchoverCompleted},
+		Timeout:         timeout,
+		MinPollInterval: 5 * time.Second,
+		Delay:           10 * time.Second,
+	})
+
+	setBlueGreenDeploymentLastError(output, err)
+
+	return output, err
+}
+
+func waitBlueGreenDeploymentDeleted(ctx context.Context, conn *rds.RDS, id string, timeout time.Duration) (*rds.BlueGreenDeployment, error) {
+	return tfresource.Wait(ctx, statusBlueGreenDeployment(conn, id), tfresource.WaiterOpts{
+		Description:     fmt.Sprintf("RDS Blue/Green Deployment (%s) delete", id),
+		Pending:         []string{BlueGreenDeploymentStatusDeleting, BlueGreenDeploymentStatusSwitchoverCompleted},
+		Target:          []string{},
+		Timeout:         timeout,
+		MinPollInterval: 5 * time.Second,
+		Delay:           10 * time.Second,
+	})
+}
+
+// setBlueGreenDeploymentLastError records a Blue/Green Deployment's status details and unfinished tasks
+// (e.g. "CREATING_READ_REPLICA_OF_SOURCE: IN_PROGRESS") as the last error of a failed wait.
+func setBlueGreenDeploymentLastError(output *rds.BlueGreenDeployment, err error) {
+	if output == nil || err == nil {
+		return
+	}
+
+	var details []string
+
+	if v := aws.StringValue(output.StatusDetails); v != "" {
+		details = append(details, v)
+	}
+
+	for _, v := range output.Tasks {
+		if v != nil && aws.StringValue(v.Status) != blueGreenDeploymentTaskStatusCompleted {
+			details = append(details, fmt.Sprintf("%s: %s", aws.StringValue(v.Name), aws.StringValue(v.Status)))
+		}
+	}
+
+	if len(details) > 0 {
+		tfresource.SetLastError(err, errors.New(strings.Join(details, ", ")))
+	}
+}
+
+func waitDBInstanceCreated(ctx context.Context, conn *rds.RDS, id string, timeout time.Duration) (*rds.DBInstance, error) {
+	return tfresource.Wait(ctx, statusDBInstance(conn, id), tfresource.WaiterOpts{
+		Description: fmt.Sprintf("RDS DB Instance (%s) create", id),
 		// https://docs.aws.amazon.com/AmazonRDS/latest/UserGuide/accessing-monitoring.html#Overview.DBInstance.Status.
 		Pending: []string{
 			InstanceStatusBackingUp,
@@ -245,24 +315,16 @@ func waitDBInstanceCreated(conn *rds.RDS, id string, timeout time.Duration) (*rd
 			InstanceStatusStopping,
 			InstanceStatusUpgrading,
 		},
-		Target:     []string{InstanceStatusAvailable, InstanceStatusStorageOptimization},
-		Refresh:    statusDBInstance(conn, id),
-		Timeout:    timeout,
-		MinTimeout: 10 * time.Second,
-		Delay:      30 * time.Second,
-	}
-
-	outputRaw, err := stateConf.WaitForState()
-
-	if output, ok := outputRaw.(*rds.DBInstance); ok {
-		return output, err
-	}
-
-	return nil, err
+		Target:          []string{InstanceStatusAvailable, InstanceStatusStorageOptimization},
+		Timeout:         timeout,
+		MinPollInterval: 10 * time.Second,
+		Delay:           30 * time.Second,
+	})
 }
 
-func waitDBInstanceDeleted(conn *rds.RDS, id string, timeout time.Duration) (*rds.DBInstance, error) {
-	stateConf := &resource.StateChangeConf{
+func waitDBInstanceDeleted(ctx context.Context, conn *rds.RDS, id string, timeout time.Duration) (*rds.DBInstance, error) {
+	return tfresource.Wait(ctx, statusDBInstance(conn, id), tfresource.WaiterOpts{
+		Description: fmt.Sprintf("RDS DB Instance (%s) delete", id),
 		Pending: []string{
 			InstanceStatusAvailable,
 			InstanceStatusBackingUp,
@@ -279,24 +341,16 @@ func waitDBInstanceDeleted(conn *rds.RDS, id string, timeout time.Duration) (*rd
 			InstanceStatusStorageOptimization,
 		},
 		Target:                    []string{},
-		Refresh:                   statusDBInstance(conn, id),
 		Timeout:                   timeout,
-		MinTimeout:                10 * time.Second,
+		MinPollInterval:           10 * time.Second,
 		Delay:                     30 * time.Second,
 		ContinuousTargetOccurence: 3,
-	}
-
-	outputRaw, err := stateConf.WaitForState()
-
-	if output, ok := outputRaw.(*rds.DBInstance); ok {
-		return output, err
-	}
-
-	return nil, err
+	})
 }
 
-func waitDBInstanceUpdated(conn *rds.RDS, id string, timeout time.Duration) (*rds.DBInstance, error) { //nolint:unparam
-	stateConf := &resource.StateChangeConf{
+func waitDBInstanceUpdated(ctx context.Context, conn *rds.RDS, id string, timeout time.Duration) (*rds.DBInstance, error) { //nolint:unparam
+	return tfresource.Wait(ctx, statusDBInstance(conn, id), tfresource.WaiterOpts{
+		Description: fmt.Sprintf("RDS DB Instance (%s) update", id),
 		Pending: []string{
 			InstanceStatusBackingUp,
 			InstanceStatusConfiguringEnhancedMonitoring,
@@ -315,24 +369,38 @@ func waitDBInstanceUpdated(conn *rds.RDS, id string, timeout time.Duration) (*rd
 			InstanceStatusUpgrading,
 		},
 		Target:                    []string{InstanceStatusAvailable, InstanceStatusStorageOptimization},
-		Refresh:                   statusDBInstance(conn, id),
 		Timeout:                   timeout,
-		MinTimeout:                10 * time.Second,
+		MinPollInterval:           10 * time.Second,
 		Delay:                     30 * time.Second,
 		ContinuousTargetOccurence: 3,
-	}
-
-	outputRaw, err := stateConf.WaitForState()
+	})
+}
 
-	if output, ok := outputRaw.(*rds.DBInstance); ok {
-		return output, err
-	}
+func waitDBInstanceParameterGroupApplied(ctx context.Context, conn *rds.RDS, id, name string, timeout time.Duration) (*rds.DBParameterGroupStatus, error) {
+	return tfresource.Wait(ctx, statusDBInstanceParameterGroup(conn, id, name), tfresource.WaiterOpts{
+		Description:     fmt.Sprintf("RDS DB Instance (%s) DB Parameter Group (%s) apply", id, name),
+		Pending:         []string{parameterApplyStatusApplying},
+		Target:          []string{parameterApplyStatusInSync, parameterApplyStatusPendingReboot},
+		Timeout:         timeout,
+		MinPollInterval: 5 * time.Second,
+		Delay:           30 * time.Second,
+	})
+}
 
-	return nil, err
+func waitDBClusterMemberParameterGroupApplied(ctx context.Context, conn *rds.RDS, dbClusterID, dbInstanceID string, timeout time.Duration) (*rds.DBClusterMember, error) {
+	return tfresource.Wait(ctx, statusDBClusterMemberParameterGroup(conn, dbClusterID, dbInstanceID), tfresource.WaiterOpts{
+		Description:     fmt.Sprintf("RDS Cluster (%s) member (%s) DB Cluster Parameter Group apply", dbClusterID, dbInstanceID),
+		Pending:         []string{parameterApplyStatusApplying},
+		Target:          []string{parameterApplyStatusInSync, parameterApplyStatusPendingReboot},
+		Timeout:         timeout,
+		MinPollInterval: 5 * time.Second,
+		Delay:           30 * time.Second,
+	})
 }
 
-func waitDBClusterInstanceCreated(conn *rds.RDS, id string, timeout time.Duration) (*rds.DBInstance, error) {
-	stateConf := &resource.StateChangeConf{
+func waitDBClusterInstanceCreated(ctx context.Context, conn *rds.RDS, id string, timeout time.Duration) (*rds.DBInstance, error) {
+	return tfresource.Wait(ctx, statusDBInstance(conn, id), tfresource.WaiterOpts{
+		Description: fmt.Sprintf("RDS Cluster Instance (%s) create", id),
 		Pending: []string{
 			InstanceStatusBackingUp,
 			InstanceStatusConfiguringEnhancedMonitoring,
@@ -348,47 +416,31 @@ func waitDBClusterInstanceCreated(conn *rds.RDS, id string, timeout time.Duratio
 			InstanceStatusStorageOptimization,
 			InstanceStatusUpgrading,
 		},
-		Target:     []string{InstanceStatusAvailable},
-		Refresh:    statusDBInstance(conn, id),
-		Timeout:    timeout,
-		MinTimeout: 10 * time.Second,
-		Delay:      30 * time.Second,
-	}
-
-	outputRaw, err := stateConf.WaitForState()
-
-	if output, ok := outputRaw.(*rds.DBInstance); ok {
-		return output, err
-	}
-
-	return nil, err
+		Target:          []string{InstanceStatusAvailable},
+		Timeout:         timeout,
+		MinPollInterval: 10 * time.Second,
+		Delay:           30 * time.Second,
+	})
 }
 
-func waitDBClusterInstanceDeleted(conn *rds.RDS, id string, timeout time.Duration) (*rds.DBInstance, error) {
-	stateConf := &resource.StateChangeConf{
+func waitDBClusterInstanceDeleted(ctx context.Context, conn *rds.RDS, id string, timeout time.Duration) (*rds.DBInstance, error) {
+	return tfresource.Wait(ctx, statusDBInstance(conn, id), tfresource.WaiterOpts{
+		Description: fmt.Sprintf("RDS Cluster Instance (%s) delete", id),
 		Pending: []string{
 			InstanceStatusConfiguringLogExports,
 			InstanceStatusDeleting,
 			InstanceStatusModifying,
 		},
-		Target:     []string{},
-		Refresh:    statusDBInstance(conn, id),
-		Timeout:    timeout,
-		MinTimeout: 10 * time.Second,
-		Delay:      30 * time.Second,
-	}
-
-	outputRaw, err := stateConf.WaitForState()
-
-	if output, ok := outputRaw.(*rds.DBInstance); ok {
-		return output, err
-	}
-
-	return nil, err
+		Target:          []string{},
+		Timeout:         timeout,
+		MinPollInterval: 10 * time.Second,
+		Delay:           30 * time.Second,
+	})
 }
 
-func waitDBClusterInstanceUpdated(conn *rds.RDS, id string, timeout time.Duration) (*rds.DBInstance, error) {
-	stateConf := &resource.StateChangeConf{
+func waitDBClusterInstanceUpdated(ctx context.Context, conn *rds.RDS, id string, timeout time.Duration) (*rds.DBInstance, error) {
+	return tfresource.Wait(ctx, statusDBInstance(conn, id), tfresource.WaiterOpts{
+		Description: fmt.Sprintf("RDS Cluster Instance (%s) update", id),
 		Pending: []string{
 			InstanceStatusBackingUp,
 			InstanceStatusConfiguringEnhancedMonitoring,
@@ -404,20 +456,11 @@ func waitDBClusterInstanceUpdated(conn *rds.RDS, id string, timeout time.Duratio
 			InstanceStatusStorageOptimization,
 			InstanceStatusUpgrading,
 		},
-		Target:     []string{InstanceStatusAvailable},
-		Refresh:    statusDBInstance(conn, id),
-		Timeout:    timeout,
-		MinTimeout: 10 * time.Second,
-		Delay:      30 * time.Second,
-	}
-
-	outputRaw, err := stateConf.WaitForState()
-
-	if output, ok := outputRaw.(*rds.DBInstance); ok {
-		return output, err
-	}
-
-	return nil, err
+		Target:          []string{InstanceStatusAvailable},
+		Timeout:         timeout,
+		MinPollInterval: 10 * time.Second,
+		Delay:           30 * time.Second,
+	})
 }
 
 // waitActivityStreamStarted waits for Aurora Cluster Activity Stream to be started
@@ -483,7 +526,7 @@ func waitDBInstanceAutomatedBackupDeleted(conn *rds.RDS, dbInstanceID, dbInstanc
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{strconv.FormatBool(true)},
 		Target:  []string{strconv.FormatBool(false)},
-		Refresh: statusDBInstanceHasAutomatedBackup(conn, dbInstanceID, dbInstanceAutomatedBackupsARN),
+		Refresh: statusDBInstanceHasAutomatedBackup(context.Background(), conn, dbInstanceID, dbInstanceAutomatedBackupsARN),
 		Timeout: timeout,
 	}
 
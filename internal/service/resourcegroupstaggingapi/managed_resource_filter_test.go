@@ -0,0 +1,90 @@
+package resourcegroupstaggingapi
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+)
+
+func TestManagedResourceFilter(t *testing.T) {
+	tagging := func(arn string, tags map[string]string) *resourcegroupstaggingapi.ResourceTagMapping {
+		apiObject := &resourcegroupstaggingapi.ResourceTagMapping{
+			ResourceARN: aws.String(arn),
+		}
+
+		for k, v := range tags {
+			apiObject.Tags = append(apiObject.Tags, &resourcegroupstaggingapi.Tag{
+				Key:   aws.String(k),
+				Value: aws.String(v),
+			})
+		}
+
+		return apiObject
+	}
+
+	taggings := []*resourcegroupstaggingapi.ResourceTagMapping{
+		tagging("arn:aws:ec2:us-west-2:123456789012:instance/i-1", map[string]string{"ManagedBy": "terraform"}),
+		tagging("arn:aws:ec2:us-west-2:123456789012:instance/i-2", map[string]string{"ManagedBy": "cloudformation"}),
+		tagging("arn:aws:ec2:us-west-2:123456789012:instance/i-3", map[string]string{"Name": "orphan"}),
+		nil,
+		tagging("arn:aws:ec2:us-west-2:123456789012:instance/i-4", nil),
+	}
+
+	testCases := []struct {
+		Name     string
+		Filter   *managedResourceFilter
+		Expected string
+	}{
+		{
+			Name:     "tag key",
+			Filter:   &managedResourceFilter{tagKey: "ManagedBy"},
+			Expected: "i-3 i-4",
+		},
+		{
+			Name:     "tag key and values",
+			Filter:   &managedResourceFilter{tagKey: "ManagedBy", tagValues: []string{"terraform"}},
+			Expected: "i-2 i-3 i-4",
+		},
+		{
+			Name:     "tag key case sensitive",
+			Filter:   &managedResourceFilter{tagKey: "managedby"},
+			Expected: "i-1 i-2 i-3 i-4",
+		},
+		{
+			Name:     "ARNs",
+			Filter:   &managedResourceFilter{arns: []string{"arn:aws:ec2:us-west-2:123456789012:instance/i-3"}},
+			Expected: "i-1 i-2 i-4",
+		},
+		{
+			Name: "tag key values and ARNs",
+			Filter: &managedResourceFilter{
+				tagKey:    "ManagedBy",
+				tagValues: []string{"terraform"},
+				arns:      []string{"arn:aws:ec2:us-west-2:123456789012:instance/i-4"},
+			},
+			Expected: "i-2 i-3",
+		},
+		{
+			Name:     "no filters",
+			Filter:   &managedResourceFilter{},
+			Expected: "i-1 i-2 i-3 i-4",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			var ids []string
+
+			for _, v := range testCase.Filter.unmanaged(taggings) {
+				_, id, _ := strings.Cut(aws.StringValue(v.ResourceARN), "/")
+				ids = append(ids, id)
+			}
+
+			if got := strings.Join(ids, " "); got != testCase.Expected {
+				t.Errorf("got %q, expected %q", got, testCase.Expected)
+			}
+		})
+	}
+}
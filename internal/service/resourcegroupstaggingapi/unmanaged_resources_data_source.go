@@ -0,0 +1,184 @@
+package resourcegroupstaggingapi
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func DataSourceUnmanagedResources() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceUnmanagedResourcesRead,
+
+		Schema: map[string]*schema.Schema{
+			"arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"managed_arns": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString, ValidateFunc: verify.ValidARN},
+				AtLeastOneOf: []string{"managed_arns", "managed_tag_key"},
+			},
+			"managed_tag_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+				AtLeastOneOf: []string{"managed_arns", "managed_tag_key"},
+			},
+			"managed_tag_values": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				RequiredWith: []string{"managed_tag_key"},
+			},
+			"resource_tag_mapping_list": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tags": tftags.TagsSchemaComputed(),
+					},
+				},
+			},
+			"resource_type_filters": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 100,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+const (
+	DSNameUnmanagedResources = "Unmanaged Resources Data Source"
+)
+
+func dataSourceUnmanagedResourcesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ResourceGroupsTaggingAPIConn
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	input := &resourcegroupstaggingapi.GetResourcesInput{}
+
+	if v, ok := d.GetOk("resource_type_filters"); ok && v.(*schema.Set).Len() > 0 {
+		input.ResourceTypeFilters = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	var taggings []*resourcegroupstaggingapi.ResourceTagMapping
+
+	err := conn.GetResourcesPagesWithContext(ctx, input, func(page *resourcegroupstaggingapi.GetResourcesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		taggings = append(taggings, page.ResourceTagMappingList...)
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return create.DiagError(names.ResourceGroupsTaggingAPI, create.ErrActionReading, DSNameUnmanagedResources, "", err)
+	}
+
+	filter := &managedResourceFilter{
+		tagKey:    d.Get("managed_tag_key").(string),
+		tagValues: flex.ExpandStringValueSet(d.Get("managed_tag_values").(*schema.Set)),
+		arns:      flex.ExpandStringValueSet(d.Get("managed_arns").(*schema.Set)),
+	}
+
+	var arns []string
+	var mappings []map[string]interface{}
+
+	for _, tagging := range filter.unmanaged(taggings) {
+		arn := aws.StringValue(tagging.ResourceARN)
+
+		arns = append(arns, arn)
+		mappings = append(mappings, map[string]interface{}{
+			"resource_arn": arn,
+			"tags":         KeyValueTags(tagging.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map(),
+		})
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set("arns", arns)
+
+	if err := d.Set("resource_tag_mapping_list", mappings); err != nil {
+		return create.DiagError(names.ResourceGroupsTaggingAPI, create.ErrActionSetting, DSNameUnmanagedResources, d.Id(), err)
+	}
+
+	return nil
+}
+
+// managedResourceFilter decides whether a tagged resource is managed.
+// A resource is managed if it carries the managed tag key (with one of the
+// allowed values, when values are configured) or if its ARN is listed.
+type managedResourceFilter struct {
+	tagKey    string
+	tagValues []string
+	arns      []string
+}
+
+func (f *managedResourceFilter) isManaged(tagging *resourcegroupstaggingapi.ResourceTagMapping) bool {
+	arn := aws.StringValue(tagging.ResourceARN)
+
+	for _, v := range f.arns {
+		if v == arn {
+			return true
+		}
+	}
+
+	if f.tagKey == "" {
+		return false
+	}
+
+	tags := KeyValueTags(tagging.Tags)
+
+	if !tags.KeyExists(f.tagKey) {
+		return false
+	}
+
+	if len(f.tagValues) == 0 {
+		return true
+	}
+
+	value := aws.StringValue(tags.KeyValue(f.tagKey))
+
+	for _, v := range f.tagValues {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (f *managedResourceFilter) unmanaged(taggings []*resourcegroupstaggingapi.ResourceTagMapping) []*resourcegroupstaggingapi.ResourceTagMapping {
+	var result []*resourcegroupstaggingapi.ResourceTagMapping
+
+	for _, tagging := range taggings {
+		if tagging == nil || f.isManaged(tagging) {
+			continue
+		}
+
+		result = append(result, tagging)
+	}
+
+	return result
+}
@@ -0,0 +1,126 @@
+package resourcegroupstaggingapi_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccResourceGroupsTaggingAPIUnmanagedResourcesDataSource_managedTagKey(t *testing.T) {
+	dataSourceName := "data.aws_unmanaged_resources.test"
+	managedResourceName := "aws_vpc.managed"
+	unmanagedResourceName := "aws_vpc.unmanaged"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, resourcegroupstaggingapi.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUnmanagedResourcesDataSourceConfig_managedTagKey(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "arns.*", unmanagedResourceName, "arn"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "resource_tag_mapping_list.*.resource_arn", unmanagedResourceName, "arn"),
+					testAccCheckUnmanagedResourcesDataSourceExcludes(dataSourceName, managedResourceName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceGroupsTaggingAPIUnmanagedResourcesDataSource_managedARNs(t *testing.T) {
+	dataSourceName := "data.aws_unmanaged_resources.test"
+	managedResourceName := "aws_vpc.managed"
+	unmanagedResourceName := "aws_vpc.unmanaged"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, resourcegroupstaggingapi.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUnmanagedResourcesDataSourceConfig_managedARNs(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "arns.*", unmanagedResourceName, "arn"),
+					testAccCheckUnmanagedResourcesDataSourceExcludes(dataSourceName, managedResourceName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckUnmanagedResourcesDataSourceExcludes(dataSourceName, resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		ds, ok := s.RootModule().Resources[dataSourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", dataSourceName)
+		}
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		arn := rs.Primary.Attributes["arn"]
+
+		for k, v := range ds.Primary.Attributes {
+			if strings.HasPrefix(k, "arns.") && v == arn {
+				return fmt.Errorf("%s unexpectedly reported as unmanaged", arn)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccUnmanagedResourcesDataSourceConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "managed" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name      = %[1]q
+    ManagedBy = "terraform"
+  }
+}
+
+resource "aws_vpc" "unmanaged" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName)
+}
+
+func testAccUnmanagedResourcesDataSourceConfig_managedTagKey(rName string) string {
+	return acctest.ConfigCompose(testAccUnmanagedResourcesDataSourceConfig_base(rName), `
+data "aws_unmanaged_resources" "test" {
+  managed_tag_key       = "ManagedBy"
+  managed_tag_values    = ["terraform"]
+  resource_type_filters = ["ec2:vpc"]
+
+  depends_on = [aws_vpc.managed, aws_vpc.unmanaged]
+}
+`)
+}
+
+func testAccUnmanagedResourcesDataSourceConfig_managedARNs(rName string) string {
+	return acctest.ConfigCompose(testAccUnmanagedResourcesDataSourceConfig_base(rName), `
+data "aws_unmanaged_resources" "test" {
+  managed_arns          = [aws_vpc.managed.arn]
+  resource_type_filters = ["ec2:vpc"]
+
+  depends_on = [aws_vpc.unmanaged]
+}
+`)
+}
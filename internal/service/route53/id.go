@@ -1,25 +1,42 @@
 package route53
 
 import (
-	"fmt"
-	"strings"
+	"github.com/hashicorp/terraform-provider-aws/internal/importid"
 )
 
 const KeySigningKeyResourceIDSeparator = ","
 
-func KeySigningKeyCreateResourceID(transitGatewayRouteTableID string, prefixListID string) string {
-	parts := []string{transitGatewayRouteTableID, prefixListID}
-	id := strings.Join(parts, KeySigningKeyResourceIDSeparator)
+var keySigningKeyResourceIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "hosted-zone-id"},
+		{Name: "name"},
+	},
+	Separator: KeySigningKeyResourceIDSeparator,
+}
+
+var trafficPolicyImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "TRAFFIC-POLICY-ID"},
+		{Name: "TRAFFIC-POLICY-VERSION"},
+	},
+	Separator: "/",
+}
 
-	return id
+func init() {
+	importid.Register("aws_route53_key_signing_key", keySigningKeyResourceIDFormat)
+	importid.Register("aws_route53_traffic_policy", trafficPolicyImportIDFormat)
+}
+
+func KeySigningKeyCreateResourceID(transitGatewayRouteTableID string, prefixListID string) string {
+	return keySigningKeyResourceIDFormat.Create(transitGatewayRouteTableID, prefixListID)
 }
 
 func KeySigningKeyParseResourceID(id string) (string, string, error) {
-	parts := strings.Split(id, KeySigningKeyResourceIDSeparator)
+	parts, err := keySigningKeyResourceIDFormat.Parse(id)
 
-	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
-		return parts[0], parts[1], nil
+	if err != nil {
+		return "", "", err
 	}
 
-	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected hosted-zone-id%[2]sname", id, KeySigningKeyResourceIDSeparator)
+	return parts[0], parts[1], nil
 }
@@ -2,10 +2,8 @@ package route53
 
 import (
 	"context"
-	"fmt"
 	"log"
 	"strconv"
-	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/route53"
@@ -26,10 +24,9 @@ func ResourceTrafficPolicy() *schema.Resource {
 
 		Importer: &schema.ResourceImporter{
 			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-				const idSeparator = "/"
-				parts := strings.Split(d.Id(), idSeparator)
-				if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-					return nil, fmt.Errorf("unexpected format for ID (%[1]s), expected TRAFFIC-POLICY-ID%[2]sTRAFFIC-POLICY-VERSION", d.Id(), idSeparator)
+				parts, err := trafficPolicyImportIDFormat.Parse(d.Id())
+				if err != nil {
+					return nil, err
 				}
 
 				version, err := strconv.Atoi(parts[1])
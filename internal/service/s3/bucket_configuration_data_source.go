@@ -0,0 +1,428 @@
+package s3
+
+import (
+	"context"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const errCodeOwnershipControlsNotFound = "OwnershipControlsNotFoundError"
+
+// DataSourceBucketConfiguration returns the aws_s3_bucket_configuration data source.
+// Its nested schemas are derived from the corresponding aws_s3_bucket_*_configuration
+// resources so that the two stay in step.
+func DataSourceBucketConfiguration() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceBucketConfigurationRead,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"cors_rule": computedOnlySchema(ResourceBucketCorsConfiguration().Schema["cors_rule"]),
+			"expected_bucket_owner": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"lifecycle_rule":          computedOnlySchema(ResourceBucketLifecycleConfiguration().Schema["rule"]),
+			"logging":                 computedOnlyBlockSchema(ResourceBucketLogging(), "target_bucket", "target_grant", "target_prefix"),
+			"ownership_controls_rule": computedOnlySchema(ResourceBucketOwnershipControls().Schema["rule"]),
+			"policy": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"public_access_block":         computedOnlyBlockSchema(ResourceBucketPublicAccessBlock(), "block_public_acls", "block_public_policy", "ignore_public_acls", "restrict_public_buckets"),
+			"replication_configuration":   computedOnlyBlockSchema(ResourceBucketReplicationConfiguration(), "role", "rule"),
+			"server_side_encryption_rule": computedOnlySchema(ResourceBucketServerSideEncryptionConfiguration().Schema["rule"]),
+			"versioning":                  computedOnlySchema(ResourceBucketVersioning().Schema["versioning_configuration"]),
+			"website":                     computedOnlyBlockSchema(ResourceBucketWebsiteConfiguration(), "error_document", "index_document", "redirect_all_requests_to", "routing_rule"),
+		},
+	}
+}
+
+// bucketConfigurationReader reads one aspect of a bucket's configuration, returning the value of the
+// corresponding top-level attribute. A nil value indicates that the bucket has no such configuration.
+type bucketConfigurationReader func(ctx context.Context, conn *s3.S3, bucket, expectedBucketOwner string) (interface{}, error)
+
+var bucketConfigurationReaders = map[string]bucketConfigurationReader{
+	"cors_rule":                   readBucketConfigurationCORSRules,
+	"lifecycle_rule":              readBucketConfigurationLifecycleRules,
+	"logging":                     readBucketConfigurationLogging,
+	"ownership_controls_rule":     readBucketConfigurationOwnershipControlsRules,
+	"policy":                      readBucketConfigurationPolicy,
+	"public_access_block":         readBucketConfigurationPublicAccessBlock,
+	"replication_configuration":   readBucketConfigurationReplication,
+	"server_side_encryption_rule": readBucketConfigurationServerSideEncryptionRules,
+	"versioning":                  readBucketConfigurationVersioning,
+	"website":                     readBucketConfigurationWebsite,
+}
+
+func dataSourceBucketConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	bucket := d.Get("bucket").(string)
+	expectedBucketOwner := d.Get("expected_bucket_owner").(string)
+
+	input := &s3.HeadBucketInput{
+		Bucket: aws.String(bucket),
+	}
+
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
+	if _, err := conn.HeadBucketWithContext(ctx, input); err != nil {
+		return diag.Errorf("reading S3 Bucket (%s): %s", bucket, err)
+	}
+
+	// Each configuration is read with a separate API call, so read them concurrently.
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var errs *multierror.Error
+	values := make(map[string]interface{}, len(bucketConfigurationReaders))
+
+	for key, reader := range bucketConfigurationReaders {
+		key, reader := key, reader
+
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			v, err := reader(ctx, conn, bucket, expectedBucketOwner)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if err != nil && !tfawserr.ErrCodeEquals(err, ErrCodeNotImplemented, ErrCodeXNotImplemented) {
+				errs = multierror.Append(errs, err)
+				return
+			}
+
+			values[key] = v
+		}()
+	}
+
+	wg.Wait()
+
+	if err := errs.ErrorOrNil(); err != nil {
+		return diag.Errorf("reading S3 Bucket (%s) configuration: %s", bucket, err)
+	}
+
+	d.SetId(CreateResourceID(bucket, expectedBucketOwner))
+
+	for key, v := range values {
+		if err := d.Set(key, v); err != nil {
+			return diag.Errorf("setting %s: %s", key, err)
+		}
+	}
+
+	return nil
+}
+
+func readBucketConfigurationCORSRules(ctx context.Context, conn *s3.S3, bucket, expectedBucketOwner string) (interface{}, error) {
+	input := &s3.GetBucketCorsInput{
+		Bucket: aws.String(bucket),
+	}
+
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
+	output, err := conn.GetBucketCorsWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, ErrCodeNoSuchCORSConfiguration) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return flattenBucketCorsConfigurationCorsRules(output.CORSRules), nil
+}
+
+func readBucketConfigurationLifecycleRules(ctx context.Context, conn *s3.S3, bucket, expectedBucketOwner string) (interface{}, error) {
+	input := &s3.GetBucketLifecycleConfigurationInput{
+		Bucket: aws.String(bucket),
+	}
+
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
+	output, err := conn.GetBucketLifecycleConfigurationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, ErrCodeNoSuchLifecycleConfiguration) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return FlattenLifecycleRules(output.Rules), nil
+}
+
+func readBucketConfigurationLogging(ctx context.Context, conn *s3.S3, bucket, expectedBucketOwner string) (interface{}, error) {
+	input := &s3.GetBucketLoggingInput{
+		Bucket: aws.String(bucket),
+	}
+
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
+	output, err := conn.GetBucketLoggingWithContext(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output.LoggingEnabled == nil {
+		return nil, nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"target_bucket": aws.StringValue(output.LoggingEnabled.TargetBucket),
+		"target_grant":  flattenBucketLoggingTargetGrants(output.LoggingEnabled.TargetGrants),
+		"target_prefix": aws.StringValue(output.LoggingEnabled.TargetPrefix),
+	}}, nil
+}
+
+func readBucketConfigurationOwnershipControlsRules(ctx context.Context, conn *s3.S3, bucket, expectedBucketOwner string) (interface{}, error) {
+	input := &s3.GetBucketOwnershipControlsInput{
+		Bucket: aws.String(bucket),
+	}
+
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
+	output, err := conn.GetBucketOwnershipControlsWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeOwnershipControlsNotFound) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output.OwnershipControls == nil {
+		return nil, nil
+	}
+
+	return flattenOwnershipControlsRules(output.OwnershipControls.Rules), nil
+}
+
+func readBucketConfigurationPolicy(ctx context.Context, conn *s3.S3, bucket, expectedBucketOwner string) (interface{}, error) {
+	input := &s3.GetBucketPolicyInput{
+		Bucket: aws.String(bucket),
+	}
+
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
+	output, err := conn.GetBucketPolicyWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, ErrCodeNoSuchBucketPolicy) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return structure.NormalizeJsonString(aws.StringValue(output.Policy))
+}
+
+func readBucketConfigurationPublicAccessBlock(ctx context.Context, conn *s3.S3, bucket, expectedBucketOwner string) (interface{}, error) {
+	input := &s3.GetPublicAccessBlockInput{
+		Bucket: aws.String(bucket),
+	}
+
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
+	output, err := conn.GetPublicAccessBlockWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, ErrCodeNoSuchPublicAccessBlockConfiguration) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output.PublicAccessBlockConfiguration == nil {
+		return nil, nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"block_public_acls":       aws.BoolValue(output.PublicAccessBlockConfiguration.BlockPublicAcls),
+		"block_public_policy":     aws.BoolValue(output.PublicAccessBlockConfiguration.BlockPublicPolicy),
+		"ignore_public_acls":      aws.BoolValue(output.PublicAccessBlockConfiguration.IgnorePublicAcls),
+		"restrict_public_buckets": aws.BoolValue(output.PublicAccessBlockConfiguration.RestrictPublicBuckets),
+	}}, nil
+}
+
+func readBucketConfigurationReplication(ctx context.Context, conn *s3.S3, bucket, expectedBucketOwner string) (interface{}, error) {
+	input := &s3.GetBucketReplicationInput{
+		Bucket: aws.String(bucket),
+	}
+
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
+	output, err := conn.GetBucketReplicationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, ErrCodeReplicationConfigurationNotFound) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output.ReplicationConfiguration == nil {
+		return nil, nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"role": aws.StringValue(output.ReplicationConfiguration.Role),
+		"rule": FlattenReplicationRules(output.ReplicationConfiguration.Rules),
+	}}, nil
+}
+
+func readBucketConfigurationServerSideEncryptionRules(ctx context.Context, conn *s3.S3, bucket, expectedBucketOwner string) (interface{}, error) {
+	input := &s3.GetBucketEncryptionInput{
+		Bucket: aws.String(bucket),
+	}
+
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
+	output, err := conn.GetBucketEncryptionWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, ErrCodeServerSideEncryptionConfigurationNotFound) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output.ServerSideEncryptionConfiguration == nil {
+		return nil, nil
+	}
+
+	return flattenBucketServerSideEncryptionConfigurationRules(output.ServerSideEncryptionConfiguration.Rules), nil
+}
+
+func readBucketConfigurationVersioning(ctx context.Context, conn *s3.S3, bucket, expectedBucketOwner string) (interface{}, error) {
+	input := &s3.GetBucketVersioningInput{
+		Bucket: aws.String(bucket),
+	}
+
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
+	output, err := conn.GetBucketVersioningWithContext(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return flattenBucketVersioningConfiguration(output), nil
+}
+
+func readBucketConfigurationWebsite(ctx context.Context, conn *s3.S3, bucket, expectedBucketOwner string) (interface{}, error) {
+	input := &s3.GetBucketWebsiteInput{
+		Bucket: aws.String(bucket),
+	}
+
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
+	output, err := conn.GetBucketWebsiteWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, ErrCodeNoSuchWebsiteConfiguration) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return []interface{}{map[string]interface{}{
+		"error_document":           flattenBucketWebsiteConfigurationErrorDocument(output.ErrorDocument),
+		"index_document":           flattenBucketWebsiteConfigurationIndexDocument(output.IndexDocument),
+		"redirect_all_requests_to": flattenBucketWebsiteConfigurationRedirectAllRequestsTo(output.RedirectAllRequestsTo),
+		"routing_rule":             flattenBucketWebsiteConfigurationRoutingRules(output.RoutingRules),
+	}}, nil
+}
+
+// computedOnlyBlockSchema returns a computed-only list attribute whose elements contain
+// the specified attributes of a resource's schema.
+func computedOnlyBlockSchema(r *schema.Resource, keys ...string) *schema.Schema {
+	elem := make(map[string]*schema.Schema, len(keys))
+
+	for _, key := range keys {
+		elem[key] = computedOnlySchema(r.Schema[key])
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: elem,
+		},
+	}
+}
+
+// computedOnlySchema returns a copy of a resource attribute's schema, including any
+// nested schemas, with all configurability (defaults, validation, diff suppression etc.) removed.
+func computedOnlySchema(s *schema.Schema) *schema.Schema {
+	v := &schema.Schema{
+		Type:      s.Type,
+		Computed:  true,
+		Sensitive: s.Sensitive,
+	}
+
+	switch elem := s.Elem.(type) {
+	case *schema.Resource:
+		m := make(map[string]*schema.Schema, len(elem.Schema))
+
+		for k, s := range elem.Schema {
+			m[k] = computedOnlySchema(s)
+		}
+
+		v.Elem = &schema.Resource{
+			Schema: m,
+		}
+	case *schema.Schema:
+		v.Elem = &schema.Schema{
+			Type: elem.Type,
+		}
+	}
+
+	return v
+}
@@ -0,0 +1,218 @@
+package s3_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/s3"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+)
+
+func TestAccS3BucketConfigurationDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_s3_bucket_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketConfigurationDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "cors_rule.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "lifecycle_rule.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "logging.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "policy", ""),
+					resource.TestCheckResourceAttr(dataSourceName, "replication_configuration.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "versioning.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "versioning.0.status", tfs3.BucketVersioningStatusDisabled),
+					resource.TestCheckResourceAttr(dataSourceName, "website.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3BucketConfigurationDataSource_full(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_s3_bucket_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketConfigurationDataSourceConfig_full(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "cors_rule.#", "1"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "cors_rule.*.allowed_methods.*", "GET"),
+					resource.TestCheckResourceAttr(dataSourceName, "lifecycle_rule.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "lifecycle_rule.0.id", "expire"),
+					resource.TestCheckResourceAttr(dataSourceName, "lifecycle_rule.0.expiration.0.days", "30"),
+					resource.TestCheckResourceAttr(dataSourceName, "logging.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "logging.0.target_bucket", "aws_s3_bucket.log", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "logging.0.target_prefix", "log/"),
+					resource.TestCheckResourceAttr(dataSourceName, "ownership_controls_rule.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "ownership_controls_rule.0.object_ownership", "BucketOwnerEnforced"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "policy"),
+					resource.TestCheckResourceAttr(dataSourceName, "public_access_block.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "public_access_block.0.block_public_acls", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "public_access_block.0.block_public_policy", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "server_side_encryption_rule.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "server_side_encryption_rule.*", map[string]string{
+						"apply_server_side_encryption_by_default.0.sse_algorithm": "AES256",
+					}),
+					resource.TestCheckResourceAttr(dataSourceName, "versioning.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "versioning.0.status", "Enabled"),
+					resource.TestCheckResourceAttr(dataSourceName, "website.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "website.0.index_document.0.suffix", "index.html"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBucketConfigurationDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+data "aws_s3_bucket_configuration" "test" {
+  bucket = aws_s3_bucket.test.id
+}
+`, rName)
+}
+
+func testAccBucketConfigurationDataSourceConfig_full(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "log" {
+  bucket = "%[1]s-log"
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_cors_configuration" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  cors_rule {
+    allowed_methods = ["GET"]
+    allowed_origins = ["https://example.com"]
+  }
+}
+
+resource "aws_s3_bucket_lifecycle_configuration" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  rule {
+    id     = "expire"
+    status = "Enabled"
+
+    filter {
+      prefix = "tmp/"
+    }
+
+    expiration {
+      days = 30
+    }
+  }
+}
+
+resource "aws_s3_bucket_logging" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  target_bucket = aws_s3_bucket.log.id
+  target_prefix = "log/"
+}
+
+resource "aws_s3_bucket_ownership_controls" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  rule {
+    object_ownership = "BucketOwnerEnforced"
+  }
+}
+
+resource "aws_s3_bucket_public_access_block" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  block_public_acls   = true
+  block_public_policy = false
+}
+
+resource "aws_s3_bucket_policy" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Sid       = "DenyInsecureTransport"
+      Effect    = "Deny"
+      Principal = "*"
+      Action    = "s3:*"
+      Resource = [
+        "arn:${data.aws_partition.current.partition}:s3:::%[1]s",
+        "arn:${data.aws_partition.current.partition}:s3:::%[1]s/*",
+      ]
+      Condition = {
+        Bool = {
+          "aws:SecureTransport" = "false"
+        }
+      }
+    }]
+  })
+
+  depends_on = [aws_s3_bucket_public_access_block.test]
+}
+
+resource "aws_s3_bucket_server_side_encryption_configuration" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  rule {
+    apply_server_side_encryption_by_default {
+      sse_algorithm = "AES256"
+    }
+  }
+}
+
+resource "aws_s3_bucket_versioning" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  versioning_configuration {
+    status = "Enabled"
+  }
+}
+
+resource "aws_s3_bucket_website_configuration" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  index_document {
+    suffix = "index.html"
+  }
+}
+
+data "aws_s3_bucket_configuration" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  depends_on = [
+    aws_s3_bucket_cors_configuration.test,
+    aws_s3_bucket_lifecycle_configuration.test,
+    aws_s3_bucket_logging.test,
+    aws_s3_bucket_ownership_controls.test,
+    aws_s3_bucket_policy.test,
+    aws_s3_bucket_server_side_encryption_configuration.test,
+    aws_s3_bucket_versioning.test,
+    aws_s3_bucket_website_configuration.test,
+  ]
+}
+`, rName)
+}
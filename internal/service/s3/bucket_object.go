@@ -404,16 +404,14 @@ func resourceBucketObjectDelete(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceBucketObjectImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	id := d.Id()
-	id = strings.TrimPrefix(id, "s3://")
-	parts := strings.Split(id, "/")
+	parts, err := objectImportIDFormat.Parse(strings.TrimPrefix(d.Id(), "s3://"))
 
-	if len(parts) < 2 {
-		return []*schema.ResourceData{d}, fmt.Errorf("id %s should be in format <bucket>/<key> or s3://<bucket>/<key>", id)
+	if err != nil {
+		return []*schema.ResourceData{d}, err
 	}
 
 	bucket := parts[0]
-	key := strings.Join(parts[1:], "/")
+	key := parts[1]
 
 	d.SetId(key)
 	d.Set("bucket", bucket)
@@ -0,0 +1,644 @@
+package s3
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"mime"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/mitchellh/go-homedir"
+)
+
+const (
+	directorySyncDefaultUploadConcurrency = 8
+	// DeleteObjects accepts at most 1000 keys per request.
+	directorySyncDeleteBatchSize = 1000
+)
+
+// directorySyncObjectAttributes are the arguments applied to every uploaded object.
+// Changing any of them re-uploads all files.
+var directorySyncObjectAttributes = []string{
+	"cache_control",
+	"checksum_algorithm",
+	"content_types",
+	"kms_key_id",
+	"server_side_encryption",
+	"storage_class",
+}
+
+func ResourceDirectorySync() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDirectorySyncCreate,
+		ReadWithoutTimeout:   resourceDirectorySyncRead,
+		UpdateWithoutTimeout: resourceDirectorySyncUpdate,
+		DeleteWithoutTimeout: resourceDirectorySyncDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: resourceDirectorySyncCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"cache_control": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"checksum_algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(s3.ChecksumAlgorithm_Values(), false),
+			},
+			"content_types": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"delete_stale": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"files": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"key_prefix": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				ForceNew:  true,
+				StateFunc: directorySyncKeyPrefixStateFunc,
+			},
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"multipart_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"multipart_part_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(int(s3manager.MinUploadPartSize)),
+			},
+			"server_side_encryption": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(s3.ServerSideEncryption_Values(), false),
+			},
+			"source": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"storage_class": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(s3.ObjectStorageClass_Values(), false),
+			},
+			"upload_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      directorySyncDefaultUploadConcurrency,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+		},
+	}
+}
+
+func resourceDirectorySyncCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	bucket := d.Get("bucket").(string)
+	keyPrefix := directorySyncKeyPrefix(d.Get("key_prefix").(string))
+
+	// Set the ID first so that objects uploaded before any failure are tracked and later cleaned up.
+	d.SetId(strings.Join([]string{bucket, keyPrefix}, resourceIDSeparator))
+
+	if err := directorySync(ctx, d, meta, true); err != nil {
+		return diag.Errorf("creating S3 Directory Sync (%s): %s", d.Id(), err)
+	}
+
+	return resourceDirectorySyncRead(ctx, d, meta)
+}
+
+func resourceDirectorySyncRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	bucket := d.Get("bucket").(string)
+	keyPrefix := directorySyncKeyPrefix(d.Get("key_prefix").(string))
+
+	remote, err := findObjectKeysByPrefix(ctx, conn, bucket, keyPrefix)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		log.Printf("[WARN] S3 Bucket (%s) not found, removing S3 Directory Sync (%s) from state", bucket, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading S3 Directory Sync (%s): %s", d.Id(), err)
+	}
+
+	files := make(map[string]string)
+
+	// Drop files that have been removed outside of Terraform so that they are uploaded again.
+	// Objects under the prefix that weren't uploaded by this resource are never recorded.
+	for k, v := range flex.ExpandStringValueMap(d.Get("files").(map[string]interface{})) {
+		if _, ok := remote[k]; ok {
+			files[k] = v
+		}
+	}
+
+	d.Set("files", files)
+
+	return nil
+}
+
+func resourceDirectorySyncUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := directorySync(ctx, d, meta, d.HasChanges(directorySyncObjectAttributes...)); err != nil {
+		return diag.Errorf("updating S3 Directory Sync (%s): %s", d.Id(), err)
+	}
+
+	return resourceDirectorySyncRead(ctx, d, meta)
+}
+
+func resourceDirectorySyncDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	bucket := d.Get("bucket").(string)
+
+	var keys []string
+
+	for k := range d.Get("files").(map[string]interface{}) {
+		keys = append(keys, k)
+	}
+
+	log.Printf("[DEBUG] Deleting S3 Directory Sync (%s): %d objects", d.Id(), len(keys))
+	_, err := deleteObjectKeys(ctx, conn, bucket, keys)
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting S3 Directory Sync (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceDirectorySyncCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("source") || !d.NewValueKnown("key_prefix") {
+		return d.SetNewComputed("files")
+	}
+
+	files, err := directorySyncLocalFiles(d.Get("source").(string), directorySyncKeyPrefix(d.Get("key_prefix").(string)), nil)
+
+	if err != nil {
+		return err
+	}
+
+	o, _ := d.GetChange("files")
+	hashes := directorySyncHashes(files, flex.ExpandStringValueMap(o.(map[string]interface{})), d.Get("delete_stale").(bool))
+
+	return d.SetNew("files", hashes)
+}
+
+// directorySyncHashes returns the hashes of the local files keyed by object key.
+// Unless stale objects are deleted, previously uploaded objects that no longer have a corresponding local file
+// keep their old hash so that they remain tracked and are deleted when the resource is destroyed.
+func directorySyncHashes(files []directorySyncFile, old map[string]string, deleteStale bool) map[string]string {
+	hashes := make(map[string]string, len(files))
+
+	if !deleteStale {
+		for k, v := range old {
+			hashes[k] = v
+		}
+	}
+
+	for _, v := range files {
+		hashes[v.key] = v.hash
+	}
+
+	return hashes
+}
+
+// directorySyncKeyPrefix returns the specified key prefix with a trailing "/", so that files are always
+// uploaded into a "folder" and the prefix never matches the keys of sibling objects.
+func directorySyncKeyPrefix(keyPrefix string) string {
+	if keyPrefix != "" && !strings.HasSuffix(keyPrefix, "/") {
+		keyPrefix += "/"
+	}
+
+	return keyPrefix
+}
+
+func directorySyncKeyPrefixStateFunc(v interface{}) string {
+	return directorySyncKeyPrefix(v.(string))
+}
+
+type directorySyncFile struct {
+	contentType string
+	hash        string
+	key         string
+	path        string
+}
+
+// directorySyncLocalFiles walks the directory tree rooted at `source`, returning
+// the files to upload with their object keys, content types and content hashes.
+func directorySyncLocalFiles(source, keyPrefix string, contentTypes map[string]string) ([]directorySyncFile, error) {
+	root, err := homedir.Expand(source)
+
+	if err != nil {
+		return nil, fmt.Errorf("expanding homedir in source (%s): %w", source, err)
+	}
+
+	var files []directorySyncFile
+
+	err = filepath.WalkDir(root, func(p string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(root, p)
+
+		if err != nil {
+			return err
+		}
+
+		hash, contentType, err := directorySyncHashFile(p, contentTypes)
+
+		if err != nil {
+			return err
+		}
+
+		files = append(files, directorySyncFile{
+			contentType: contentType,
+			hash:        hash,
+			key:         keyPrefix + filepath.ToSlash(rel),
+			path:        p,
+		})
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("reading source (%s): %w", source, err)
+	}
+
+	return files, nil
+}
+
+// directorySyncHashFile returns the hex-encoded SHA-256 hash and detected content type of a local file.
+// The content type is looked up by file extension, first in `contentTypes` then in the system MIME types,
+// falling back to sniffing the file's content.
+func directorySyncHashFile(p string, contentTypes map[string]string) (string, string, error) {
+	file, err := os.Open(p)
+
+	if err != nil {
+		return "", "", err
+	}
+
+	defer file.Close()
+
+	var sniff [512]byte
+	n, err := io.ReadFull(file, sniff[:])
+
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return "", "", err
+	}
+
+	hash := sha256.New()
+	hash.Write(sniff[:n])
+
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", "", err
+	}
+
+	ext := strings.ToLower(path.Ext(filepath.ToSlash(p)))
+	contentType, ok := contentTypes[ext]
+
+	if !ok {
+		contentType = mime.TypeByExtension(ext)
+	}
+
+	if contentType == "" {
+		contentType = http.DetectContentType(sniff[:n])
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), contentType, nil
+}
+
+// directorySync uploads new and changed local files and, if configured, deletes previously uploaded objects
+// that no longer have a corresponding local file. If `all` is true then all local files are uploaded.
+func directorySync(ctx context.Context, d *schema.ResourceData, meta interface{}, all bool) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	bucket := d.Get("bucket").(string)
+	keyPrefix := directorySyncKeyPrefix(d.Get("key_prefix").(string))
+
+	contentTypes := make(map[string]string)
+
+	for k, v := range d.Get("content_types").(map[string]interface{}) {
+		ext := strings.ToLower(k)
+
+		if !strings.HasPrefix(ext, ".") {
+			ext = "." + ext
+		}
+
+		contentTypes[ext] = v.(string)
+	}
+
+	files, err := directorySyncLocalFiles(d.Get("source").(string), keyPrefix, contentTypes)
+
+	if err != nil {
+		return err
+	}
+
+	o, _ := d.GetChange("files")
+	old := flex.ExpandStringValueMap(o.(map[string]interface{}))
+
+	var uploads []directorySyncFile
+
+	for _, v := range files {
+		if all || old[v.key] != v.hash {
+			uploads = append(uploads, v)
+		}
+	}
+
+	log.Printf("[DEBUG] Uploading %d of %d files to S3 Bucket (%s)", len(uploads), len(files), bucket)
+	uploaded, err := directorySyncUpload(ctx, d, conn, uploads)
+
+	if err != nil {
+		// Only record the objects known to be in sync, so that failed uploads are retried on the next apply
+		// and previously uploaded objects remain tracked.
+		hashes := make(map[string]string, len(old)+len(uploaded))
+
+		for k, v := range old {
+			hashes[k] = v
+		}
+
+		for _, v := range uploads {
+			delete(hashes, v.key)
+		}
+
+		for _, v := range uploaded {
+			hashes[v.key] = v.hash
+		}
+
+		d.Set("files", hashes)
+
+		return err
+	}
+
+	deleteStale := d.Get("delete_stale").(bool)
+	hashes := directorySyncHashes(files, old, deleteStale)
+
+	// Only objects recorded in state are deleted, never other objects under the key prefix.
+	if deleteStale {
+		var stale []string
+
+		for k := range old {
+			if _, ok := hashes[k]; !ok {
+				stale = append(stale, k)
+			}
+		}
+
+		sort.Strings(stale)
+
+		log.Printf("[DEBUG] Deleting %d stale objects from S3 Bucket (%s)", len(stale), bucket)
+		if undeleted, err := deleteObjectKeys(ctx, conn, bucket, stale); err != nil {
+			// Keep tracking the stale objects that were not deleted, so that they are deleted on the next apply.
+			for _, k := range undeleted {
+				hashes[k] = old[k]
+			}
+
+			d.Set("files", hashes)
+
+			return err
+		}
+	}
+
+	d.Set("files", hashes)
+
+	return nil
+}
+
+// directorySyncUpload uploads files concurrently, bounded by the configured upload concurrency.
+// The files that were successfully uploaded are returned, even if other uploads failed.
+func directorySyncUpload(ctx context.Context, d *schema.ResourceData, conn *s3.S3, files []directorySyncFile) ([]directorySyncFile, error) {
+	uploader := s3manager.NewUploaderWithClient(conn, func(u *s3manager.Uploader) {
+		if v, ok := d.GetOk("multipart_concurrency"); ok {
+			u.Concurrency = v.(int)
+		}
+
+		if v, ok := d.GetOk("multipart_part_size"); ok {
+			u.PartSize = int64(v.(int))
+		}
+	})
+
+	template := s3manager.UploadInput{
+		Bucket: aws.String(d.Get("bucket").(string)),
+	}
+
+	if v, ok := d.GetOk("cache_control"); ok {
+		template.CacheControl = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("checksum_algorithm"); ok {
+		template.ChecksumAlgorithm = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("server_side_encryption"); ok {
+		template.ServerSideEncryption = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		template.SSEKMSKeyId = aws.String(v.(string))
+		template.ServerSideEncryption = aws.String(s3.ServerSideEncryptionAwsKms)
+	}
+
+	if v, ok := d.GetOk("storage_class"); ok {
+		template.StorageClass = aws.String(v.(string))
+	}
+
+	ch := make(chan directorySyncFile)
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var errs *multierror.Error
+	var uploaded []directorySyncFile
+
+	for i := 0; i < d.Get("upload_concurrency").(int); i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for v := range ch {
+				err := directorySyncUploadFile(ctx, uploader, template, v)
+
+				mu.Lock()
+				if err != nil {
+					errs = multierror.Append(errs, err)
+				} else {
+					uploaded = append(uploaded, v)
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	for _, v := range files {
+		ch <- v
+	}
+
+	close(ch)
+	wg.Wait()
+
+	return uploaded, errs.ErrorOrNil()
+}
+
+func directorySyncUploadFile(ctx context.Context, uploader *s3manager.Uploader, template s3manager.UploadInput, file directorySyncFile) error {
+	body, err := os.Open(file.path)
+
+	if err != nil {
+		return fmt.Errorf("opening %s: %w", file.path, err)
+	}
+
+	defer body.Close()
+
+	input := template
+	input.Body = body
+	input.ContentType = aws.String(file.contentType)
+	input.Key = aws.String(file.key)
+
+	if _, err := uploader.UploadWithContext(ctx, &input); err != nil {
+		return fmt.Errorf("uploading %s to %s: %w", file.path, file.key, err)
+	}
+
+	return nil
+}
+
+// findObjectKeysByPrefix returns the keys of all objects in the specified bucket whose keys start with `prefix`.
+func findObjectKeysByPrefix(ctx context.Context, conn *s3.S3, bucket, prefix string) (map[string]struct{}, error) {
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+	}
+
+	if prefix != "" {
+		input.Prefix = aws.String(prefix)
+	}
+
+	keys := make(map[string]struct{})
+
+	err := conn.ListObjectsV2PagesWithContext(ctx, input, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Contents {
+			if v == nil {
+				continue
+			}
+
+			keys[aws.StringValue(v.Key)] = struct{}{}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return keys, nil
+}
+
+// deleteObjectKeys deletes the current versions of the specified objects in batches.
+// The keys of the objects that were not deleted are returned with the error.
+func deleteObjectKeys(ctx context.Context, conn *s3.S3, bucket string, keys []string) ([]string, error) {
+	var errs *multierror.Error
+	var undeleted []string
+
+	for len(keys) > 0 {
+		n := len(keys)
+
+		if n > directorySyncDeleteBatchSize {
+			n = directorySyncDeleteBatchSize
+		}
+
+		batch := keys[:n]
+		objects := make([]*s3.ObjectIdentifier, 0, n)
+
+		for _, v := range batch {
+			objects = append(objects, &s3.ObjectIdentifier{
+				Key: aws.String(v),
+			})
+		}
+
+		keys = keys[n:]
+
+		output, err := conn.DeleteObjectsWithContext(ctx, &s3.DeleteObjectsInput{
+			Bucket: aws.String(bucket),
+			Delete: &s3.Delete{
+				Objects: objects,
+				Quiet:   aws.Bool(true), // Only report errors.
+			},
+		})
+
+		if err != nil {
+			undeleted = append(undeleted, batch...)
+			undeleted = append(undeleted, keys...)
+
+			return undeleted, err
+		}
+
+		for _, v := range output.Errors {
+			if aws.StringValue(v.Code) == s3.ErrCodeNoSuchKey {
+				continue
+			}
+
+			undeleted = append(undeleted, aws.StringValue(v.Key))
+			errs = multierror.Append(errs, newDeleteObjectVersionError(v))
+		}
+	}
+
+	return undeleted, errs.ErrorOrNil()
+}
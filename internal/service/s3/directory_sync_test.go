@@ -0,0 +1,344 @@
+package s3_test
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestAccS3DirectorySync_basic(t *testing.T) {
+	resourceName := "aws_s3_directory_sync.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	source := t.TempDir()
+
+	testAccDirectorySyncWriteFile(t, source, "index.html", "<html><body>Hello</body></html>")
+	testAccDirectorySyncWriteFile(t, source, "css/site.css", "body { color: red; }")
+	testAccDirectorySyncWriteFile(t, source, "data/blob", "\x00\x01\x02")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectorySyncDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig_basic(rName, source),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "files.%", "3"),
+					resource.TestCheckResourceAttrSet(resourceName, "files.site/index.html"),
+					resource.TestCheckResourceAttrSet(resourceName, "files.site/css/site.css"),
+					resource.TestCheckResourceAttrSet(resourceName, "files.site/data/blob"),
+					testAccCheckDirectorySyncObjectContentType(resourceName, "site/index.html", "text/html; charset=utf-8"),
+					testAccCheckDirectorySyncObjectContentType(resourceName, "site/css/site.css", "text/css; charset=utf-8"),
+					testAccCheckDirectorySyncObjectContentType(resourceName, "site/data/blob", "application/octet-stream"),
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccDirectorySyncWriteFile(t, source, "index.html", "<html><body>Updated</body></html>")
+					testAccDirectorySyncWriteFile(t, source, "app.js", "console.log('hi');")
+
+					if err := os.RemoveAll(filepath.Join(source, "data")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccDirectorySyncConfig_basic(rName, source),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "files.%", "4"),
+					resource.TestCheckResourceAttrSet(resourceName, "files.site/app.js"),
+					// Stale objects are only deleted if delete_stale is set, but remain tracked.
+					resource.TestCheckResourceAttrSet(resourceName, "files.site/data/blob"),
+					testAccCheckDirectorySyncObjectContentType(resourceName, "site/data/blob", "application/octet-stream"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3DirectorySync_deleteStale(t *testing.T) {
+	resourceName := "aws_s3_directory_sync.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	source := t.TempDir()
+
+	testAccDirectorySyncWriteFile(t, source, "index.html", "<html><body>Hello</body></html>")
+	testAccDirectorySyncWriteFile(t, source, "stale.html", "<html><body>Stale</body></html>")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectorySyncDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig_bucket(rName),
+			},
+			{
+				PreConfig: func() {
+					conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+
+					_, err := conn.PutObject(&s3.PutObjectInput{
+						Body:        strings.NewReader("unmanaged"),
+						Bucket:      aws.String(rName),
+						ContentType: aws.String("text/plain"),
+						Key:         aws.String("site/unmanaged.txt"),
+					})
+
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccDirectorySyncConfig_deleteStale(rName, source),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "files.%", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "files.site/stale.html"),
+					resource.TestCheckNoResourceAttr(resourceName, "files.site/unmanaged.txt"),
+				),
+			},
+			{
+				PreConfig: func() {
+					if err := os.Remove(filepath.Join(source, "stale.html")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccDirectorySyncConfig_deleteStale(rName, source),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "files.%", "1"),
+					testAccCheckDirectorySyncObjectNotExists(resourceName, "site/stale.html"),
+					// Objects that weren't uploaded by the resource are never deleted.
+					testAccCheckDirectorySyncObjectContentType(resourceName, "site/unmanaged.txt", "text/plain"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3DirectorySync_removeFileThenDestroy(t *testing.T) {
+	resourceName := "aws_s3_directory_sync.test"
+	bucketResourceName := "aws_s3_bucket.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	source := t.TempDir()
+
+	testAccDirectorySyncWriteFile(t, source, "index.html", "<html><body>Hello</body></html>")
+	testAccDirectorySyncWriteFile(t, source, "stale.html", "<html><body>Stale</body></html>")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectorySyncDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig_basic(rName, source),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "files.%", "2"),
+				),
+			},
+			{
+				PreConfig: func() {
+					if err := os.Remove(filepath.Join(source, "stale.html")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccDirectorySyncConfig_basic(rName, source),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "files.%", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "files.site/stale.html"),
+					testAccCheckDirectorySyncObjectContentType(resourceName, "site/stale.html", "text/html; charset=utf-8"),
+				),
+			},
+			{
+				// Destroy the directory sync but keep the bucket, so that its objects can be checked.
+				Config: testAccDirectorySyncConfig_bucket(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDirectorySyncObjectNotExists(bucketResourceName, "site/index.html"),
+					testAccCheckDirectorySyncObjectNotExists(bucketResourceName, "site/stale.html"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3DirectorySync_keyPrefix(t *testing.T) {
+	resourceName := "aws_s3_directory_sync.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	source := t.TempDir()
+
+	testAccDirectorySyncWriteFile(t, source, "index.html", "<html><body>Hello</body></html>")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectorySyncDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig_keyPrefix(rName, source, "site"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "key_prefix", "site/"),
+					resource.TestCheckResourceAttrSet(resourceName, "files.site/index.html"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDirectorySyncDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_s3_directory_sync" {
+			continue
+		}
+
+		output, err := conn.ListObjectsV2(&s3.ListObjectsV2Input{
+			Bucket: aws.String(rs.Primary.Attributes["bucket"]),
+			Prefix: aws.String(rs.Primary.Attributes["key_prefix"]),
+		})
+
+		if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if n := len(output.Contents); n > 0 {
+			return fmt.Errorf("S3 Directory Sync %s still has %d objects", rs.Primary.ID, n)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckDirectorySyncObjectContentType(n, key, want string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+
+		output, err := conn.HeadObject(&s3.HeadObjectInput{
+			Bucket: aws.String(rs.Primary.Attributes["bucket"]),
+			Key:    aws.String(key),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if got := aws.StringValue(output.ContentType); got != want {
+			return fmt.Errorf("S3 Object (%s) content type = %q, want %q", key, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDirectorySyncObjectNotExists(n, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+
+		_, err := conn.HeadObject(&s3.HeadObjectInput{
+			Bucket: aws.String(rs.Primary.Attributes["bucket"]),
+			Key:    aws.String(key),
+		})
+
+		if tfawserr.ErrStatusCodeEquals(err, http.StatusNotFound) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("S3 Object (%s) still exists", key)
+	}
+}
+
+func testAccDirectorySyncWriteFile(t *testing.T, dir, name, content string) {
+	t.Helper()
+
+	p := filepath.Join(dir, filepath.FromSlash(name))
+
+	if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(p, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testAccDirectorySyncConfig_basic(rName, source string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_directory_sync" "test" {
+  bucket     = aws_s3_bucket.test.bucket
+  key_prefix = "site/"
+  source     = %[2]q
+}
+`, rName, source)
+}
+
+func testAccDirectorySyncConfig_deleteStale(rName, source string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_directory_sync" "test" {
+  bucket       = aws_s3_bucket.test.bucket
+  delete_stale = true
+  key_prefix   = "site/"
+  source       = %[2]q
+}
+`, rName, source)
+}
+
+func testAccDirectorySyncConfig_keyPrefix(rName, source, keyPrefix string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_directory_sync" "test" {
+  bucket     = aws_s3_bucket.test.bucket
+  key_prefix = %[3]q
+  source     = %[2]q
+}
+`, rName, source, keyPrefix)
+}
+
+func testAccDirectorySyncConfig_bucket(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+`, rName)
+}
@@ -3,6 +3,8 @@ package s3
 import (
 	"fmt"
 	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/importid"
 )
 
 const resourceIDSeparator = ","
@@ -39,3 +41,17 @@ func ParseResourceID(id string) (bucket, expectedBucketOwner string, err error)
 	err = fmt.Errorf("unexpected format for ID (%s), expected BUCKET or BUCKET%sEXPECTED_BUCKET_OWNER", id, resourceIDSeparator)
 	return
 }
+
+// Object keys contain the separator. The bucket may be prefixed with "s3://".
+var objectImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "bucket"},
+		{Name: "key", Greedy: true},
+	},
+	Separator: "/",
+}
+
+func init() {
+	importid.Register("aws_s3_object", objectImportIDFormat)
+	importid.Register("aws_s3_bucket_object", objectImportIDFormat)
+}
@@ -165,10 +165,10 @@ func resourceEndpointDelete(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceEndpointImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	idParts := strings.Split(d.Id(), ",")
+	idParts, err := endpointImportIDFormat.Parse(d.Id())
 
-	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
-		return nil, fmt.Errorf("unexpected format of ID (%s), expected ENDPOINT-ARN,SECURITY-GROUP-ID,SUBNET-ID", d.Id())
+	if err != nil {
+		return nil, err
 	}
 
 	endpointArn := idParts[0]
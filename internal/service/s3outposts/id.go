@@ -0,0 +1,18 @@
+package s3outposts
+
+import (
+	"github.com/hashicorp/terraform-provider-aws/internal/importid"
+)
+
+var endpointImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "ENDPOINT-ARN"},
+		{Name: "SECURITY-GROUP-ID"},
+		{Name: "SUBNET-ID"},
+	},
+	Separator: ",",
+}
+
+func init() {
+	importid.Register("aws_s3outposts_endpoint", endpointImportIDFormat)
+}
@@ -0,0 +1,26 @@
+package servicediscovery
+
+import (
+	"github.com/hashicorp/terraform-provider-aws/internal/importid"
+)
+
+var privateDNSNamespaceImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "NAMESPACE_ID"},
+		{Name: "VPC_ID"},
+	},
+	Separator: ":",
+}
+
+var instanceImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "service-id"},
+		{Name: "instance-id", Greedy: true},
+	},
+	Separator: "/",
+}
+
+func init() {
+	importid.Register("aws_service_discovery_private_dns_namespace", privateDNSNamespaceImportIDFormat)
+	importid.Register("aws_service_discovery_instance", instanceImportIDFormat)
+}
@@ -2,10 +2,8 @@ package servicediscovery
 
 import (
 	"context"
-	"fmt"
 	"log"
 	"regexp"
-	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/servicediscovery"
@@ -131,9 +129,9 @@ func resourceInstanceDelete(ctx context.Context, d *schema.ResourceData, meta in
 }
 
 func resourceInstanceImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	parts := strings.SplitN(d.Id(), "/", 2)
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		return nil, fmt.Errorf("unexpected format (%q), expected <service-id>/<instance-id>", d.Id())
+	parts, err := instanceImportIDFormat.Parse(d.Id())
+	if err != nil {
+		return nil, err
 	}
 
 	instanceID := parts[1]
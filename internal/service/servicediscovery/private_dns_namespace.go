@@ -2,9 +2,7 @@ package servicediscovery
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/servicediscovery"
@@ -26,9 +24,9 @@ func ResourcePrivateDNSNamespace() *schema.Resource {
 
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-				idParts := strings.Split(d.Id(), ":")
-				if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-					return nil, fmt.Errorf("Unexpected format of ID (%q), expected NAMESPACE_ID:VPC_ID", d.Id())
+				idParts, err := privateDNSNamespaceImportIDFormat.Parse(d.Id())
+				if err != nil {
+					return nil, err
 				}
 				d.SetId(idParts[0])
 				d.Set("vpc", idParts[1])
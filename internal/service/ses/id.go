@@ -0,0 +1,26 @@
+package ses
+
+import (
+	"github.com/hashicorp/terraform-provider-aws/internal/importid"
+)
+
+var eventDestinationImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "configuration-set-name"},
+		{Name: "event-destination-name"},
+	},
+	Separator: "/",
+}
+
+var receiptRuleImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "ruleset-name"},
+		{Name: "rule-name"},
+	},
+	Separator: ":",
+}
+
+func init() {
+	importid.Register("aws_ses_event_destination", eventDestinationImportIDFormat)
+	importid.Register("aws_ses_receipt_rule", receiptRuleImportIDFormat)
+}
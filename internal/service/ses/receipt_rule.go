@@ -6,7 +6,6 @@ import (
 	"log"
 	"regexp"
 	"sort"
-	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
@@ -398,9 +397,9 @@ func ResourceReceiptRule() *schema.Resource {
 }
 
 func resourceReceiptRuleImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	idParts := strings.Split(d.Id(), ":")
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		return nil, fmt.Errorf("unexpected format of ID (%q), expected <ruleset-name>:<rule-name>", d.Id())
+	idParts, err := receiptRuleImportIDFormat.Parse(d.Id())
+	if err != nil {
+		return nil, err
 	}
 
 	ruleSetName := idParts[0]
@@ -0,0 +1,17 @@
+package signer
+
+import (
+	"github.com/hashicorp/terraform-provider-aws/internal/importid"
+)
+
+var signingProfilePermissionImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "PROFILE_NAME"},
+		{Name: "STATEMENT_ID"},
+	},
+	Separator: "/",
+}
+
+func init() {
+	importid.Register("aws_signer_signing_profile_permission", signingProfilePermissionImportIDFormat)
+}
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"log"
 	"regexp"
-	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/signer"
@@ -310,9 +309,9 @@ func resourceSigningProfilePermissionDelete(d *schema.ResourceData, meta interfa
 }
 
 func resourceSigningProfilePermissionImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	idParts := strings.Split(d.Id(), "/")
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		return nil, fmt.Errorf("unexpected format of ID (%q), expected PROFILE_NAME/STATEMENT_ID", d.Id())
+	idParts, err := signingProfilePermissionImportIDFormat.Parse(d.Id())
+	if err != nil {
+		return nil, err
 	}
 
 	profileName := idParts[0]
@@ -0,0 +1,26 @@
+package ssm
+
+import (
+	"github.com/hashicorp/terraform-provider-aws/internal/importid"
+)
+
+var maintenanceWindowTaskImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "window-id"},
+		{Name: "window-task-id", Greedy: true},
+	},
+	Separator: "/",
+}
+
+var maintenanceWindowTargetImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "WINDOW_ID"},
+		{Name: "WINDOW_TARGET_ID"},
+	},
+	Separator: "/",
+}
+
+func init() {
+	importid.Register("aws_ssm_maintenance_window_task", maintenanceWindowTaskImportIDFormat)
+	importid.Register("aws_ssm_maintenance_window_target", maintenanceWindowTargetImportIDFormat)
+}
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"log"
 	"regexp"
-	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
@@ -22,9 +21,9 @@ func ResourceMaintenanceWindowTarget() *schema.Resource {
 		Delete: resourceMaintenanceWindowTargetDelete,
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-				idParts := strings.Split(d.Id(), "/")
-				if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-					return nil, fmt.Errorf("Unexpected format of ID (%q), expected WINDOW_ID/WINDOW_TARGET_ID", d.Id())
+				idParts, err := maintenanceWindowTargetImportIDFormat.Parse(d.Id())
+				if err != nil {
+					return nil, err
 				}
 				d.Set("window_id", idParts[0])
 				d.SetId(idParts[1])
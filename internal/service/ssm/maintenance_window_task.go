@@ -5,7 +5,6 @@ import (
 	"log"
 	"regexp"
 	"sort"
-	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
@@ -854,9 +853,9 @@ func resourceMaintenanceWindowTaskDelete(d *schema.ResourceData, meta interface{
 }
 
 func resourceMaintenanceWindowTaskImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	idParts := strings.SplitN(d.Id(), "/", 2)
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		return nil, fmt.Errorf("unexpected format of ID (%q), expected <window-id>/<window-task-id>", d.Id())
+	idParts, err := maintenanceWindowTaskImportIDFormat.Parse(d.Id())
+	if err != nil {
+		return nil, err
 	}
 
 	windowID := idParts[0]
@@ -0,0 +1,48 @@
+package wafv2
+
+import (
+	"github.com/hashicorp/terraform-provider-aws/internal/importid"
+)
+
+var regexPatternSetImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "ID"},
+		{Name: "NAME"},
+		{Name: "SCOPE"},
+	},
+	Separator: "/",
+}
+
+var ipSetImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "ID"},
+		{Name: "NAME"},
+		{Name: "SCOPE"},
+	},
+	Separator: "/",
+}
+
+var ruleGroupImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "ID"},
+		{Name: "NAME"},
+		{Name: "SCOPE"},
+	},
+	Separator: "/",
+}
+
+var webACLImportIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "ID"},
+		{Name: "NAME"},
+		{Name: "SCOPE"},
+	},
+	Separator: "/",
+}
+
+func init() {
+	importid.Register("aws_wafv2_regex_pattern_set", regexPatternSetImportIDFormat)
+	importid.Register("aws_wafv2_ip_set", ipSetImportIDFormat)
+	importid.Register("aws_wafv2_rule_group", ruleGroupImportIDFormat)
+	importid.Register("aws_wafv2_web_acl", webACLImportIDFormat)
+}
@@ -2,9 +2,7 @@ package wafv2
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -34,9 +32,9 @@ func ResourceIPSet() *schema.Resource {
 
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-				idParts := strings.Split(d.Id(), "/")
-				if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
-					return nil, fmt.Errorf("Unexpected format of ID (%q), expected ID/NAME/SCOPE", d.Id())
+				idParts, err := ipSetImportIDFormat.Parse(d.Id())
+				if err != nil {
+					return nil, err
 				}
 				id := idParts[0]
 				name := idParts[1]
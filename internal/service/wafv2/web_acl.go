@@ -2,10 +2,8 @@ package wafv2
 
 import (
 	"context"
-	"fmt"
 	"log"
 	"regexp"
-	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -36,9 +34,9 @@ func ResourceWebACL() *schema.Resource {
 
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-				idParts := strings.Split(d.Id(), "/")
-				if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
-					return nil, fmt.Errorf("Unexpected format of ID (%q), expected ID/NAME/SCOPE", d.Id())
+				idParts, err := webACLImportIDFormat.Parse(d.Id())
+				if err != nil {
+					return nil, err
 				}
 				id := idParts[0]
 				name := idParts[1]
@@ -7,6 +7,13 @@ import (
 
 // Terraform Plugin Framework variants of tags schemas.
 
+func TagsAttribute() tfsdk.Attribute {
+	return tfsdk.Attribute{
+		Type:     types.MapType{ElemType: types.StringType},
+		Optional: true,
+	}
+}
+
 func TagsAttributeComputed() tfsdk.Attribute {
 	return tfsdk.Attribute{
 		Type:     types.MapType{ElemType: types.StringType},
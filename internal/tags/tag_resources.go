@@ -1,21 +1,25 @@
 package tags
 
 import (
-	"fmt"
-	"strings"
+	"github.com/hashicorp/terraform-provider-aws/internal/importid"
 )
 
-const (
-	// Separator used in resource identifiers
-	resourceIDSeparator = `,`
-)
+// ResourceIDFormat is the format of tag resource identifiers.
+// The tag key may contain the separator.
+var ResourceIDFormat = importid.Format{
+	Parts: []importid.Part{
+		{Name: "ID"},
+		{Name: "KEY", Greedy: true},
+	},
+	Separator: ",",
+}
 
 // GetResourceID parses a given resource identifier for tag identifier and tag key.
 func GetResourceID(resourceID string) (string, string, error) {
-	parts := strings.SplitN(resourceID, resourceIDSeparator, 2)
+	parts, err := ResourceIDFormat.Parse(resourceID)
 
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		return "", "", fmt.Errorf("invalid resource identifier (%[1]s), expected ID%[2]sKEY", resourceID, resourceIDSeparator)
+	if err != nil {
+		return "", "", err
 	}
 
 	return parts[0], parts[1], nil
@@ -23,8 +27,5 @@ func GetResourceID(resourceID string) (string, string, error) {
 
 // SetResourceID creates a resource identifier given a tag identifier and a tag key.
 func SetResourceID(identifier string, key string) string {
-	parts := []string{identifier, key}
-	resourceID := strings.Join(parts, resourceIDSeparator)
-
-	return resourceID
+	return ResourceIDFormat.Create(identifier, key)
 }
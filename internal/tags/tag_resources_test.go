@@ -16,28 +16,28 @@ func TestGetResourceID(t *testing.T) {
 			Description:        "empty resource identifier",
 			ResourceIdentifier: "",
 			ExpectedError: func(err error) bool {
-				return err.Error() == "invalid resource identifier (), expected ID,KEY"
+				return err.Error() == "unexpected format for ID (), expected ID,KEY"
 			},
 		},
 		{
 			Description:        "missing identifier",
 			ResourceIdentifier: ",testkey",
 			ExpectedError: func(err error) bool {
-				return err.Error() == "invalid resource identifier (,testkey), expected ID,KEY"
+				return err.Error() == "unexpected format for ID (,testkey), expected ID,KEY"
 			},
 		},
 		{
 			Description:        "missing key",
 			ResourceIdentifier: "testidentifier,",
 			ExpectedError: func(err error) bool {
-				return err.Error() == "invalid resource identifier (testidentifier,), expected ID,KEY"
+				return err.Error() == "unexpected format for ID (testidentifier,), expected ID,KEY"
 			},
 		},
 		{
 			Description:        "incorrect separator",
 			ResourceIdentifier: "testidentifier;testkey",
 			ExpectedError: func(err error) bool {
-				return err.Error() == "invalid resource identifier (testidentifier;testkey), expected ID,KEY"
+				return err.Error() == "unexpected format for ID (testidentifier;testkey), expected ID,KEY"
 			},
 		},
 		{
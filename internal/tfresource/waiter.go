@@ -0,0 +1,242 @@
+package tfresource
+
+import (
+	"context"
+	"errors"
+	"log"
+	"math/rand"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	defaultWaiterMinPollInterval  = 5 * time.Second
+	defaultWaiterMaxPollInterval  = 1 * time.Minute
+	defaultWaiterNotFoundChecks   = 20
+	defaultWaiterProgressInterval = 1 * time.Minute
+)
+
+var errPollDeadlineExceeded = errors.New("poll deadline exceeded")
+
+// WaiterOpts configures Wait.
+type WaiterOpts struct {
+	Description string   // Describes the operation being waited on in progress logs, e.g. "RDS DB Instance (db-1) modifying".
+	Pending     []string // Statuses that are waited through.
+	Target      []string // Statuses that complete the wait. An empty Target waits for the resource to be not found.
+
+	ContinuousTargetOccurence int           // Number of times the target status has to occur continuously.
+	Delay                     time.Duration // Wait this time before the first poll.
+	MaxPollInterval           time.Duration // Upper bound of the interval between polls. Defaults to 1m.
+	MinPollInterval           time.Duration // Initial interval between polls, doubled after each poll. Defaults to 5s.
+	NotFoundChecks            int           // Number of consecutive polls that may find no resource while waiting for Target. Defaults to 20.
+	PollTimeout               time.Duration // Deadline for each poll. A poll exceeding it is canceled and retried.
+	ProgressInterval          time.Duration // Interval between progress logs. Defaults to 1m.
+	Timeout                   time.Duration // Overall timeout.
+}
+
+func (o *WaiterOpts) setDefaults() {
+	if o.ContinuousTargetOccurence < 1 {
+		o.ContinuousTargetOccurence = 1
+	}
+
+	if o.MinPollInterval <= 0 {
+		o.MinPollInterval = defaultWaiterMinPollInterval
+	}
+
+	if o.MaxPollInterval < o.MinPollInterval {
+		o.MaxPollInterval = defaultWaiterMaxPollInterval
+
+		if o.MaxPollInterval < o.MinPollInterval {
+			o.MaxPollInterval = o.MinPollInterval
+		}
+	}
+
+	if o.NotFoundChecks <= 0 {
+		o.NotFoundChecks = defaultWaiterNotFoundChecks
+	}
+
+	if o.ProgressInterval <= 0 {
+		o.ProgressInterval = defaultWaiterProgressInterval
+	}
+}
+
+// Wait calls `refresh` until it returns one of the target statuses, returning the last result.
+// Each call's context is canceled when the wait ends or the call exceeds the poll timeout.
+// An empty status indicates that the resource was not found.
+// If `refresh` returns an error, return immediately with that error.
+// If `refresh` returns a status that is neither pending nor a target, return a *resource.UnexpectedStateError.
+// If the timeout is exceeded, return a *resource.TimeoutError recording the last status.
+// Waits between calls to `refresh` using jittered exponential backoff and logs progress periodically.
+func Wait[T any](ctx context.Context, refresh func(context.Context) (T, string, error), opts WaiterOpts) (T, error) {
+	opts.setDefaults()
+
+	parentCtx := ctx
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+
+	var result T
+	var lastStatus string
+	var notFoundTicks, targetOccurence int
+
+	start := time.Now()
+	lastProgress := start
+	interval := opts.MinPollInterval
+
+	timeoutError := func() error {
+		if err := parentCtx.Err(); err != nil {
+			return err
+		}
+
+		return &resource.TimeoutError{
+			LastState:     lastStatus,
+			Timeout:       opts.Timeout,
+			ExpectedState: opts.Target,
+		}
+	}
+
+	if !sleepContext(ctx, opts.Delay) {
+		return result, timeoutError()
+	}
+
+	for {
+		v, status, err := pollWithDeadline(ctx, refresh, opts.PollTimeout)
+
+		switch {
+		case errors.Is(err, errPollDeadlineExceeded):
+			log.Printf("[WARN] %s: poll exceeded %s, retrying", opts.Description, opts.PollTimeout)
+
+		case err != nil:
+			if ctx.Err() != nil {
+				return result, timeoutError()
+			}
+
+			return v, err
+
+		case status == "":
+			result = v
+
+			if len(opts.Target) == 0 {
+				targetOccurence++
+
+				if targetOccurence >= opts.ContinuousTargetOccurence {
+					return result, nil
+				}
+
+				break
+			}
+
+			targetOccurence = 0
+			notFoundTicks++
+
+			if notFoundTicks > opts.NotFoundChecks {
+				return result, &resource.NotFoundError{
+					LastError: errors.New("couldn't find resource"),
+					Retries:   notFoundTicks,
+				}
+			}
+
+		default:
+			result = v
+			notFoundTicks = 0
+
+			if status != lastStatus {
+				log.Printf("[DEBUG] %s: elapsed=%s status=%s", opts.Description, time.Since(start).Round(time.Second), status)
+			}
+
+			lastStatus = status
+
+			if slices.Contains(opts.Target, status) {
+				targetOccurence++
+
+				if targetOccurence >= opts.ContinuousTargetOccurence {
+					return result, nil
+				}
+
+				// Check again soon for the target status to reoccur.
+				interval = opts.MinPollInterval
+
+				break
+			}
+
+			if !slices.Contains(opts.Pending, status) {
+				return result, &resource.UnexpectedStateError{
+					State:         status,
+					ExpectedState: opts.Target,
+				}
+			}
+
+			targetOccurence = 0
+		}
+
+		if elapsed := time.Since(start); time.Since(lastProgress) >= opts.ProgressInterval {
+			log.Printf("[INFO] %s, %s elapsed, status=%s", opts.Description, elapsed.Round(time.Second), lastStatus)
+			lastProgress = time.Now()
+		}
+
+		if !sleepContext(ctx, jitter(interval)) {
+			return result, timeoutError()
+		}
+
+		if targetOccurence == 0 {
+			interval *= 2
+
+			if interval > opts.MaxPollInterval {
+				interval = opts.MaxPollInterval
+			}
+		}
+	}
+}
+
+// pollWithDeadline calls `refresh` with a context that is canceled after `timeout`.
+// If `refresh` fails once the deadline is exceeded, errPollDeadlineExceeded is returned.
+func pollWithDeadline[T any](ctx context.Context, refresh func(context.Context) (T, string, error), timeout time.Duration) (T, string, error) {
+	if timeout <= 0 {
+		return refresh(ctx)
+	}
+
+	pollCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	v, status, err := refresh(pollCtx)
+
+	if err != nil && ctx.Err() == nil && errors.Is(pollCtx.Err(), context.DeadlineExceeded) {
+		var zero T
+
+		return zero, "", errPollDeadlineExceeded
+	}
+
+	return v, status, err
+}
+
+// jitter returns a random duration between half of and the full duration `d`.
+func jitter(d time.Duration) time.Duration {
+	if d <= 1 {
+		return d
+	}
+
+	half := d / 2
+
+	return half + time.Duration(rand.Int63n(int64(half)))
+}
+
+// sleepContext sleeps for duration `d`, returning false if the context is done first.
+func sleepContext(ctx context.Context, d time.Duration) bool {
+	if d <= 0 {
+		return ctx.Err() == nil
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
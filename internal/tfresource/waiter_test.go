@@ -0,0 +1,175 @@
+package tfresource_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestWait(t *testing.T) {
+	t.Parallel()
+
+	statuses := func(s ...string) func(context.Context) (int, string, error) {
+		i := 0
+
+		return func(context.Context) (int, string, error) {
+			status := s[len(s)-1]
+			if i < len(s) {
+				status = s[i]
+			}
+			i++
+
+			return i, status, nil
+		}
+	}
+
+	testCases := []struct {
+		Name          string
+		Refresh       func(context.Context) (int, string, error)
+		Opts          tfresource.WaiterOpts
+		Expected      int
+		ExpectedError func(error) bool
+	}{
+		{
+			Name:     "immediate target",
+			Refresh:  statuses("available"),
+			Opts:     tfresource.WaiterOpts{Pending: []string{"creating"}, Target: []string{"available"}},
+			Expected: 1,
+		},
+		{
+			Name:     "pending then target",
+			Refresh:  statuses("creating", "backing-up", "available"),
+			Opts:     tfresource.WaiterOpts{Pending: []string{"creating", "backing-up"}, Target: []string{"available"}},
+			Expected: 3,
+		},
+		{
+			Name:     "continuous target occurence",
+			Refresh:  statuses("available", "modifying", "available"),
+			Opts:     tfresource.WaiterOpts{Pending: []string{"modifying"}, Target: []string{"available"}, ContinuousTargetOccurence: 2},
+			Expected: 4,
+		},
+		{
+			Name:     "not found",
+			Refresh:  statuses("deleting", ""),
+			Opts:     tfresource.WaiterOpts{Pending: []string{"deleting"}},
+			Expected: 2,
+		},
+		{
+			Name:    "not found checks exceeded",
+			Refresh: statuses(""),
+			Opts:    tfresource.WaiterOpts{Pending: []string{"creating"}, Target: []string{"available"}, NotFoundChecks: 2},
+			ExpectedError: func(err error) bool {
+				return tfresource.NotFound(err)
+			},
+		},
+		{
+			Name:    "unexpected status",
+			Refresh: statuses("creating", "failed"),
+			Opts:    tfresource.WaiterOpts{Pending: []string{"creating"}, Target: []string{"available"}},
+			ExpectedError: func(err error) bool {
+				var e *resource.UnexpectedStateError
+				return errors.As(err, &e) && e.State == "failed"
+			},
+		},
+		{
+			Name: "refresh error",
+			Refresh: func(context.Context) (int, string, error) {
+				return 0, "", errors.New("test error")
+			},
+			Opts: tfresource.WaiterOpts{Pending: []string{"creating"}, Target: []string{"available"}},
+			ExpectedError: func(err error) bool {
+				return err.Error() == "test error"
+			},
+		},
+		{
+			Name:    "timeout",
+			Refresh: statuses("creating", "backing-up"),
+			Opts:    tfresource.WaiterOpts{Pending: []string{"creating", "backing-up"}, Target: []string{"available"}, Timeout: 100 * time.Millisecond},
+			ExpectedError: func(err error) bool {
+				return tfresource.TimedOut(err) && strings.Contains(err.Error(), "last state: 'backing-up'")
+			},
+		},
+		{
+			Name: "poll timeout",
+			Refresh: func(ctx context.Context) (int, string, error) {
+				select {
+				case <-time.After(time.Second):
+					return 1, "available", nil
+				case <-ctx.Done():
+					return 0, "", ctx.Err()
+				}
+			},
+			Opts: tfresource.WaiterOpts{Pending: []string{"creating"}, Target: []string{"available"}, PollTimeout: 10 * time.Millisecond, Timeout: 100 * time.Millisecond},
+			ExpectedError: func(err error) bool {
+				return tfresource.TimedOut(err)
+			},
+		},
+		{
+			Name:     "poll timeout retried",
+			Refresh:  slowFirstPoll(),
+			Opts:     tfresource.WaiterOpts{Pending: []string{"creating"}, Target: []string{"available"}, PollTimeout: 10 * time.Millisecond},
+			Expected: 2,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			opts := testCase.Opts
+			opts.Description = testCase.Name
+			opts.MinPollInterval = time.Millisecond
+			opts.MaxPollInterval = 5 * time.Millisecond
+
+			if opts.Timeout == 0 {
+				opts.Timeout = 5 * time.Second
+			}
+
+			got, err := tfresource.Wait(context.Background(), testCase.Refresh, opts)
+
+			if testCase.ExpectedError != nil {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+
+				if !testCase.ExpectedError(err) {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("got %d, expected %d", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+// slowFirstPoll returns a refresh function whose first call blocks until its context is canceled.
+func slowFirstPoll() func(context.Context) (int, string, error) {
+	i := 0
+
+	return func(ctx context.Context) (int, string, error) {
+		i++
+
+		if i == 1 {
+			<-ctx.Done()
+
+			return 0, "", ctx.Err()
+		}
+
+		return i, "available", nil
+	}
+}
@@ -41,6 +41,8 @@ const (
 type ServiceDatum struct {
 	Aliases            []string
 	Brand              string
+	ClientSDKV1        bool
+	ClientSDKV2        bool
 	DeprecatedEnvVar   string
 	EnvVar             string
 	GoV1ClientTypeName string
@@ -97,6 +99,8 @@ func readCSVIntoServiceData() error {
 
 		serviceData[p] = &ServiceDatum{
 			Brand:              l[ColBrand],
+			ClientSDKV1:        l[ColClientSDKV1] != "",
+			ClientSDKV2:        l[ColClientSDKV2] != "",
 			DeprecatedEnvVar:   l[ColDeprecatedEnvVar],
 			EnvVar:             l[ColEnvVar],
 			GoV1ClientTypeName: l[ColGoV1ClientTypeName],
@@ -231,6 +235,28 @@ func AWSGoV2Package(providerPackage string) (string, error) {
 	return "", fmt.Errorf("getting AWS SDK Go v2 package, %s not found", providerPackage)
 }
 
+// AWSGoSDKVersions returns the AWS SDK for Go major versions used by the
+// specified provider package's clients.
+func AWSGoSDKVersions(providerPackage string) ([]int, error) {
+	v, ok := serviceData[providerPackage]
+
+	if !ok {
+		return nil, fmt.Errorf("getting AWS SDK Go versions, %s not found", providerPackage)
+	}
+
+	var versions []int
+
+	if v.ClientSDKV1 {
+		versions = append(versions, 1)
+	}
+
+	if v.ClientSDKV2 {
+		versions = append(versions, 2)
+	}
+
+	return versions, nil
+}
+
 func AWSGoClientTypeName(providerPackage string, version int) (string, error) {
 	switch version {
 	case 1:
@@ -468,3 +468,61 @@ func TestAWSGoV1ClientName(t *testing.T) {
 		})
 	}
 }
+
+func TestAWSGoSDKVersions(t *testing.T) {
+	testCases := []struct {
+		TestName string
+		Input    string
+		Expected []int
+		Error    bool
+	}{
+		{
+			TestName: "empty",
+			Input:    "",
+			Expected: nil,
+			Error:    true,
+		},
+		{
+			TestName: "v1",
+			Input:    Translate,
+			Expected: []int{1},
+			Error:    false,
+		},
+		{
+			TestName: "v2",
+			Input:    SESV2,
+			Expected: []int{2},
+			Error:    false,
+		},
+		{
+			TestName: "v1 and v2",
+			Input:    S3Control,
+			Expected: []int{1, 2},
+			Error:    false,
+		},
+		{
+			TestName: "doesnotexist",
+			Input:    "doesnotexist",
+			Expected: nil,
+			Error:    true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got, err := AWSGoSDKVersions(testCase.Input)
+
+			if err != nil && !testCase.Error {
+				t.Errorf("got error (%s), expected no error", err)
+			}
+
+			if err == nil && testCase.Error {
+				t.Errorf("got (%v) and no error, expected error", got)
+			}
+
+			if fmt.Sprint(got) != fmt.Sprint(testCase.Expected) {
+				t.Errorf("got %v, expected %v", got, testCase.Expected)
+			}
+		})
+	}
+}
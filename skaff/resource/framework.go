@@ -0,0 +1,270 @@
+package resource
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+//go:embed resourcefw.tmpl
+var resourceFrameworkTmpl string
+
+//go:embed resourcefwtest.tmpl
+var resourceFrameworkTestTmpl string
+
+//go:embed sweepfw.tmpl
+var sweepFrameworkTmpl string
+
+const (
+	generateDirectiveServicePackageData = "//go:generate go run ../../generate/servicepackagedata/main.go"
+	generateDirectiveTags               = "//go:generate go run ../../generate/tags/main.go"
+	generateFileComment                 = "// ONLY generate directives and package declaration! Do not add anything else to this file."
+)
+
+// createFramework writes a Terraform Plugin Framework resource along with its
+// acceptance tests and documentation, and wires the resource into the service
+// package's sweeper, go:generate directives and test exports.
+func createFramework(snakeName string, force bool, td TemplateData) error {
+	f := fmt.Sprintf("%s.go", snakeName)
+	if err := writeTemplate("newres", f, resourceFrameworkTmpl, force, td); err != nil {
+		return fmt.Errorf("writing resource template: %w", err)
+	}
+
+	tf := fmt.Sprintf("%s_test.go", snakeName)
+	if err := writeTemplate("restest", tf, resourceFrameworkTestTmpl, force, td); err != nil {
+		return fmt.Errorf("writing resource test template: %w", err)
+	}
+
+	wf := fmt.Sprintf("%s_%s.html.markdown", td.ServicePackage, snakeName)
+	wf = filepath.Join("..", "..", "..", "website", "docs", "r", wf)
+	if err := writeTemplate("webdoc", wf, websiteTmpl, force, td); err != nil {
+		return fmt.Errorf("writing resource website doc template: %w", err)
+	}
+
+	sweeper, err := executeTemplate("sweep", sweepFrameworkTmpl, td)
+	if err != nil {
+		return fmt.Errorf("executing sweeper template: %w", err)
+	}
+
+	err = updateFile("sweep.go", func(contents string) (string, error) {
+		if contents == "" {
+			contents = sweepFileHeader(td)
+		}
+
+		return addSweeper(contents, sweeperRegistration(td), sweeper)
+	})
+	if err != nil {
+		return fmt.Errorf("adding sweeper: %w", err)
+	}
+
+	err = updateFile("generate.go", func(contents string) (string, error) {
+		if contents == "" {
+			contents = fmt.Sprintf("%s\n\npackage %s\n", generateFileComment, td.ServicePackage)
+		}
+
+		return addGenerateDirectives(contents, tagsGenerateDirective(td), generateDirectiveServicePackageData), nil
+	})
+	if err != nil {
+		return fmt.Errorf("adding generate directives: %w", err)
+	}
+
+	err = updateFile("exports_test.go", func(contents string) (string, error) {
+		return addTestExport(contents, td.ServicePackage, fmt.Sprintf("Resource%s", td.Resource), fmt.Sprintf("newResource%s", td.Resource)), nil
+	})
+	if err != nil {
+		return fmt.Errorf("adding test exports: %w", err)
+	}
+
+	return nil
+}
+
+// frameworkAWSGoSDKV2 reports whether a Plugin Framework resource in the
+// service package uses the AWS SDK for Go v2, based on the clients listed for
+// the service in names_data.csv. v2 is preferred unless v1 is requested.
+func frameworkAWSGoSDKV2(servicePackage string, v1 bool) (bool, error) {
+	versions, err := names.AWSGoSDKVersions(servicePackage)
+	if err != nil {
+		return false, err
+	}
+
+	var hasV1, hasV2 bool
+	for _, v := range versions {
+		switch v {
+		case 1:
+			hasV1 = true
+		case 2:
+			hasV2 = true
+		}
+	}
+
+	switch {
+	case v1 && !hasV1:
+		return false, fmt.Errorf("%s has no AWS SDK for Go v1 client", servicePackage)
+	case v1:
+		return false, nil
+	case hasV2:
+		return true, nil
+	case hasV1:
+		return false, nil
+	default:
+		return false, fmt.Errorf("%s has no AWS SDK for Go client", servicePackage)
+	}
+}
+
+// updateFile applies update to the contents of filename, creating the file
+// if it does not exist. A missing file is passed to update as "".
+func updateFile(filename string, update func(string) (string, error)) error {
+	b, err := os.ReadFile(filename)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("error reading file (%s): %s", filename, err)
+	}
+
+	contents, err := update(string(b))
+	if err != nil {
+		return fmt.Errorf("error updating file (%s): %w", filename, err)
+	}
+
+	if err := os.WriteFile(filename, []byte(contents), 0644); err != nil {
+		return fmt.Errorf("error writing file (%s): %s", filename, err)
+	}
+
+	return nil
+}
+
+func tagsGenerateDirective(td TemplateData) string {
+	if td.AWSGoSDKV2 {
+		return fmt.Sprintf("%s -AWSSDKVersion=2 -ListTags -ServiceTagsMap -UpdateTags", generateDirectiveTags)
+	}
+
+	return fmt.Sprintf("%s -ListTags -ServiceTagsMap -UpdateTags", generateDirectiveTags)
+}
+
+// addGenerateDirectives adds each directive to the contents of a generate.go
+// file unless an equivalent directive is already present. Directives for the
+// same generator (e.g., tags) with different flags are considered equivalent.
+func addGenerateDirectives(contents string, directives ...string) string {
+	lines := strings.Split(contents, "\n")
+
+	var missing []string
+
+	for _, directive := range directives {
+		generator := directive
+		if i := strings.Index(directive, "/main.go"); i >= 0 {
+			generator = directive[:i+len("/main.go")]
+		}
+
+		found := false
+		for _, line := range lines {
+			if strings.HasPrefix(line, generator) {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			missing = append(missing, directive)
+		}
+	}
+
+	if len(missing) == 0 {
+		return contents
+	}
+
+	// Insert after any existing directives.
+	i := 0
+	for i < len(lines) && strings.HasPrefix(lines[i], "//go:generate ") {
+		i++
+	}
+
+	result := make([]string, 0, len(lines)+len(missing))
+	result = append(result, lines[:i]...)
+	result = append(result, missing...)
+	result = append(result, lines[i:]...)
+
+	return strings.Join(result, "\n")
+}
+
+func sweepFileHeader(td TemplateData) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "//go:build sweep\n// +build sweep\n\npackage %s\n\nimport (\n", td.ServicePackage)
+
+	if td.AWSGoSDKV2 {
+		fmt.Fprintf(&b, "\t\"context\"\n")
+	}
+
+	fmt.Fprintf(&b, "\t\"fmt\"\n\t\"log\"\n\n")
+
+	if td.AWSGoSDKV2 {
+		fmt.Fprintf(&b, "\t\"github.com/aws/aws-sdk-go-v2/aws\"\n\t\"github.com/aws/aws-sdk-go-v2/service/%s\"\n", td.ServicePackage)
+	} else {
+		fmt.Fprintf(&b, "\t\"github.com/aws/aws-sdk-go/aws\"\n\t\"github.com/aws/aws-sdk-go/service/%s\"\n", td.ServicePackage)
+	}
+
+	fmt.Fprintf(&b, "\t\"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource\"\n")
+	fmt.Fprintf(&b, "\t\"github.com/hashicorp/terraform-provider-aws/internal/conns\"\n")
+	fmt.Fprintf(&b, "\t\"github.com/hashicorp/terraform-provider-aws/internal/sweep\"\n")
+	fmt.Fprintf(&b, ")\n\nfunc init() {\n}\n")
+
+	return b.String()
+}
+
+func sweeperRegistration(td TemplateData) string {
+	name := fmt.Sprintf("aws_%s_%s", td.ServicePackage, td.ResourceSnake)
+
+	return fmt.Sprintf("\tresource.AddTestSweepers(%[1]q, &resource.Sweeper{\n\t\tName: %[1]q,\n\t\tF:    sweep%[2]ss,\n\t})\n", name, td.Resource)
+}
+
+// addSweeper registers a sweeper at the end of the init() function of a
+// sweep.go file and appends the sweeper function to the file.
+// If the sweeper is already registered, e.g. when rerun with --force, the contents are returned unchanged.
+func addSweeper(contents, registration, function string) (string, error) {
+	if strings.Contains(contents, registration) {
+		return contents, nil
+	}
+
+	start := strings.Index(contents, "\nfunc init() {\n")
+	if start < 0 {
+		return "", errors.New("no init() function found")
+	}
+
+	end := strings.Index(contents[start:], "\n}\n")
+	if end < 0 {
+		return "", errors.New("end of init() function not found")
+	}
+	end += start + 1
+
+	var b strings.Builder
+
+	b.WriteString(contents[:end])
+	if !strings.HasSuffix(contents[:end], "{\n") {
+		b.WriteString("\n")
+	}
+	b.WriteString(registration)
+	b.WriteString(strings.TrimRight(contents[end:], "\n"))
+	b.WriteString("\n")
+	b.WriteString(function)
+
+	return b.String(), nil
+}
+
+// addTestExport adds a test-only export to the contents of an exports_test.go file.
+func addTestExport(contents, packageName, name, value string) string {
+	export := fmt.Sprintf("var %s = %s\n", name, value)
+
+	if contents == "" {
+		return fmt.Sprintf("package %s\n\n// Exports for use in tests only.\n%s", packageName, export)
+	}
+
+	if strings.Contains(contents, export) {
+		return contents
+	}
+
+	return strings.TrimRight(contents, "\n") + "\n" + export
+}
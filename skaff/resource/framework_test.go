@@ -0,0 +1,212 @@
+package resource
+
+import (
+	"testing"
+)
+
+func TestAddGenerateDirectives(t *testing.T) {
+	testCases := []struct {
+		TestName string
+		Input    string
+		Expected string
+	}{
+		{
+			TestName: "no directives",
+			Input: `// ONLY generate directives and package declaration! Do not add anything else to this file.
+
+package simpledb
+`,
+			Expected: `//go:generate go run ../../generate/tags/main.go -ListTags -ServiceTagsMap -UpdateTags
+//go:generate go run ../../generate/servicepackagedata/main.go
+// ONLY generate directives and package declaration! Do not add anything else to this file.
+
+package simpledb
+`,
+		},
+		{
+			TestName: "existing tags directive",
+			Input: `//go:generate go run ../../generate/tags/main.go -ListTags -ServiceTagsSlice -UpdateTags
+// ONLY generate directives and package declaration! Do not add anything else to this file.
+
+package globalaccelerator
+`,
+			Expected: `//go:generate go run ../../generate/tags/main.go -ListTags -ServiceTagsSlice -UpdateTags
+//go:generate go run ../../generate/servicepackagedata/main.go
+// ONLY generate directives and package declaration! Do not add anything else to this file.
+
+package globalaccelerator
+`,
+		},
+		{
+			TestName: "all directives",
+			Input: `//go:generate go run ../../generate/tags/main.go -ListTags -ServiceTagsSlice -UpdateTags
+//go:generate go run ../../generate/servicepackagedata/main.go
+// ONLY generate directives and package declaration! Do not add anything else to this file.
+
+package globalaccelerator
+`,
+			Expected: `//go:generate go run ../../generate/tags/main.go -ListTags -ServiceTagsSlice -UpdateTags
+//go:generate go run ../../generate/servicepackagedata/main.go
+// ONLY generate directives and package declaration! Do not add anything else to this file.
+
+package globalaccelerator
+`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got := addGenerateDirectives(testCase.Input, tagsGenerateDirective(TemplateData{}), generateDirectiveServicePackageData)
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestAddSweeper(t *testing.T) {
+	registration := "\tregistration()\n"
+	function := "\nfunc sweepWidgets(region string) error {\n\treturn nil\n}\n"
+
+	testCases := []struct {
+		TestName string
+		Input    string
+		Expected string
+		Error    bool
+	}{
+		{
+			TestName: "empty init",
+			Input:    "package simpledb\n\nfunc init() {\n}\n",
+			Expected: "package simpledb\n\nfunc init() {\n\tregistration()\n}\n\nfunc sweepWidgets(region string) error {\n\treturn nil\n}\n",
+		},
+		{
+			TestName: "existing registrations",
+			Input:    "package simpledb\n\nfunc init() {\n\texisting()\n}\n\nfunc sweepDomains(region string) error {\n\treturn nil\n}\n",
+			Expected: "package simpledb\n\nfunc init() {\n\texisting()\n\n\tregistration()\n}\n\nfunc sweepDomains(region string) error {\n\treturn nil\n}\n\nfunc sweepWidgets(region string) error {\n\treturn nil\n}\n",
+		},
+		{
+			TestName: "already registered",
+			Input:    "package simpledb\n\nfunc init() {\n\tregistration()\n}\n\nfunc sweepWidgets(region string) error {\n\treturn nil\n}\n",
+			Expected: "package simpledb\n\nfunc init() {\n\tregistration()\n}\n\nfunc sweepWidgets(region string) error {\n\treturn nil\n}\n",
+		},
+		{
+			TestName: "no init",
+			Input:    "package simpledb\n",
+			Error:    true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got, err := addSweeper(testCase.Input, registration, function)
+
+			if err != nil && !testCase.Error {
+				t.Errorf("got error (%s), expected no error", err)
+			}
+
+			if err == nil && testCase.Error {
+				t.Errorf("got (%s) and no error, expected error", got)
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("got %q, expected %q", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestAddTestExport(t *testing.T) {
+	testCases := []struct {
+		TestName string
+		Input    string
+		Expected string
+	}{
+		{
+			TestName: "new file",
+			Input:    "",
+			Expected: "package simpledb\n\n// Exports for use in tests only.\nvar ResourceWidget = newResourceWidget\n",
+		},
+		{
+			TestName: "existing exports",
+			Input:    "package simpledb\n\n// Exports for use in tests only.\nvar ResourceDomain = newResourceDomain\n",
+			Expected: "package simpledb\n\n// Exports for use in tests only.\nvar ResourceDomain = newResourceDomain\nvar ResourceWidget = newResourceWidget\n",
+		},
+		{
+			TestName: "already exported",
+			Input:    "package simpledb\n\n// Exports for use in tests only.\nvar ResourceWidget = newResourceWidget\n",
+			Expected: "package simpledb\n\n// Exports for use in tests only.\nvar ResourceWidget = newResourceWidget\n",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got := addTestExport(testCase.Input, "simpledb", "ResourceWidget", "newResourceWidget")
+
+			if got != testCase.Expected {
+				t.Errorf("got %q, expected %q", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestFrameworkAWSGoSDKV2(t *testing.T) {
+	testCases := []struct {
+		TestName       string
+		ServicePackage string
+		V1             bool
+		Expected       bool
+		Error          bool
+	}{
+		{
+			TestName:       "v1 only",
+			ServicePackage: "simpledb",
+			Expected:       false,
+		},
+		{
+			TestName:       "v2 only",
+			ServicePackage: "comprehend",
+			Expected:       true,
+		},
+		{
+			TestName:       "v1 and v2",
+			ServicePackage: "s3control",
+			Expected:       true,
+		},
+		{
+			TestName:       "v1 and v2 with v1 requested",
+			ServicePackage: "s3control",
+			V1:             true,
+			Expected:       false,
+		},
+		{
+			TestName:       "v1 requested without v1 client",
+			ServicePackage: "comprehend",
+			V1:             true,
+			Error:          true,
+		},
+		{
+			TestName:       "unknown service",
+			ServicePackage: "notaservice",
+			Error:          true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got, err := frameworkAWSGoSDKV2(testCase.ServicePackage, testCase.V1)
+
+			if err != nil && !testCase.Error {
+				t.Errorf("got unexpected error: %s", err)
+			}
+
+			if err == nil && testCase.Error {
+				t.Errorf("expected error, got none")
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
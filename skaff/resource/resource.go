@@ -35,6 +35,7 @@ type TemplateData struct {
 	ServiceLower         string
 	AWSServiceName       string
 	AWSGoSDKV2           bool
+	Framework            bool
 	HumanResourceName    string
 }
 
@@ -58,7 +59,7 @@ func HumanResName(upper string) string {
 	return strings.TrimPrefix(re2.ReplaceAllString(upper, ` $1`), " ")
 }
 
-func Create(resName, snakeName string, comments, force, v2 bool) error {
+func Create(resName, snakeName string, comments, force, v2, framework bool) error {
 	wd, err := os.Getwd() // os.Getenv("GOPACKAGE") not available since this is not run with go generate
 	if err != nil {
 		return fmt.Errorf("error reading working directory: %s", err)
@@ -106,9 +107,18 @@ func Create(resName, snakeName string, comments, force, v2 bool) error {
 		ServiceLower:         strings.ToLower(s),
 		AWSServiceName:       sn,
 		AWSGoSDKV2:           v2,
+		Framework:            framework,
 		HumanResourceName:    HumanResName(resName),
 	}
 
+	if framework {
+		if templateData.AWSGoSDKV2, err = frameworkAWSGoSDKV2(servicePackage, !v2); err != nil {
+			return fmt.Errorf("error getting AWS SDK for Go version: %w", err)
+		}
+
+		return createFramework(snakeName, force, templateData)
+	}
+
 	f := fmt.Sprintf("%s.go", snakeName)
 	if err = writeTemplate("newres", f, resourceTmpl, force, templateData); err != nil {
 		return fmt.Errorf("writing resource template: %w", err)
@@ -133,20 +143,14 @@ func writeTemplate(templateName, filename, tmpl string, force bool, td TemplateD
 		return fmt.Errorf("file (%s) already exists and force is not set", filename)
 	}
 
-	f, err := os.OpenFile(filename, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644)
-	if err != nil {
-		return fmt.Errorf("error opening file (%s): %s", filename, err)
-	}
-
-	tplate, err := template.New(templateName).Parse(tmpl)
+	contents, err := executeTemplate(templateName, tmpl, td)
 	if err != nil {
-		return fmt.Errorf("error parsing template: %s", err)
+		return err
 	}
 
-	var buffer bytes.Buffer
-	err = tplate.Execute(&buffer, td)
+	f, err := os.OpenFile(filename, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644)
 	if err != nil {
-		return fmt.Errorf("error executing template: %s", err)
+		return fmt.Errorf("error opening file (%s): %s", filename, err)
 	}
 
 	//contents, err := format.Source(buffer.Bytes())
@@ -154,8 +158,7 @@ func writeTemplate(templateName, filename, tmpl string, force bool, td TemplateD
 	//	return fmt.Errorf("error formatting generated file: %s", err)
 	//}
 
-	//if _, err := f.Write(contents); err != nil {
-	if _, err := f.Write(buffer.Bytes()); err != nil {
+	if _, err := f.WriteString(contents); err != nil {
 		f.Close() // ignore error; Write error takes precedence
 		return fmt.Errorf("error writing to file (%s): %s", filename, err)
 	}
@@ -166,3 +169,18 @@ func writeTemplate(templateName, filename, tmpl string, force bool, td TemplateD
 
 	return nil
 }
+
+func executeTemplate(templateName, tmpl string, td TemplateData) (string, error) {
+	tplate, err := template.New(templateName).Parse(tmpl)
+	if err != nil {
+		return "", fmt.Errorf("error parsing template: %s", err)
+	}
+
+	var buffer bytes.Buffer
+	err = tplate.Execute(&buffer, td)
+	if err != nil {
+		return "", fmt.Errorf("error executing template: %s", err)
+	}
+
+	return buffer.String(), nil
+}